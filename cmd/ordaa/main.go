@@ -16,11 +16,15 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/Markus-Schwer/ordaa/internal/boundary/api"
 	"github.com/Markus-Schwer/ordaa/internal/boundary/matrix"
+	"github.com/Markus-Schwer/ordaa/internal/boundary/ssh"
 	"github.com/Markus-Schwer/ordaa/internal/config"
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
 	"github.com/Markus-Schwer/ordaa/internal/entity"
 	"github.com/Markus-Schwer/ordaa/internal/repository"
 	"github.com/Markus-Schwer/ordaa/internal/service"
+	"github.com/Markus-Schwer/ordaa/internal/templates"
 )
 
 func main() {
@@ -48,6 +52,21 @@ func Run() error {
 		return err
 	}
 
+	sshConfig, err := config.LoadSSHConfig()
+	if err != nil {
+		return err
+	}
+
+	httpConfig, err := config.LoadHTTPConfig()
+	if err != nil {
+		return err
+	}
+
+	argon2Config, err := config.LoadArgon2Config()
+	if err != nil {
+		return err
+	}
+
 	if !logConfig.JSON {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
@@ -77,13 +96,42 @@ func Run() error {
 	userRepository := &repository.UserRepository{DB: db}
 	menuRepository := &repository.MenuRepository{DB: db}
 	orderRepository := &repository.OrderRepository{DB: db, MenuRepository: *menuRepository}
+	roomRepository := &repository.RoomRepository{DB: db}
+
+	authorizer := &service.AuthorizationService{RoleRepository: userRepository}
+
+	userService := &service.UserService{
+		UserRepository:  userRepository,
+		Authorizer:      authorizer,
+		BootstrapAdmins: matrixConfig.Admins,
+		PasswordHasher: crypto.NewArgon2idHash(
+			argon2Config.Time,
+			argon2Config.SaltLength,
+			argon2Config.Memory,
+			argon2Config.Threads,
+			argon2Config.KeyLength,
+		),
+	}
+	orderService := &service.OrderService{
+		OrderRepository: orderRepository,
+		MenuRepository:  menuRepository,
+		Authorizer:      authorizer,
+	}
+	menuService := &service.MenuService{MenuRepository: menuRepository, Authorizer: authorizer}
+	roomService := &service.RoomService{RoomRepository: roomRepository, MenuRepository: menuRepository}
 
-	userService := &service.UserService{UserRepository: userRepository}
-	orderService := &service.OrderService{OrderRepository: orderRepository, MenuRepository: menuRepository}
+	if err := userService.GrantBootstrapAdmins(ctx); err != nil {
+		return fmt.Errorf("granting admin role: %w", err)
+	}
+
+	tmpl, err := templates.Load(matrixConfig.TemplateDir)
+	if err != nil {
+		return fmt.Errorf("loading templates: %w", err)
+	}
 
 	g, gCtx := errgroup.WithContext(ctx)
 
-	matrixBoundary, err := matrix.NewMatrixBoundary(ctx, matrixConfig, userService, orderService)
+	matrixBoundary, err := matrix.NewMatrixBoundary(ctx, matrixConfig, userService, orderService, menuService, roomService, tmpl)
 	if err != nil {
 		return err
 	}
@@ -97,6 +145,40 @@ func Run() error {
 		return matrixBoundary.Stop()
 	})
 
+	if sshConfig.Enabled {
+		var sshBoundary *ssh.Boundary
+
+		if sshBoundary, err = ssh.NewSSHBoundary(ctx, sshConfig, userService, orderService, menuService); err != nil {
+			return err
+		}
+
+		g.Go(func() error {
+			return sshBoundary.Start(ctx)
+		})
+
+		g.Go(func() error {
+			<-gCtx.Done()
+			return sshBoundary.Stop()
+		})
+	}
+
+	if httpConfig.Address != "" {
+		var apiBoundary *api.Boundary
+
+		if apiBoundary, err = api.NewAPIBoundary(ctx, httpConfig, userService, orderService, menuService); err != nil {
+			return err
+		}
+
+		g.Go(func() error {
+			return apiBoundary.Start(ctx)
+		})
+
+		g.Go(func() error {
+			<-gCtx.Done()
+			return apiBoundary.Stop()
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		return fmt.Errorf("shutting down: %w", err)
 	}
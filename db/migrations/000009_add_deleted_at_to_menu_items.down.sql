@@ -0,0 +1,2 @@
+DELETE FROM menu_items WHERE deleted_at IS NOT NULL AND uuid NOT IN (SELECT order_items.menu_item_uuid FROM order_items);
+ALTER TABLE menu_items DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS user_roles (
+    uuid UUID DEFAULT gen_random_uuid(),
+    user_uuid UUID NOT NULL,
+    role VARCHAR(40) NOT NULL,
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_user_roles_user FOREIGN KEY(user_uuid) REFERENCES users(uuid) ON DELETE CASCADE,
+    CONSTRAINT unique_user_role UNIQUE (user_uuid, role)
+);
+INSERT INTO user_roles (user_uuid, role) SELECT users.uuid, 'member' FROM users;
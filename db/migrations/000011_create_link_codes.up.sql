@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS link_codes (
+    uuid UUID DEFAULT gen_random_uuid(),
+    user_uuid UUID NOT NULL,
+    code VARCHAR(16) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_link_codes_user FOREIGN KEY(user_uuid) REFERENCES users(uuid) ON DELETE CASCADE,
+    CONSTRAINT unique_link_code UNIQUE (code)
+);
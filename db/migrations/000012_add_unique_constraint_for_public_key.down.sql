@@ -0,0 +1 @@
+ALTER TABLE ssh_users DROP CONSTRAINT IF EXISTS unique_public_key;
@@ -0,0 +1,4 @@
+-- keys are normalized without options and comment by entity.Migrate before
+-- this migration runs, it fails if two rows hold the same key
+ALTER TABLE ssh_users DROP CONSTRAINT IF EXISTS unique_public_key;
+ALTER TABLE ssh_users ADD CONSTRAINT unique_public_key UNIQUE (public_key);
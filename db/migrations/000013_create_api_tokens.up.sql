@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS api_tokens (
+    uuid UUID DEFAULT gen_random_uuid(),
+    user_uuid UUID NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    scope VARCHAR(16) NOT NULL,
+    token_id VARCHAR(16) NOT NULL,
+    token_hash TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_api_tokens_user FOREIGN KEY(user_uuid) REFERENCES users(uuid) ON DELETE CASCADE,
+    CONSTRAINT unique_api_token_id UNIQUE (token_id),
+    CONSTRAINT unique_api_token_name UNIQUE (user_uuid, name)
+);
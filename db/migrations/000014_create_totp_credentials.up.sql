@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS totp_credentials (
+    uuid UUID DEFAULT gen_random_uuid(),
+    user_uuid UUID NOT NULL,
+    secret VARCHAR(64) NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_totp_credentials_user FOREIGN KEY(user_uuid) REFERENCES users(uuid) ON DELETE CASCADE,
+    CONSTRAINT unique_totp_credential_user UNIQUE (user_uuid)
+);
+
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    uuid UUID DEFAULT gen_random_uuid(),
+    user_uuid UUID NOT NULL,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMPTZ,
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_recovery_codes_user FOREIGN KEY(user_uuid) REFERENCES users(uuid) ON DELETE CASCADE
+);
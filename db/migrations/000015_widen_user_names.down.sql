@@ -0,0 +1 @@
+ALTER TABLE users ALTER COLUMN name TYPE VARCHAR(40) USING LEFT(name, 40);
@@ -0,0 +1 @@
+ALTER TABLE users ALTER COLUMN name TYPE VARCHAR(255);
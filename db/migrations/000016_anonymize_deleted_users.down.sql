@@ -0,0 +1,19 @@
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS fk_order_items_user;
+ALTER TABLE order_items ADD CONSTRAINT fk_order_items_user FOREIGN KEY(order_user) REFERENCES users(uuid);
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS fk_orders_sugar_person;
+ALTER TABLE orders ADD CONSTRAINT fk_orders_sugar_person FOREIGN KEY(sugar_person) REFERENCES users(uuid);
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS fk_orders_initiator;
+ALTER TABLE orders ADD CONSTRAINT fk_orders_initiator FOREIGN KEY(initiator) REFERENCES users(uuid);
+
+-- orders and items of deleted users have no user anymore, they are given to
+-- a placeholder account so the columns can be required again
+INSERT INTO users (uuid, name)
+SELECT '00000000-0000-0000-0000-000000000000', 'deleted user'
+WHERE EXISTS (SELECT 1 FROM orders WHERE initiator IS NULL)
+   OR EXISTS (SELECT 1 FROM order_items WHERE order_user IS NULL)
+ON CONFLICT (uuid) DO NOTHING;
+UPDATE orders SET initiator = '00000000-0000-0000-0000-000000000000' WHERE initiator IS NULL;
+UPDATE order_items SET order_user = '00000000-0000-0000-0000-000000000000' WHERE order_user IS NULL;
+
+ALTER TABLE order_items ALTER COLUMN order_user SET NOT NULL;
+ALTER TABLE orders ALTER COLUMN initiator SET NOT NULL;
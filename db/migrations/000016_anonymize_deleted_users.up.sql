@@ -0,0 +1,9 @@
+ALTER TABLE orders ALTER COLUMN initiator DROP NOT NULL;
+ALTER TABLE order_items ALTER COLUMN order_user DROP NOT NULL;
+
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS fk_orders_initiator;
+ALTER TABLE orders ADD CONSTRAINT fk_orders_initiator FOREIGN KEY(initiator) REFERENCES users(uuid) ON DELETE SET NULL;
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS fk_orders_sugar_person;
+ALTER TABLE orders ADD CONSTRAINT fk_orders_sugar_person FOREIGN KEY(sugar_person) REFERENCES users(uuid) ON DELETE SET NULL;
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS fk_order_items_user;
+ALTER TABLE order_items ADD CONSTRAINT fk_order_items_user FOREIGN KEY(order_user) REFERENCES users(uuid) ON DELETE SET NULL;
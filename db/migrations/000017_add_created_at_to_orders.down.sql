@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_orders_menu_created_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,4 @@
+-- the creation date of existing orders is unknown, so they keep NULL
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ;
+ALTER TABLE orders ALTER COLUMN created_at SET DEFAULT now();
+CREATE INDEX IF NOT EXISTS idx_orders_menu_created_at ON orders (menu_uuid, created_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS favourite_items;
+DROP TABLE IF EXISTS favourites;
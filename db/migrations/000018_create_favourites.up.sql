@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS favourites (
+    uuid UUID DEFAULT gen_random_uuid(),
+    user_uuid UUID NOT NULL,
+    menu_uuid UUID NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_favourites_user FOREIGN KEY(user_uuid) REFERENCES users(uuid) ON DELETE CASCADE,
+    CONSTRAINT fk_favourites_menu FOREIGN KEY(menu_uuid) REFERENCES menus(uuid) ON DELETE CASCADE,
+    CONSTRAINT unique_favourite_name UNIQUE (user_uuid, menu_uuid, name)
+);
+
+CREATE TABLE IF NOT EXISTS favourite_items (
+    uuid UUID DEFAULT gen_random_uuid(),
+    favourite_uuid UUID NOT NULL,
+    menu_item_uuid UUID NOT NULL,
+    quantity INTEGER NOT NULL DEFAULT 1,
+    PRIMARY KEY (uuid),
+    CONSTRAINT fk_favourite_items_favourite FOREIGN KEY(favourite_uuid) REFERENCES favourites(uuid) ON DELETE CASCADE,
+    CONSTRAINT fk_favourite_items_menu_item FOREIGN KEY(menu_item_uuid) REFERENCES menu_items(uuid) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_menu_items_name_trgm;
+DROP FUNCTION IF EXISTS search_name(text);
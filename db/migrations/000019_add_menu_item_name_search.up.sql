@@ -0,0 +1,10 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent is only stable because its dictionary could change, an index
+-- needs an immutable function
+CREATE OR REPLACE FUNCTION search_name(text) RETURNS text AS $$
+    SELECT lower(public.unaccent('public.unaccent', $1))
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+CREATE INDEX IF NOT EXISTS idx_menu_items_name_trgm ON menu_items USING gin (search_name(name) gin_trgm_ops);
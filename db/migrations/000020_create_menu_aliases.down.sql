@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS menu_aliases;
+DROP INDEX IF EXISTS unique_lower_menu_name;
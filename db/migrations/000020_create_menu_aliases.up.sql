@@ -0,0 +1,8 @@
+CREATE UNIQUE INDEX IF NOT EXISTS unique_lower_menu_name ON menus (lower(name));
+
+CREATE TABLE IF NOT EXISTS menu_aliases (
+    alias VARCHAR(255) NOT NULL,
+    menu_uuid UUID NOT NULL,
+    PRIMARY KEY (alias),
+    CONSTRAINT fk_menu_aliases_menu FOREIGN KEY(menu_uuid) REFERENCES menus(uuid) ON DELETE CASCADE
+);
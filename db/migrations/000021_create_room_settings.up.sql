@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS room_settings (
+    room_id VARCHAR(255) NOT NULL,
+    prefix VARCHAR(32) NOT NULL DEFAULT '',
+    language VARCHAR(8) NOT NULL DEFAULT '',
+    default_menu_uuid UUID,
+    auto_register BOOLEAN,
+    PRIMARY KEY (room_id),
+    CONSTRAINT fk_room_settings_default_menu FOREIGN KEY(default_menu_uuid) REFERENCES menus(uuid) ON DELETE SET NULL
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_orders_menu_room;
+ALTER TABLE orders DROP COLUMN IF EXISTS shared;
+ALTER TABLE orders DROP COLUMN IF EXISTS room_id;
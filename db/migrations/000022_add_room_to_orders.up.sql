@@ -0,0 +1,5 @@
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS room_id VARCHAR(255) NOT NULL DEFAULT '';
+-- orders started before are not tied to a room and stay visible in all rooms
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS shared BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE orders ALTER COLUMN shared SET DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_orders_menu_room ON orders (menu_uuid, room_id);
@@ -0,0 +1,2 @@
+-- an empty language falls back to the language of the room
+ALTER TABLE users ADD COLUMN IF NOT EXISTS language VARCHAR(8) NOT NULL DEFAULT '';
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Markus-Schwer/ordaa/internal/config"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+var ErrJWTSecretMissing = errors.New("JWT_SECRET must be set to serve the http api")
+
+//go:generate go tool moq -rm -out user_service_mock.go . UserService
+
+type UserService interface {
+	Login(ctx context.Context, username, password, totpCode, linkCode string) (*entity.User, error)
+	AuthenticateAPIToken(ctx context.Context, token string) (*entity.APIToken, error)
+	EnrollTOTP(ctx context.Context, userUUID *uuid.UUID) (string, error)
+	ConfirmTOTP(ctx context.Context, userUUID *uuid.UUID, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userUUID *uuid.UUID, code string) error
+}
+
+//go:generate go tool moq -rm -out order_service_mock.go . OrderService
+
+type OrderService interface {
+	GetAllOrders(ctx context.Context) ([]entity.Order, error)
+	GetOrder(ctx context.Context, uuid *uuid.UUID) (*entity.Order, error)
+	GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error)
+	CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*entity.Order, error)
+	AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, roomID, shortName, menuName string) error
+	RemoveOrderItem(ctx context.Context, currentUser, orderItemUUID *uuid.UUID) error
+}
+
+//go:generate go tool moq -rm -out menu_service_mock.go . MenuService
+
+type MenuService interface {
+	GetAllMenus(ctx context.Context) ([]entity.Menu, error)
+	GetMenu(ctx context.Context, uuid *uuid.UUID) (*entity.Menu, error)
+}
+
+// Boundary serves the http api. Requests are authenticated either with a jwt
+// from /api/login or with a personal api token.
+type Boundary struct {
+	server       *echo.Echo
+	address      string
+	jwtSecret    []byte
+	jwtTTL       time.Duration
+	userService  UserService
+	orderService OrderService
+	menuService  MenuService
+}
+
+func NewAPIBoundary(
+	ctx context.Context,
+	cfg *config.HTTPConfig,
+	userService UserService,
+	orderService OrderService,
+	menuService MenuService,
+) (*Boundary, error) {
+	if cfg.JWTSecret == "" {
+		return nil, ErrJWTSecretMissing
+	}
+
+	boundary := &Boundary{
+		server:       echo.New(),
+		address:      cfg.Address,
+		jwtSecret:    []byte(cfg.JWTSecret),
+		jwtTTL:       cfg.JWTTTL,
+		userService:  userService,
+		orderService: orderService,
+		menuService:  menuService,
+	}
+
+	boundary.server.HideBanner = true
+	boundary.server.HidePort = true
+	boundary.routes()
+
+	return boundary, nil
+}
+
+func (b *Boundary) routes() {
+	b.server.POST("/api/login", b.login)
+
+	api := b.server.Group("/api", b.authenticate)
+	write := requireScope(entity.TokenScopeOrderWrite)
+
+	api.GET("/menus", b.getAllMenus)
+	api.GET("/menus/:uuid", b.getMenu)
+	api.GET("/orders", b.getAllOrders)
+	api.GET("/orders/:uuid", b.getOrder)
+	api.POST("/orders", b.createOrder, write)
+	api.GET("/orders/:uuid/items", b.getAllOrderItems)
+	api.POST("/orders/:uuid/items", b.createOrderItem, write)
+	api.DELETE("/orders/:uuid/items/:item_uuid", b.deleteOrderItem, write)
+	api.POST("/totp", b.enrollTOTP, requireSession)
+	api.POST("/totp/confirm", b.confirmTOTP, requireSession)
+	api.POST("/totp/disable", b.disableTOTP, requireSession)
+}
+
+func (b *Boundary) Start(ctx context.Context) error {
+	log.Ctx(ctx).Info().Msgf("starting http server on %s", b.address)
+
+	if err := b.server.Start(b.address); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serving http: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Boundary) Stop() error {
+	log.Info().Msg("shutting down http boundary")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := b.server.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("shutting down http server: %w", err)
+	}
+
+	return nil
+}
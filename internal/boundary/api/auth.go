@@ -0,0 +1,181 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+const principalKey = "principal"
+
+// principal is the authenticated caller of a request. A jwt from a password
+// login grants the widest scope, api tokens only the scope they were created with.
+type principal struct {
+	UserUUID *uuid.UUID
+	Scope    entity.TokenScope
+	// Session is set for jwts, i.e. the caller knows the password.
+	Session bool
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// TOTP is a totp or recovery code, only required after totp was set up.
+	TOTP string `json:"totp"`
+	// LinkCode adds this login to the account that created the code.
+	LinkCode string `json:"link_code"`
+}
+
+type loginResponse struct {
+	JWT string `json:"jwt"`
+}
+
+func (b *Boundary) login(c echo.Context) error {
+	var req loginRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid login request")
+	}
+
+	ctx := c.Request().Context()
+
+	user, err := b.userService.Login(ctx, req.Username, req.Password, req.TOTP, req.LinkCode)
+	if errors.Is(err, service.ErrInvalidCredentials) || errors.Is(err, service.ErrTOTPRequired) || errors.Is(err, service.ErrInvalidTOTPCode) {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	} else if isLinkError(err) {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	} else if err != nil {
+		return httpError(c, err)
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   user.UUID.String(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(b.jwtTTL)),
+	})
+
+	signed, err := token.SignedString(b.jwtSecret)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, loginResponse{JWT: signed})
+}
+
+// isLinkError reports errors of a link code the caller can fix.
+func isLinkError(err error) bool {
+	for _, target := range []error{
+		repository.ErrLinkCodeNotFound,
+		repository.ErrConflictingAPIToken,
+		repository.ErrConflictingFavourite,
+		repository.ErrConflictingTOTP,
+		service.ErrAlreadyLinked,
+		service.ErrPrivilegedLink,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authenticate accepts a bearer jwt or a bearer api token.
+func (b *Boundary) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		bearer, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || bearer == "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, "missing bearer token")
+		}
+
+		var (
+			p   *principal
+			err error
+		)
+
+		if strings.HasPrefix(bearer, service.APITokenPrefix) {
+			p, err = b.authenticateAPIToken(c, bearer)
+		} else {
+			p, err = b.authenticateJWT(bearer)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		c.Set(principalKey, p)
+
+		return next(c)
+	}
+}
+
+func (b *Boundary) authenticateAPIToken(c echo.Context, token string) (*principal, error) {
+	apiToken, err := b.userService.AuthenticateAPIToken(c.Request().Context(), token)
+	if errors.Is(err, service.ErrInvalidAPIToken) {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	} else if err != nil {
+		return nil, httpError(c, err)
+	}
+
+	return &principal{UserUUID: apiToken.UserUUID, Scope: apiToken.Scope}, nil
+}
+
+func (b *Boundary) authenticateJWT(token string) (*principal, error) {
+	var claims jwt.RegisteredClaims
+
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return b.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired jwt")
+	}
+
+	userUUID, err := uuid.FromString(claims.Subject)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired jwt")
+	}
+
+	return &principal{UserUUID: &userUUID, Scope: entity.TokenScopeOrderWrite, Session: true}, nil
+}
+
+// requireScope rejects api tokens whose scope does not cover the route.
+func requireScope(scope entity.TokenScope) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if scope == entity.TokenScopeOrderWrite && currentPrincipal(c).Scope != entity.TokenScopeOrderWrite {
+				return echo.NewHTTPError(http.StatusForbidden, "token scope does not allow this request")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// requireSession rejects api tokens for account settings like the second factor.
+func requireSession(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !currentPrincipal(c).Session {
+			return echo.NewHTTPError(http.StatusForbidden, "api tokens cannot change account settings")
+		}
+
+		return next(c)
+	}
+}
+
+func currentPrincipal(c echo.Context) *principal {
+	p, _ := c.Get(principalKey).(*principal)
+	if p == nil {
+		return &principal{}
+	}
+
+	return p
+}
@@ -0,0 +1,258 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Markus-Schwer/ordaa/internal/config"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+const (
+	jwtSecret       = "test-secret"
+	readOnlyToken   = "ordaa_000000000001_read"
+	orderWriteToken = "ordaa_000000000002_write"
+)
+
+var (
+	userUUID = uuid.Must(uuid.NewV4())
+	menuUUID = uuid.Must(uuid.NewV4())
+)
+
+func signJWT(t *testing.T, secret string, expiresAt time.Time) string {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   userUUID.String(),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SignedString([]byte(secret))
+	require.NoError(t, err)
+
+	return token
+}
+
+func newTestBoundary(t *testing.T) *Boundary {
+	t.Helper()
+
+	userService := &UserServiceMock{
+		LoginFunc: func(ctx context.Context, username, password, totpCode, linkCode string) (*entity.User, error) {
+			if username != "luca" && username != "jana" || password != "secret" {
+				return nil, service.ErrInvalidCredentials
+			}
+
+			// jana has set up totp
+			if username == "jana" && totpCode == "" {
+				return nil, service.ErrTOTPRequired
+			} else if username == "jana" && totpCode != "287082" {
+				return nil, service.ErrInvalidTOTPCode
+			}
+
+			if linkCode != "" && linkCode != "ABCD-EFGH" {
+				return nil, fmt.Errorf("%w: %w", service.ErrLinkingAccount, repository.ErrLinkCodeNotFound)
+			}
+
+			return &entity.User{UUID: &userUUID, Name: username}, nil
+		},
+		EnrollTOTPFunc: func(ctx context.Context, userUUID *uuid.UUID) (string, error) {
+			return "otpauth://totp/ordaa:luca?secret=GEZDGNBV", nil
+		},
+		AuthenticateAPITokenFunc: func(ctx context.Context, token string) (*entity.APIToken, error) {
+			switch token {
+			case readOnlyToken:
+				return &entity.APIToken{UserUUID: &userUUID, Scope: entity.TokenScopeReadOnly}, nil
+			case orderWriteToken:
+				return &entity.APIToken{UserUUID: &userUUID, Scope: entity.TokenScopeOrderWrite}, nil
+			default:
+				return nil, service.ErrInvalidAPIToken
+			}
+		},
+	}
+
+	orderService := &OrderServiceMock{
+		CreateOrderForMenuNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*entity.Order, error) {
+			return &entity.Order{Initiator: currentUser, MenuUUID: &menuUUID, State: entity.Open}, nil
+		},
+	}
+
+	menuService := &MenuServiceMock{
+		GetAllMenusFunc: func(ctx context.Context) ([]entity.Menu, error) {
+			return []entity.Menu{{UUID: &menuUUID, Name: "sangam"}}, nil
+		},
+		GetMenuFunc: func(ctx context.Context, uuid *uuid.UUID) (*entity.Menu, error) {
+			return &entity.Menu{UUID: &menuUUID, Name: "sangam"}, nil
+		},
+	}
+
+	cfg := &config.HTTPConfig{JWTSecret: jwtSecret, JWTTTL: time.Hour}
+
+	b, err := NewAPIBoundary(t.Context(), cfg, userService, orderService, menuService)
+	require.NoError(t, err)
+
+	return b
+}
+
+func TestAuthentication(t *testing.T) {
+	type testCase struct {
+		name   string
+		method string
+		path   string
+		body   string
+		token  string
+		status int
+	}
+
+	createOrder := `{"menu_uuid": "` + menuUUID.String() + `"}`
+
+	testCases := []testCase{
+		{
+			name:   "should reject request without token",
+			method: http.MethodGet,
+			path:   "/api/menus",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "should accept jwt",
+			method: http.MethodGet,
+			path:   "/api/menus",
+			token:  signJWT(t, jwtSecret, time.Now().Add(time.Hour)),
+			status: http.StatusOK,
+		},
+		{
+			name:   "should reject expired jwt",
+			method: http.MethodGet,
+			path:   "/api/menus",
+			token:  signJWT(t, jwtSecret, time.Now().Add(-time.Minute)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "should reject jwt with wrong signature",
+			method: http.MethodGet,
+			path:   "/api/menus",
+			token:  signJWT(t, "other-secret", time.Now().Add(time.Hour)),
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "should accept read-only api token for reading",
+			method: http.MethodGet,
+			path:   "/api/menus",
+			token:  readOnlyToken,
+			status: http.StatusOK,
+		},
+		{
+			name:   "should reject unknown api token",
+			method: http.MethodGet,
+			path:   "/api/menus",
+			token:  "ordaa_000000000003_unknown",
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:   "should reject read-only api token for starting an order",
+			method: http.MethodPost,
+			path:   "/api/orders",
+			body:   createOrder,
+			token:  readOnlyToken,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "should reject api token for setting up totp",
+			method: http.MethodPost,
+			path:   "/api/totp",
+			token:  orderWriteToken,
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "should accept jwt for setting up totp",
+			method: http.MethodPost,
+			path:   "/api/totp",
+			token:  signJWT(t, jwtSecret, time.Now().Add(time.Hour)),
+			status: http.StatusCreated,
+		},
+		{
+			name:   "should accept order-write api token for starting an order",
+			method: http.MethodPost,
+			path:   "/api/orders",
+			body:   createOrder,
+			token:  orderWriteToken,
+			status: http.StatusCreated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newTestBoundary(t)
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			rec := httptest.NewRecorder()
+			b.server.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.status, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestLogin(t *testing.T) {
+	b := newTestBoundary(t)
+
+	login := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/login", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		b.server.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := login(`{"username": "luca", "password": "wrong"}`)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = login(`{"username": "jana", "password": "secret"}`)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), service.ErrTOTPRequired.Error())
+
+	rec = login(`{"username": "jana", "password": "secret", "totp": "000000"}`)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = login(`{"username": "jana", "password": "secret", "totp": "287082"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = login(`{"username": "luca", "password": "secret", "link_code": "WXYZ-2345"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), repository.ErrLinkCodeNotFound.Error())
+
+	rec = login(`{"username": "luca", "password": "secret", "link_code": "ABCD-EFGH"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = login(`{"username": "luca", "password": "secret"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp loginResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/menus", nil)
+	req.Header.Set("Authorization", "Bearer "+resp.JWT)
+
+	rec = httptest.NewRecorder()
+	b.server.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
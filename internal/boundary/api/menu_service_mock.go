@@ -0,0 +1,127 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package api
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that MenuServiceMock does implement MenuService.
+// If this is not the case, regenerate this file with moq.
+var _ MenuService = &MenuServiceMock{}
+
+// MenuServiceMock is a mock implementation of MenuService.
+//
+//	func TestSomethingThatUsesMenuService(t *testing.T) {
+//
+//		// make and configure a mocked MenuService
+//		mockedMenuService := &MenuServiceMock{
+//			GetAllMenusFunc: func(ctx context.Context) ([]entity.Menu, error) {
+//				panic("mock out the GetAllMenus method")
+//			},
+//			GetMenuFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Menu, error) {
+//				panic("mock out the GetMenu method")
+//			},
+//		}
+//
+//		// use mockedMenuService in code that requires MenuService
+//		// and then make assertions.
+//
+//	}
+type MenuServiceMock struct {
+	// GetAllMenusFunc mocks the GetAllMenus method.
+	GetAllMenusFunc func(ctx context.Context) ([]entity.Menu, error)
+
+	// GetMenuFunc mocks the GetMenu method.
+	GetMenuFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Menu, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetAllMenus holds details about calls to the GetAllMenus method.
+		GetAllMenus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetMenu holds details about calls to the GetMenu method.
+		GetMenu []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+	}
+	lockGetAllMenus sync.RWMutex
+	lockGetMenu     sync.RWMutex
+}
+
+// GetAllMenus calls GetAllMenusFunc.
+func (mock *MenuServiceMock) GetAllMenus(ctx context.Context) ([]entity.Menu, error) {
+	if mock.GetAllMenusFunc == nil {
+		panic("MenuServiceMock.GetAllMenusFunc: method is nil but MenuService.GetAllMenus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllMenus.Lock()
+	mock.calls.GetAllMenus = append(mock.calls.GetAllMenus, callInfo)
+	mock.lockGetAllMenus.Unlock()
+	return mock.GetAllMenusFunc(ctx)
+}
+
+// GetAllMenusCalls gets all the calls that were made to GetAllMenus.
+// Check the length with:
+//
+//	len(mockedMenuService.GetAllMenusCalls())
+func (mock *MenuServiceMock) GetAllMenusCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllMenus.RLock()
+	calls = mock.calls.GetAllMenus
+	mock.lockGetAllMenus.RUnlock()
+	return calls
+}
+
+// GetMenu calls GetMenuFunc.
+func (mock *MenuServiceMock) GetMenu(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Menu, error) {
+	if mock.GetMenuFunc == nil {
+		panic("MenuServiceMock.GetMenuFunc: method is nil but MenuService.GetMenu was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetMenu.Lock()
+	mock.calls.GetMenu = append(mock.calls.GetMenu, callInfo)
+	mock.lockGetMenu.Unlock()
+	return mock.GetMenuFunc(ctx, uuidMoqParam)
+}
+
+// GetMenuCalls gets all the calls that were made to GetMenu.
+// Check the length with:
+//
+//	len(mockedMenuService.GetMenuCalls())
+func (mock *MenuServiceMock) GetMenuCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetMenu.RLock()
+	calls = mock.calls.GetMenu
+	mock.lockGetMenu.RUnlock()
+	return calls
+}
@@ -1,7 +1,7 @@
 // Code generated by moq; DO NOT EDIT.
 // github.com/matryer/moq
 
-package handler
+package api
 
 import (
 	"context"
@@ -20,20 +20,14 @@ var _ OrderService = &OrderServiceMock{}
 //
 //		// make and configure a mocked OrderService
 //		mockedOrderService := &OrderServiceMock{
-//			AddOrderItemToOrderByNameFunc: func(ctx context.Context, currentUser *uuid.UUID, shortName string, menuName string) error {
+//			AddOrderItemToOrderByNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, shortName string, menuName string) error {
 //				panic("mock out the AddOrderItemToOrderByName method")
 //			},
-//			CreateOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error) {
-//				panic("mock out the CreateOrder method")
-//			},
-//			CreateOrderForMenuNameFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string) (*entity.Order, error) {
+//			CreateOrderForMenuNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error) {
 //				panic("mock out the CreateOrderForMenuName method")
 //			},
-//			GetActiveOrderByMenuFunc: func(ctx context.Context, menuUUID *uuid.UUID) (*entity.Order, error) {
-//				panic("mock out the GetActiveOrderByMenu method")
-//			},
-//			GetActiveOrderByMenuNameFunc: func(ctx context.Context, name string) (*entity.Order, error) {
-//				panic("mock out the GetActiveOrderByMenuName method")
+//			GetAllOrderItemsFunc: func(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
+//				panic("mock out the GetAllOrderItems method")
 //			},
 //			GetAllOrdersFunc: func(ctx context.Context) ([]entity.Order, error) {
 //				panic("mock out the GetAllOrders method")
@@ -41,8 +35,8 @@ var _ OrderService = &OrderServiceMock{}
 //			GetOrderFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Order, error) {
 //				panic("mock out the GetOrder method")
 //			},
-//			UpdateOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
-//				panic("mock out the UpdateOrder method")
+//			RemoveOrderItemFunc: func(ctx context.Context, currentUser *uuid.UUID, orderItemUUID *uuid.UUID) error {
+//				panic("mock out the RemoveOrderItem method")
 //			},
 //		}
 //
@@ -52,19 +46,13 @@ var _ OrderService = &OrderServiceMock{}
 //	}
 type OrderServiceMock struct {
 	// AddOrderItemToOrderByNameFunc mocks the AddOrderItemToOrderByName method.
-	AddOrderItemToOrderByNameFunc func(ctx context.Context, currentUser *uuid.UUID, shortName string, menuName string) error
-
-	// CreateOrderFunc mocks the CreateOrder method.
-	CreateOrderFunc func(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error)
+	AddOrderItemToOrderByNameFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, shortName string, menuName string) error
 
 	// CreateOrderForMenuNameFunc mocks the CreateOrderForMenuName method.
-	CreateOrderForMenuNameFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string) (*entity.Order, error)
-
-	// GetActiveOrderByMenuFunc mocks the GetActiveOrderByMenu method.
-	GetActiveOrderByMenuFunc func(ctx context.Context, menuUUID *uuid.UUID) (*entity.Order, error)
+	CreateOrderForMenuNameFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error)
 
-	// GetActiveOrderByMenuNameFunc mocks the GetActiveOrderByMenuName method.
-	GetActiveOrderByMenuNameFunc func(ctx context.Context, name string) (*entity.Order, error)
+	// GetAllOrderItemsFunc mocks the GetAllOrderItems method.
+	GetAllOrderItemsFunc func(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error)
 
 	// GetAllOrdersFunc mocks the GetAllOrders method.
 	GetAllOrdersFunc func(ctx context.Context) ([]entity.Order, error)
@@ -72,8 +60,8 @@ type OrderServiceMock struct {
 	// GetOrderFunc mocks the GetOrder method.
 	GetOrderFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Order, error)
 
-	// UpdateOrderFunc mocks the UpdateOrder method.
-	UpdateOrderFunc func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error)
+	// RemoveOrderItemFunc mocks the RemoveOrderItem method.
+	RemoveOrderItemFunc func(ctx context.Context, currentUser *uuid.UUID, orderItemUUID *uuid.UUID) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -83,42 +71,30 @@ type OrderServiceMock struct {
 			Ctx context.Context
 			// CurrentUser is the currentUser argument value.
 			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
 			// ShortName is the shortName argument value.
 			ShortName string
 			// MenuName is the menuName argument value.
 			MenuName string
 		}
-		// CreateOrder holds details about calls to the CreateOrder method.
-		CreateOrder []struct {
-			// Ctx is the ctx argument value.
-			Ctx context.Context
-			// CurrentUser is the currentUser argument value.
-			CurrentUser *uuid.UUID
-			// Order is the order argument value.
-			Order *entity.Order
-		}
 		// CreateOrderForMenuName holds details about calls to the CreateOrderForMenuName method.
 		CreateOrderForMenuName []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// CurrentUser is the currentUser argument value.
 			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
 			// MenuName is the menuName argument value.
 			MenuName string
 		}
-		// GetActiveOrderByMenu holds details about calls to the GetActiveOrderByMenu method.
-		GetActiveOrderByMenu []struct {
-			// Ctx is the ctx argument value.
-			Ctx context.Context
-			// MenuUUID is the menuUUID argument value.
-			MenuUUID *uuid.UUID
-		}
-		// GetActiveOrderByMenuName holds details about calls to the GetActiveOrderByMenuName method.
-		GetActiveOrderByMenuName []struct {
+		// GetAllOrderItems holds details about calls to the GetAllOrderItems method.
+		GetAllOrderItems []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// Name is the name argument value.
-			Name string
+			// OrderUUID is the orderUUID argument value.
+			OrderUUID *uuid.UUID
 		}
 		// GetAllOrders holds details about calls to the GetAllOrders method.
 		GetAllOrders []struct {
@@ -132,48 +108,46 @@ type OrderServiceMock struct {
 			// UuidMoqParam is the uuidMoqParam argument value.
 			UuidMoqParam *uuid.UUID
 		}
-		// UpdateOrder holds details about calls to the UpdateOrder method.
-		UpdateOrder []struct {
+		// RemoveOrderItem holds details about calls to the RemoveOrderItem method.
+		RemoveOrderItem []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// CurrentUser is the currentUser argument value.
 			CurrentUser *uuid.UUID
-			// UuidMoqParam is the uuidMoqParam argument value.
-			UuidMoqParam *uuid.UUID
-			// Order is the order argument value.
-			Order *entity.Order
+			// OrderItemUUID is the orderItemUUID argument value.
+			OrderItemUUID *uuid.UUID
 		}
 	}
 	lockAddOrderItemToOrderByName sync.RWMutex
-	lockCreateOrder               sync.RWMutex
 	lockCreateOrderForMenuName    sync.RWMutex
-	lockGetActiveOrderByMenu      sync.RWMutex
-	lockGetActiveOrderByMenuName  sync.RWMutex
+	lockGetAllOrderItems          sync.RWMutex
 	lockGetAllOrders              sync.RWMutex
 	lockGetOrder                  sync.RWMutex
-	lockUpdateOrder               sync.RWMutex
+	lockRemoveOrderItem           sync.RWMutex
 }
 
 // AddOrderItemToOrderByName calls AddOrderItemToOrderByNameFunc.
-func (mock *OrderServiceMock) AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, shortName string, menuName string) error {
+func (mock *OrderServiceMock) AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, roomID string, shortName string, menuName string) error {
 	if mock.AddOrderItemToOrderByNameFunc == nil {
 		panic("OrderServiceMock.AddOrderItemToOrderByNameFunc: method is nil but OrderService.AddOrderItemToOrderByName was just called")
 	}
 	callInfo := struct {
 		Ctx         context.Context
 		CurrentUser *uuid.UUID
+		RoomID      string
 		ShortName   string
 		MenuName    string
 	}{
 		Ctx:         ctx,
 		CurrentUser: currentUser,
+		RoomID:      roomID,
 		ShortName:   shortName,
 		MenuName:    menuName,
 	}
 	mock.lockAddOrderItemToOrderByName.Lock()
 	mock.calls.AddOrderItemToOrderByName = append(mock.calls.AddOrderItemToOrderByName, callInfo)
 	mock.lockAddOrderItemToOrderByName.Unlock()
-	return mock.AddOrderItemToOrderByNameFunc(ctx, currentUser, shortName, menuName)
+	return mock.AddOrderItemToOrderByNameFunc(ctx, currentUser, roomID, shortName, menuName)
 }
 
 // AddOrderItemToOrderByNameCalls gets all the calls that were made to AddOrderItemToOrderByName.
@@ -183,12 +157,14 @@ func (mock *OrderServiceMock) AddOrderItemToOrderByName(ctx context.Context, cur
 func (mock *OrderServiceMock) AddOrderItemToOrderByNameCalls() []struct {
 	Ctx         context.Context
 	CurrentUser *uuid.UUID
+	RoomID      string
 	ShortName   string
 	MenuName    string
 } {
 	var calls []struct {
 		Ctx         context.Context
 		CurrentUser *uuid.UUID
+		RoomID      string
 		ShortName   string
 		MenuName    string
 	}
@@ -198,64 +174,26 @@ func (mock *OrderServiceMock) AddOrderItemToOrderByNameCalls() []struct {
 	return calls
 }
 
-// CreateOrder calls CreateOrderFunc.
-func (mock *OrderServiceMock) CreateOrder(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error) {
-	if mock.CreateOrderFunc == nil {
-		panic("OrderServiceMock.CreateOrderFunc: method is nil but OrderService.CreateOrder was just called")
-	}
-	callInfo := struct {
-		Ctx         context.Context
-		CurrentUser *uuid.UUID
-		Order       *entity.Order
-	}{
-		Ctx:         ctx,
-		CurrentUser: currentUser,
-		Order:       order,
-	}
-	mock.lockCreateOrder.Lock()
-	mock.calls.CreateOrder = append(mock.calls.CreateOrder, callInfo)
-	mock.lockCreateOrder.Unlock()
-	return mock.CreateOrderFunc(ctx, currentUser, order)
-}
-
-// CreateOrderCalls gets all the calls that were made to CreateOrder.
-// Check the length with:
-//
-//	len(mockedOrderService.CreateOrderCalls())
-func (mock *OrderServiceMock) CreateOrderCalls() []struct {
-	Ctx         context.Context
-	CurrentUser *uuid.UUID
-	Order       *entity.Order
-} {
-	var calls []struct {
-		Ctx         context.Context
-		CurrentUser *uuid.UUID
-		Order       *entity.Order
-	}
-	mock.lockCreateOrder.RLock()
-	calls = mock.calls.CreateOrder
-	mock.lockCreateOrder.RUnlock()
-	return calls
-}
-
 // CreateOrderForMenuName calls CreateOrderForMenuNameFunc.
-func (mock *OrderServiceMock) CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, menuName string) (*entity.Order, error) {
+func (mock *OrderServiceMock) CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error) {
 	if mock.CreateOrderForMenuNameFunc == nil {
 		panic("OrderServiceMock.CreateOrderForMenuNameFunc: method is nil but OrderService.CreateOrderForMenuName was just called")
 	}
 	callInfo := struct {
 		Ctx         context.Context
 		CurrentUser *uuid.UUID
+		RoomID      string
 		MenuName    string
 	}{
 		Ctx:         ctx,
 		CurrentUser: currentUser,
+		RoomID:      roomID,
 		MenuName:    menuName,
 	}
 	mock.lockCreateOrderForMenuName.Lock()
 	mock.calls.CreateOrderForMenuName = append(mock.calls.CreateOrderForMenuName, callInfo)
 	mock.lockCreateOrderForMenuName.Unlock()
-	return mock.CreateOrderForMenuNameFunc(ctx, currentUser, menuName)
+	return mock.CreateOrderForMenuNameFunc(ctx, currentUser, roomID, menuName)
 }
 
 // CreateOrderForMenuNameCalls gets all the calls that were made to CreateOrderForMenuName.
@@ -265,11 +203,13 @@ func (mock *OrderServiceMock) CreateOrderForMenuName(ctx context.Context, curren
 func (mock *OrderServiceMock) CreateOrderForMenuNameCalls() []struct {
 	Ctx         context.Context
 	CurrentUser *uuid.UUID
+	RoomID      string
 	MenuName    string
 } {
 	var calls []struct {
 		Ctx         context.Context
 		CurrentUser *uuid.UUID
+		RoomID      string
 		MenuName    string
 	}
 	mock.lockCreateOrderForMenuName.RLock()
@@ -278,75 +218,39 @@ func (mock *OrderServiceMock) CreateOrderForMenuNameCalls() []struct {
 	return calls
 }
 
-// GetActiveOrderByMenu calls GetActiveOrderByMenuFunc.
-func (mock *OrderServiceMock) GetActiveOrderByMenu(ctx context.Context, menuUUID *uuid.UUID) (*entity.Order, error) {
-	if mock.GetActiveOrderByMenuFunc == nil {
-		panic("OrderServiceMock.GetActiveOrderByMenuFunc: method is nil but OrderService.GetActiveOrderByMenu was just called")
+// GetAllOrderItems calls GetAllOrderItemsFunc.
+func (mock *OrderServiceMock) GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
+	if mock.GetAllOrderItemsFunc == nil {
+		panic("OrderServiceMock.GetAllOrderItemsFunc: method is nil but OrderService.GetAllOrderItems was just called")
 	}
 	callInfo := struct {
-		Ctx      context.Context
-		MenuUUID *uuid.UUID
+		Ctx       context.Context
+		OrderUUID *uuid.UUID
 	}{
-		Ctx:      ctx,
-		MenuUUID: menuUUID,
+		Ctx:       ctx,
+		OrderUUID: orderUUID,
 	}
-	mock.lockGetActiveOrderByMenu.Lock()
-	mock.calls.GetActiveOrderByMenu = append(mock.calls.GetActiveOrderByMenu, callInfo)
-	mock.lockGetActiveOrderByMenu.Unlock()
-	return mock.GetActiveOrderByMenuFunc(ctx, menuUUID)
+	mock.lockGetAllOrderItems.Lock()
+	mock.calls.GetAllOrderItems = append(mock.calls.GetAllOrderItems, callInfo)
+	mock.lockGetAllOrderItems.Unlock()
+	return mock.GetAllOrderItemsFunc(ctx, orderUUID)
 }
 
-// GetActiveOrderByMenuCalls gets all the calls that were made to GetActiveOrderByMenu.
+// GetAllOrderItemsCalls gets all the calls that were made to GetAllOrderItems.
 // Check the length with:
 //
-//	len(mockedOrderService.GetActiveOrderByMenuCalls())
-func (mock *OrderServiceMock) GetActiveOrderByMenuCalls() []struct {
-	Ctx      context.Context
-	MenuUUID *uuid.UUID
+//	len(mockedOrderService.GetAllOrderItemsCalls())
+func (mock *OrderServiceMock) GetAllOrderItemsCalls() []struct {
+	Ctx       context.Context
+	OrderUUID *uuid.UUID
 } {
 	var calls []struct {
-		Ctx      context.Context
-		MenuUUID *uuid.UUID
+		Ctx       context.Context
+		OrderUUID *uuid.UUID
 	}
-	mock.lockGetActiveOrderByMenu.RLock()
-	calls = mock.calls.GetActiveOrderByMenu
-	mock.lockGetActiveOrderByMenu.RUnlock()
-	return calls
-}
-
-// GetActiveOrderByMenuName calls GetActiveOrderByMenuNameFunc.
-func (mock *OrderServiceMock) GetActiveOrderByMenuName(ctx context.Context, name string) (*entity.Order, error) {
-	if mock.GetActiveOrderByMenuNameFunc == nil {
-		panic("OrderServiceMock.GetActiveOrderByMenuNameFunc: method is nil but OrderService.GetActiveOrderByMenuName was just called")
-	}
-	callInfo := struct {
-		Ctx  context.Context
-		Name string
-	}{
-		Ctx:  ctx,
-		Name: name,
-	}
-	mock.lockGetActiveOrderByMenuName.Lock()
-	mock.calls.GetActiveOrderByMenuName = append(mock.calls.GetActiveOrderByMenuName, callInfo)
-	mock.lockGetActiveOrderByMenuName.Unlock()
-	return mock.GetActiveOrderByMenuNameFunc(ctx, name)
-}
-
-// GetActiveOrderByMenuNameCalls gets all the calls that were made to GetActiveOrderByMenuName.
-// Check the length with:
-//
-//	len(mockedOrderService.GetActiveOrderByMenuNameCalls())
-func (mock *OrderServiceMock) GetActiveOrderByMenuNameCalls() []struct {
-	Ctx  context.Context
-	Name string
-} {
-	var calls []struct {
-		Ctx  context.Context
-		Name string
-	}
-	mock.lockGetActiveOrderByMenuName.RLock()
-	calls = mock.calls.GetActiveOrderByMenuName
-	mock.lockGetActiveOrderByMenuName.RUnlock()
+	mock.lockGetAllOrderItems.RLock()
+	calls = mock.calls.GetAllOrderItems
+	mock.lockGetAllOrderItems.RUnlock()
 	return calls
 }
 
@@ -418,46 +322,42 @@ func (mock *OrderServiceMock) GetOrderCalls() []struct {
 	return calls
 }
 
-// UpdateOrder calls UpdateOrderFunc.
-func (mock *OrderServiceMock) UpdateOrder(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
-	if mock.UpdateOrderFunc == nil {
-		panic("OrderServiceMock.UpdateOrderFunc: method is nil but OrderService.UpdateOrder was just called")
+// RemoveOrderItem calls RemoveOrderItemFunc.
+func (mock *OrderServiceMock) RemoveOrderItem(ctx context.Context, currentUser *uuid.UUID, orderItemUUID *uuid.UUID) error {
+	if mock.RemoveOrderItemFunc == nil {
+		panic("OrderServiceMock.RemoveOrderItemFunc: method is nil but OrderService.RemoveOrderItem was just called")
 	}
 	callInfo := struct {
-		Ctx          context.Context
-		CurrentUser  *uuid.UUID
-		UuidMoqParam *uuid.UUID
-		Order        *entity.Order
+		Ctx           context.Context
+		CurrentUser   *uuid.UUID
+		OrderItemUUID *uuid.UUID
 	}{
-		Ctx:          ctx,
-		CurrentUser:  currentUser,
-		UuidMoqParam: uuidMoqParam,
-		Order:        order,
+		Ctx:           ctx,
+		CurrentUser:   currentUser,
+		OrderItemUUID: orderItemUUID,
 	}
-	mock.lockUpdateOrder.Lock()
-	mock.calls.UpdateOrder = append(mock.calls.UpdateOrder, callInfo)
-	mock.lockUpdateOrder.Unlock()
-	return mock.UpdateOrderFunc(ctx, currentUser, uuidMoqParam, order)
+	mock.lockRemoveOrderItem.Lock()
+	mock.calls.RemoveOrderItem = append(mock.calls.RemoveOrderItem, callInfo)
+	mock.lockRemoveOrderItem.Unlock()
+	return mock.RemoveOrderItemFunc(ctx, currentUser, orderItemUUID)
 }
 
-// UpdateOrderCalls gets all the calls that were made to UpdateOrder.
+// RemoveOrderItemCalls gets all the calls that were made to RemoveOrderItem.
 // Check the length with:
 //
-//	len(mockedOrderService.UpdateOrderCalls())
-func (mock *OrderServiceMock) UpdateOrderCalls() []struct {
-	Ctx          context.Context
-	CurrentUser  *uuid.UUID
-	UuidMoqParam *uuid.UUID
-	Order        *entity.Order
+//	len(mockedOrderService.RemoveOrderItemCalls())
+func (mock *OrderServiceMock) RemoveOrderItemCalls() []struct {
+	Ctx           context.Context
+	CurrentUser   *uuid.UUID
+	OrderItemUUID *uuid.UUID
 } {
 	var calls []struct {
-		Ctx          context.Context
-		CurrentUser  *uuid.UUID
-		UuidMoqParam *uuid.UUID
-		Order        *entity.Order
+		Ctx           context.Context
+		CurrentUser   *uuid.UUID
+		OrderItemUUID *uuid.UUID
 	}
-	mock.lockUpdateOrder.RLock()
-	calls = mock.calls.UpdateOrder
-	mock.lockUpdateOrder.RUnlock()
+	mock.lockRemoveOrderItem.RLock()
+	calls = mock.calls.RemoveOrderItem
+	mock.lockRemoveOrderItem.RUnlock()
 	return calls
 }
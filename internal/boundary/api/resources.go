@@ -0,0 +1,187 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func (b *Boundary) getAllMenus(c echo.Context) error {
+	menus, err := b.menuService.GetAllMenus(c.Request().Context())
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, menus)
+}
+
+func (b *Boundary) getMenu(c echo.Context) error {
+	menuUUID, err := uuidParam(c, "uuid")
+	if err != nil {
+		return err
+	}
+
+	menu, err := b.menuService.GetMenu(c.Request().Context(), menuUUID)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, menu)
+}
+
+func (b *Boundary) getAllOrders(c echo.Context) error {
+	orders, err := b.orderService.GetAllOrders(c.Request().Context())
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, orders)
+}
+
+func (b *Boundary) getOrder(c echo.Context) error {
+	orderUUID, err := uuidParam(c, "uuid")
+	if err != nil {
+		return err
+	}
+
+	order, err := b.orderService.GetOrder(c.Request().Context(), orderUUID)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, order)
+}
+
+// createOrder starts a new order for the menu_uuid of the request body with
+// the caller as initiator.
+func (b *Boundary) createOrder(c echo.Context) error {
+	var req entity.Order
+	if err := c.Bind(&req); err != nil || req.MenuUUID == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "menu_uuid is required")
+	}
+
+	ctx := c.Request().Context()
+
+	menu, err := b.menuService.GetMenu(ctx, req.MenuUUID)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	order, err := b.orderService.CreateOrderForMenuName(ctx, currentPrincipal(c).UserUUID, entity.NoRoom, menu.Name)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, order)
+}
+
+func (b *Boundary) getAllOrderItems(c echo.Context) error {
+	orderUUID, err := uuidParam(c, "uuid")
+	if err != nil {
+		return err
+	}
+
+	orderItems, err := b.orderService.GetAllOrderItems(c.Request().Context(), orderUUID)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, orderItems)
+}
+
+// createOrderItem adds the menu_item_uuid of the request body to the order
+// for the caller. Price and user are always taken from the menu and the caller.
+func (b *Boundary) createOrderItem(c echo.Context) error {
+	orderUUID, err := uuidParam(c, "uuid")
+	if err != nil {
+		return err
+	}
+
+	var req entity.OrderItem
+	if err = c.Bind(&req); err != nil || req.MenuItemUUID == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "menu_item_uuid is required")
+	}
+
+	ctx := c.Request().Context()
+
+	order, err := b.orderService.GetOrder(ctx, orderUUID)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	if order.State != entity.Open {
+		return httpError(c, service.ErrOrderNotOpen)
+	}
+
+	menu, err := b.menuService.GetMenu(ctx, order.MenuUUID)
+	if err != nil {
+		return httpError(c, err)
+	}
+
+	for _, menuItem := range menu.Items {
+		if *menuItem.UUID != *req.MenuItemUUID {
+			continue
+		}
+
+		// the room of the order makes sure the item ends up in this order
+		err = b.orderService.AddOrderItemToOrderByName(ctx, currentPrincipal(c).UserUUID, order.RoomID, menuItem.ShortName, menu.Name)
+		if err != nil {
+			return httpError(c, err)
+		}
+
+		return c.NoContent(http.StatusCreated)
+	}
+
+	return httpError(c, repository.ErrMenuItemNotFound)
+}
+
+func (b *Boundary) deleteOrderItem(c echo.Context) error {
+	orderItemUUID, err := uuidParam(c, "item_uuid")
+	if err != nil {
+		return err
+	}
+
+	if err = b.orderService.RemoveOrderItem(c.Request().Context(), currentPrincipal(c).UserUUID, orderItemUUID); err != nil {
+		return httpError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func uuidParam(c echo.Context, name string) (*uuid.UUID, error) {
+	value, err := uuid.FromString(c.Param(name))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid "+name)
+	}
+
+	return &value, nil
+}
+
+// httpError maps errors of the services to status codes. Unexpected errors
+// are logged and hidden from the caller.
+func httpError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrMenuNotFound),
+		errors.Is(err, repository.ErrMenuItemNotFound),
+		errors.Is(err, repository.ErrOrderNotFound),
+		errors.Is(err, repository.ErrOrderItemNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied),
+		errors.Is(err, service.ErrNotOrderItemOwner):
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrActiveOrderForMenuAlreadyExists),
+		errors.Is(err, service.ErrOrderNotOpen):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+
+	log.Ctx(c.Request().Context()).Error().Err(err).Msgf("%s %s failed", c.Request().Method, c.Path())
+
+	return echo.NewHTTPError(http.StatusInternalServerError, "internal server error")
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+type totpEnrollResponse struct {
+	// ProvisioningURI is the otpauth uri to show as qr code.
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type totpCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type totpConfirmResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (b *Boundary) enrollTOTP(c echo.Context) error {
+	uri, err := b.userService.EnrollTOTP(c.Request().Context(), currentPrincipal(c).UserUUID)
+	if err != nil {
+		return totpError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, totpEnrollResponse{ProvisioningURI: uri})
+}
+
+func (b *Boundary) confirmTOTP(c echo.Context) error {
+	var req totpCodeRequest
+	if err := c.Bind(&req); err != nil || req.Code == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "code is required")
+	}
+
+	recoveryCodes, err := b.userService.ConfirmTOTP(c.Request().Context(), currentPrincipal(c).UserUUID, req.Code)
+	if err != nil {
+		return totpError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, totpConfirmResponse{RecoveryCodes: recoveryCodes})
+}
+
+func (b *Boundary) disableTOTP(c echo.Context) error {
+	var req totpCodeRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request")
+	}
+
+	if err := b.userService.DisableTOTP(c.Request().Context(), currentPrincipal(c).UserUUID, req.Code); err != nil {
+		return totpError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func totpError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidTOTPCode):
+		return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, service.ErrTOTPAlreadyEnrolled):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case errors.Is(err, repository.ErrTOTPNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return httpError(c, err)
+}
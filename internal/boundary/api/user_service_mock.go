@@ -0,0 +1,313 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package api
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that UserServiceMock does implement UserService.
+// If this is not the case, regenerate this file with moq.
+var _ UserService = &UserServiceMock{}
+
+// UserServiceMock is a mock implementation of UserService.
+//
+//	func TestSomethingThatUsesUserService(t *testing.T) {
+//
+//		// make and configure a mocked UserService
+//		mockedUserService := &UserServiceMock{
+//			AuthenticateAPITokenFunc: func(ctx context.Context, token string) (*entity.APIToken, error) {
+//				panic("mock out the AuthenticateAPIToken method")
+//			},
+//			ConfirmTOTPFunc: func(ctx context.Context, userUUID *uuid.UUID, code string) ([]string, error) {
+//				panic("mock out the ConfirmTOTP method")
+//			},
+//			DisableTOTPFunc: func(ctx context.Context, userUUID *uuid.UUID, code string) error {
+//				panic("mock out the DisableTOTP method")
+//			},
+//			EnrollTOTPFunc: func(ctx context.Context, userUUID *uuid.UUID) (string, error) {
+//				panic("mock out the EnrollTOTP method")
+//			},
+//			LoginFunc: func(ctx context.Context, username string, password string, totpCode string, linkCode string) (*entity.User, error) {
+//				panic("mock out the Login method")
+//			},
+//		}
+//
+//		// use mockedUserService in code that requires UserService
+//		// and then make assertions.
+//
+//	}
+type UserServiceMock struct {
+	// AuthenticateAPITokenFunc mocks the AuthenticateAPIToken method.
+	AuthenticateAPITokenFunc func(ctx context.Context, token string) (*entity.APIToken, error)
+
+	// ConfirmTOTPFunc mocks the ConfirmTOTP method.
+	ConfirmTOTPFunc func(ctx context.Context, userUUID *uuid.UUID, code string) ([]string, error)
+
+	// DisableTOTPFunc mocks the DisableTOTP method.
+	DisableTOTPFunc func(ctx context.Context, userUUID *uuid.UUID, code string) error
+
+	// EnrollTOTPFunc mocks the EnrollTOTP method.
+	EnrollTOTPFunc func(ctx context.Context, userUUID *uuid.UUID) (string, error)
+
+	// LoginFunc mocks the Login method.
+	LoginFunc func(ctx context.Context, username string, password string, totpCode string, linkCode string) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AuthenticateAPIToken holds details about calls to the AuthenticateAPIToken method.
+		AuthenticateAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
+		// ConfirmTOTP holds details about calls to the ConfirmTOTP method.
+		ConfirmTOTP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Code is the code argument value.
+			Code string
+		}
+		// DisableTOTP holds details about calls to the DisableTOTP method.
+		DisableTOTP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Code is the code argument value.
+			Code string
+		}
+		// EnrollTOTP holds details about calls to the EnrollTOTP method.
+		EnrollTOTP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// Login holds details about calls to the Login method.
+		Login []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+			// Password is the password argument value.
+			Password string
+			// TotpCode is the totpCode argument value.
+			TotpCode string
+			// LinkCode is the linkCode argument value.
+			LinkCode string
+		}
+	}
+	lockAuthenticateAPIToken sync.RWMutex
+	lockConfirmTOTP          sync.RWMutex
+	lockDisableTOTP          sync.RWMutex
+	lockEnrollTOTP           sync.RWMutex
+	lockLogin                sync.RWMutex
+}
+
+// AuthenticateAPIToken calls AuthenticateAPITokenFunc.
+func (mock *UserServiceMock) AuthenticateAPIToken(ctx context.Context, token string) (*entity.APIToken, error) {
+	if mock.AuthenticateAPITokenFunc == nil {
+		panic("UserServiceMock.AuthenticateAPITokenFunc: method is nil but UserService.AuthenticateAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockAuthenticateAPIToken.Lock()
+	mock.calls.AuthenticateAPIToken = append(mock.calls.AuthenticateAPIToken, callInfo)
+	mock.lockAuthenticateAPIToken.Unlock()
+	return mock.AuthenticateAPITokenFunc(ctx, token)
+}
+
+// AuthenticateAPITokenCalls gets all the calls that were made to AuthenticateAPIToken.
+// Check the length with:
+//
+//	len(mockedUserService.AuthenticateAPITokenCalls())
+func (mock *UserServiceMock) AuthenticateAPITokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockAuthenticateAPIToken.RLock()
+	calls = mock.calls.AuthenticateAPIToken
+	mock.lockAuthenticateAPIToken.RUnlock()
+	return calls
+}
+
+// ConfirmTOTP calls ConfirmTOTPFunc.
+func (mock *UserServiceMock) ConfirmTOTP(ctx context.Context, userUUID *uuid.UUID, code string) ([]string, error) {
+	if mock.ConfirmTOTPFunc == nil {
+		panic("UserServiceMock.ConfirmTOTPFunc: method is nil but UserService.ConfirmTOTP was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Code     string
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Code:     code,
+	}
+	mock.lockConfirmTOTP.Lock()
+	mock.calls.ConfirmTOTP = append(mock.calls.ConfirmTOTP, callInfo)
+	mock.lockConfirmTOTP.Unlock()
+	return mock.ConfirmTOTPFunc(ctx, userUUID, code)
+}
+
+// ConfirmTOTPCalls gets all the calls that were made to ConfirmTOTP.
+// Check the length with:
+//
+//	len(mockedUserService.ConfirmTOTPCalls())
+func (mock *UserServiceMock) ConfirmTOTPCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Code     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Code     string
+	}
+	mock.lockConfirmTOTP.RLock()
+	calls = mock.calls.ConfirmTOTP
+	mock.lockConfirmTOTP.RUnlock()
+	return calls
+}
+
+// DisableTOTP calls DisableTOTPFunc.
+func (mock *UserServiceMock) DisableTOTP(ctx context.Context, userUUID *uuid.UUID, code string) error {
+	if mock.DisableTOTPFunc == nil {
+		panic("UserServiceMock.DisableTOTPFunc: method is nil but UserService.DisableTOTP was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Code     string
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Code:     code,
+	}
+	mock.lockDisableTOTP.Lock()
+	mock.calls.DisableTOTP = append(mock.calls.DisableTOTP, callInfo)
+	mock.lockDisableTOTP.Unlock()
+	return mock.DisableTOTPFunc(ctx, userUUID, code)
+}
+
+// DisableTOTPCalls gets all the calls that were made to DisableTOTP.
+// Check the length with:
+//
+//	len(mockedUserService.DisableTOTPCalls())
+func (mock *UserServiceMock) DisableTOTPCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Code     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Code     string
+	}
+	mock.lockDisableTOTP.RLock()
+	calls = mock.calls.DisableTOTP
+	mock.lockDisableTOTP.RUnlock()
+	return calls
+}
+
+// EnrollTOTP calls EnrollTOTPFunc.
+func (mock *UserServiceMock) EnrollTOTP(ctx context.Context, userUUID *uuid.UUID) (string, error) {
+	if mock.EnrollTOTPFunc == nil {
+		panic("UserServiceMock.EnrollTOTPFunc: method is nil but UserService.EnrollTOTP was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockEnrollTOTP.Lock()
+	mock.calls.EnrollTOTP = append(mock.calls.EnrollTOTP, callInfo)
+	mock.lockEnrollTOTP.Unlock()
+	return mock.EnrollTOTPFunc(ctx, userUUID)
+}
+
+// EnrollTOTPCalls gets all the calls that were made to EnrollTOTP.
+// Check the length with:
+//
+//	len(mockedUserService.EnrollTOTPCalls())
+func (mock *UserServiceMock) EnrollTOTPCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockEnrollTOTP.RLock()
+	calls = mock.calls.EnrollTOTP
+	mock.lockEnrollTOTP.RUnlock()
+	return calls
+}
+
+// Login calls LoginFunc.
+func (mock *UserServiceMock) Login(ctx context.Context, username string, password string, totpCode string, linkCode string) (*entity.User, error) {
+	if mock.LoginFunc == nil {
+		panic("UserServiceMock.LoginFunc: method is nil but UserService.Login was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+		Password string
+		TotpCode string
+		LinkCode string
+	}{
+		Ctx:      ctx,
+		Username: username,
+		Password: password,
+		TotpCode: totpCode,
+		LinkCode: linkCode,
+	}
+	mock.lockLogin.Lock()
+	mock.calls.Login = append(mock.calls.Login, callInfo)
+	mock.lockLogin.Unlock()
+	return mock.LoginFunc(ctx, username, password, totpCode, linkCode)
+}
+
+// LoginCalls gets all the calls that were made to Login.
+// Check the length with:
+//
+//	len(mockedUserService.LoginCalls())
+func (mock *UserServiceMock) LoginCalls() []struct {
+	Ctx      context.Context
+	Username string
+	Password string
+	TotpCode string
+	LinkCode string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+		Password string
+		TotpCode string
+		LinkCode string
+	}
+	mock.lockLogin.RLock()
+	calls = mock.calls.Login
+	mock.lockLogin.RUnlock()
+	return calls
+}
@@ -5,56 +5,109 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/rs/zerolog/log"
 	"maunium.net/go/mautrix"
 	"maunium.net/go/mautrix/event"
 	"maunium.net/go/mautrix/id"
 
-	"github.com/Markus-Schwer/ordaa/internal/boundary/matrix/handler"
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/chat/command"
 	"github.com/Markus-Schwer/ordaa/internal/config"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/templates"
 )
 
 var ErrGettingDefaultSyncer = errors.New("getting DefaultSyncer")
 
-type CommandHandler interface {
-	Matches(ctx context.Context, evt *event.Event) bool
-	Handle(ctx context.Context, evt *event.Event) *handler.CommandResponse
+// maxAttachmentSize limits the size of files downloaded for commands.
+const maxAttachmentSize = 1 << 20
+
+// UserService manages the accounts of matrix users next to the user
+// management the commands need.
+type UserService interface {
+	command.UserService
+	RegisterMatrixUser(ctx context.Context, username, displayName string) (*entity.User, error)
+	EnsureMatrixUser(ctx context.Context, username, displayName string) (*entity.User, error)
+	UpdateMatrixDisplayName(ctx context.Context, username, displayName string) (*entity.User, error)
+	GetMatrixUserByUsername(ctx context.Context, username string) (*entity.MatrixUser, error)
+	LinkMatrixUser(ctx context.Context, code, username string) (*entity.User, error)
 }
 
 type Boundary struct {
 	cfg              *config.MatrixConfig
 	client           *mautrix.Client
 	startupTimestamp int64
-	handlers         []CommandHandler
+	handlers         []chat.Handler
+	userService      UserService
+	roomService      command.RoomService
+	// directMu serializes the lookup and creation of direct chats
+	directMu sync.Mutex
+	// namesMu guards displayNames
+	namesMu sync.Mutex
+	// displayNames caches the display name of room members
+	displayNames map[roomMember]string
+}
+
+type roomMember struct {
+	roomID id.RoomID
+	userID id.UserID
 }
 
 func NewMatrixBoundary(
 	ctx context.Context,
 	cfg *config.MatrixConfig,
-	userService handler.UserService,
-	orderService handler.OrderService,
+	userService UserService,
+	orderService command.OrderService,
+	menuService command.MenuService,
+	roomService command.RoomService,
+	tmpl *templates.Templates,
 ) (*Boundary, error) {
 	client, err := mautrix.NewClient(cfg.HomeserverURL, "", "")
 	if err != nil {
 		return nil, fmt.Errorf("creating matrix client: %w", err)
 	}
 
-	return &Boundary{
+	boundary := &Boundary{
 		cfg:              cfg,
 		client:           client,
 		startupTimestamp: time.Now().UnixMilli(),
-		handlers: []CommandHandler{
-			&handler.HelpHandler{},
-			&handler.StatusHandler{OrderService: orderService},
-			&handler.RegisterHandler{UserService: userService},
-			&handler.StartHandler{UserService: userService, OrderService: orderService},
-			&handler.AddHandler{UserService: userService, OrderService: orderService},
-			&handler.StateTransitionHandler{UserService: userService, OrderService: orderService},
-			&handler.UnrecognizedCommandHandler{}, // must be last handler in list, because it always matches
-		},
-	}, nil
+		userService:      userService,
+		roomService:      roomService,
+		displayNames:     map[roomMember]string{},
+	}
+
+	boundary.handlers = []chat.Handler{
+		&command.HelpHandler{},
+		&command.StatusHandler{OrderService: orderService, Templates: tmpl},
+		&command.CallSheetHandler{OrderService: orderService, Templates: tmpl},
+		&command.ReminderHandler{OrderService: orderService, Templates: tmpl},
+		&command.RegisterHandler{Accounts: boundary},
+		&command.StartHandler{OrderService: orderService},
+		&command.AddHandler{OrderService: orderService},
+		&command.HistoryHandler{OrderService: orderService},
+		&command.FavouriteHandler{OrderService: orderService},
+		&command.SameHandler{Accounts: boundary, OrderService: orderService},
+		&command.StateTransitionHandler{OrderService: orderService},
+		&command.ShareHandler{OrderService: orderService},
+		&command.AdminMenuHandler{MenuService: menuService},
+		&command.AdminItemHandler{MenuService: menuService},
+		&command.RoleHandler{UserService: userService, Accounts: boundary},
+		&command.LinkHandler{UserService: userService, Accounts: boundary, Messenger: boundary},
+		&command.SSHKeyHandler{UserService: userService},
+		&command.APITokenHandler{UserService: userService, Messenger: boundary},
+		&command.PasswordHandler{UserService: userService, Messenger: boundary},
+		&command.PrivacyHandler{UserService: userService, Accounts: boundary, Messenger: boundary},
+		&command.RoomHandler{RoomService: roomService, Permissions: boundary, AutoRegister: cfg.AutoRegister},
+		&command.LanguageHandler{UserService: userService},
+		&command.UnrecognizedCommandHandler{}, // must be last handler in list, because it always matches
+	}
+
+	return boundary, nil
 }
 
 func (m *Boundary) Start(ctx context.Context) error {
@@ -119,6 +172,8 @@ func (m *Boundary) listen(ctx context.Context) error {
 		m.handleMessageEvent(ctx, evt)
 	})
 
+	syncer.OnEventType(event.StateMember, m.handleMemberEvent)
+
 	if err := m.client.SyncWithContext(ctx); err != nil {
 		return fmt.Errorf("listening to matrix events: %w", err)
 	}
@@ -127,30 +182,374 @@ func (m *Boundary) listen(ctx context.Context) error {
 }
 
 func (m *Boundary) handleMessageEvent(ctx context.Context, evt *event.Event) {
-	msg := evt.Content.AsMessage().Body
-	if !strings.HasPrefix(msg, handler.MatrixCommandPrefix) {
+	// commands sent as a reply carry a quote of the original message
+	evt.Content.AsMessage().RemoveReplyFallback()
+
+	settings := m.roomSettings(ctx, evt.RoomID)
+	ctx = command.WithRoomSettings(ctx, settings)
+
+	req := &chat.Request{
+		Sender: evt.Sender.String(),
+		Room:   evt.RoomID.String(),
+		Text:   evt.Content.AsMessage().Body,
+	}
+
+	for _, h := range m.handlers {
+		if conversation, ok := h.(chat.ConversationHandler); ok && conversation.InConversation(ctx, req) {
+			req.User = m.syncUser(ctx, settings, evt.Sender)
+			ctx = m.withUserLanguage(ctx, req.User)
+
+			// the message may be a secret, so it is not logged
+			m.respond(ctx, evt, conversation.Handle(ctx, req))
+			return
+		}
+	}
+
+	if !command.IsCommand(ctx, req.Text) {
 		return
 	}
 
-	log.Ctx(ctx).Debug().Msgf("received message: %s", msg)
+	// only the command is logged, its arguments may contain secrets
+	log.Ctx(ctx).Debug().Msgf("received command: %s", commandName(req.Text))
+
+	req.User = m.syncUser(ctx, settings, evt.Sender)
+	ctx = m.withUserLanguage(ctx, req.User)
+	req.Attachments = m.attachments(ctx, evt)
 
-	for _, handler := range m.handlers {
-		if !handler.Matches(ctx, evt) {
+	for _, h := range m.handlers {
+		if !h.Matches(ctx, req) {
 			continue
 		}
 
-		resp := handler.Handle(ctx, evt)
-		if resp == nil {
-			log.Ctx(ctx).Warn().Msgf("command handler didn't return a response for message: %s", msg)
-			break
+		m.respond(ctx, evt, h.Handle(ctx, req))
+
+		break
+	}
+}
+
+func commandName(msg string) string {
+	fields := strings.Fields(msg)
+
+	return strings.Join(fields[:min(2, len(fields))], " ")
+}
+
+func (m *Boundary) respond(ctx context.Context, evt *event.Event, resp *chat.Response) {
+	if resp == nil {
+		log.Ctx(ctx).Warn().Msgf("command handler didn't return a response for event %s", evt.ID)
+		return
+	}
+
+	if resp.Redact {
+		if _, err := m.client.RedactEvent(ctx, evt.RoomID, evt.ID); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("redacting command")
+		}
+	}
+
+	if err := m.reply(ctx, evt.RoomID, evt.ID, resp.Msg, resp.HTML); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("handling command")
+	}
+}
+
+// roomSettings returns the settings of the room, falling back to the defaults
+// if they cannot be loaded.
+func (m *Boundary) roomSettings(ctx context.Context, roomID id.RoomID) *entity.RoomSettings {
+	settings, err := m.roomService.GetRoomSettings(ctx, roomID.String())
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("getting settings of room %s", roomID)
+		return &entity.RoomSettings{RoomID: roomID.String()}
+	}
+
+	return settings
+}
+
+// syncUser stores the display name of the sender before a command is
+// handled, registering the sender first if auto registration is enabled in
+// the room. It returns the account of the sender, nil if there is none or it
+// cannot be loaded.
+func (m *Boundary) syncUser(ctx context.Context, settings *entity.RoomSettings, userID id.UserID) *entity.User {
+	displayName := m.DisplayName(ctx, settings.RoomID, userID.String())
+
+	autoRegister := m.cfg.AutoRegister
+	if settings.AutoRegister != nil {
+		autoRegister = *settings.AutoRegister
+	}
+
+	// the user is looked up on every command instead of remembering synced
+	// users, accounts can be deleted in between
+	var (
+		user *entity.User
+		err  error
+	)
+
+	if autoRegister {
+		user, err = m.userService.EnsureMatrixUser(ctx, userID.String(), displayName)
+	} else {
+		user, err = m.userService.UpdateMatrixDisplayName(ctx, userID.String(), displayName)
+	}
+
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return nil
+	} else if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("syncing user %s", userID)
+		return nil
+	}
+
+	return user
+}
+
+// withUserLanguage returns a context in which replies are sent in the
+// language of the user, which is the one of the room for unregistered users.
+func (m *Boundary) withUserLanguage(ctx context.Context, user *entity.User) context.Context {
+	if user == nil {
+		return ctx
+	}
+
+	return command.WithUserLanguage(ctx, user.Language)
+}
+
+func (m *Boundary) handleMemberEvent(ctx context.Context, evt *event.Event) {
+	member := evt.Content.AsMember()
+	if evt.StateKey == nil || member.Membership != event.MembershipJoin {
+		return
+	}
+
+	userID := id.UserID(*evt.StateKey)
+
+	m.namesMu.Lock()
+	m.displayNames[roomMember{roomID: evt.RoomID, userID: userID}] = member.Displayname
+	m.namesMu.Unlock()
+
+	// the state of the initial sync is synced lazily on the next command
+	if evt.Timestamp < m.startupTimestamp || userID == m.client.UserID {
+		return
+	}
+
+	// joining a room only renames existing accounts, accounts are created by
+	// commands, so deleted accounts are not brought back by a join
+	_, err := m.userService.UpdateMatrixDisplayName(ctx, userID.String(), m.DisplayName(ctx, evt.RoomID.String(), userID.String()))
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		log.Ctx(ctx).Warn().Err(err).Msgf("updating display name of %s", userID)
+	}
+}
+
+// Register creates an account for the matrix user who sent req, named after
+// their display name in the room.
+func (m *Boundary) Register(ctx context.Context, req *chat.Request) (*entity.User, error) {
+	return m.userService.RegisterMatrixUser(ctx, req.Sender, m.DisplayName(ctx, req.Room, req.Sender))
+}
+
+// AccountUUID returns the account of the matrix user id.
+func (m *Boundary) AccountUUID(ctx context.Context, username string) (*uuid.UUID, error) {
+	user, err := m.userService.GetMatrixUserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.UserUUID, nil
+}
+
+// Link adds the matrix user who sent req to the account of the link code.
+func (m *Boundary) Link(ctx context.Context, req *chat.Request, code string) (*entity.User, error) {
+	return m.userService.LinkMatrixUser(ctx, code, req.Sender)
+}
+
+// DisplayName returns the display name of the user in the room, falling back
+// to the global display name of the user. An empty string is returned if the
+// user has none.
+func (m *Boundary) DisplayName(ctx context.Context, roomID, userID string) string {
+	key := roomMember{roomID: id.RoomID(roomID), userID: id.UserID(userID)}
+
+	m.namesMu.Lock()
+	displayName, ok := m.displayNames[key]
+	m.namesMu.Unlock()
+
+	if ok {
+		return displayName
+	}
+
+	member := event.MemberEventContent{}
+	if err := m.client.StateEvent(ctx, key.roomID, event.StateMember, userID, &member); err == nil {
+		displayName = member.Displayname
+	} else if profile, err := m.client.GetDisplayName(ctx, key.userID); err == nil {
+		displayName = profile.DisplayName
+	} else {
+		log.Ctx(ctx).Warn().Err(err).Msgf("getting display name of %s", userID)
+		return ""
+	}
+
+	m.namesMu.Lock()
+	m.displayNames[key] = displayName
+	m.namesMu.Unlock()
+
+	return displayName
+}
+
+// IsRoomAdmin reports whether the user may change the power levels of the
+// room, which is what makes a room admin in matrix.
+func (m *Boundary) IsRoomAdmin(ctx context.Context, roomID, userID string) (bool, error) {
+	powerLevels := event.PowerLevelsEventContent{}
+	if err := m.client.StateEvent(ctx, id.RoomID(roomID), event.StatePowerLevels, "", &powerLevels); err != nil {
+		return false, fmt.Errorf("getting power levels: %w", err)
+	}
+
+	return powerLevels.GetUserLevel(id.UserID(userID)) >= powerLevels.GetEventLevel(event.StatePowerLevels), nil
+}
+
+// attachments returns the file of the message, e.g. a file with the command
+// as caption, or else the file of the message it replies to.
+func (m *Boundary) attachments(ctx context.Context, evt *event.Event) []chat.Attachment {
+	content := evt.Content.AsMessage()
+	if attachment, ok := m.fileAttachment(content); ok {
+		return []chat.Attachment{attachment}
+	}
+
+	replyTo := content.RelatesTo.GetReplyTo()
+	if replyTo == "" {
+		return nil
+	}
+
+	repliedEvt, err := m.client.GetEvent(ctx, evt.RoomID, replyTo)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("getting replied event %s", replyTo)
+		return nil
+	}
+
+	if err = repliedEvt.Content.ParseRaw(repliedEvt.Type); err != nil && !errors.Is(err, event.ErrContentAlreadyParsed) {
+		log.Ctx(ctx).Warn().Err(err).Msgf("parsing replied event %s", replyTo)
+		return nil
+	}
+
+	repliedContent, ok := repliedEvt.Content.Parsed.(*event.MessageEventContent)
+	if !ok {
+		return nil
+	}
+
+	if attachment, ok := m.fileAttachment(repliedContent); ok {
+		return []chat.Attachment{attachment}
+	}
+
+	return nil
+}
+
+// fileAttachment returns the file of a file message, which is downloaded
+// when it is fetched.
+func (m *Boundary) fileAttachment(content *event.MessageEventContent) (chat.Attachment, bool) {
+	if content.MsgType != event.MsgFile || content.URL == "" {
+		return chat.Attachment{}, false
+	}
+
+	attachment := chat.Attachment{Name: content.FileName}
+	if attachment.Name == "" {
+		attachment.Name = content.Body
+	}
+
+	if content.Info != nil {
+		attachment.MimeType = content.Info.MimeType
+	}
+
+	attachment.Fetch = func(ctx context.Context) ([]byte, error) {
+		if content.Info != nil && content.Info.Size > maxAttachmentSize {
+			return nil, fmt.Errorf("file is larger than %d bytes", maxAttachmentSize)
+		}
+
+		uri, err := content.URL.Parse()
+		if err != nil {
+			return nil, fmt.Errorf("parsing file url: %w", err)
 		}
 
-		if err := m.reply(ctx, evt.RoomID, evt.ID, resp.Msg, resp.AsHTML); err != nil {
-			log.Ctx(ctx).Warn().Err(err).Msg("handling command")
+		data, err := m.client.DownloadBytes(ctx, uri)
+		if err != nil {
+			return nil, fmt.Errorf("downloading file: %w", err)
 		}
 
-		break
+		return data, nil
 	}
+
+	return attachment, true
+}
+
+// DirectRoom returns the direct chat with the user, which is created if the
+// bot has none with the user yet.
+func (m *Boundary) DirectRoom(ctx context.Context, username string) (string, error) {
+	roomID, err := m.directRoom(ctx, id.UserID(username))
+
+	return roomID.String(), err
+}
+
+// SendDirectMessage sends msg to the user in a direct chat, which is created
+// if the bot has none with the user yet.
+func (m *Boundary) SendDirectMessage(ctx context.Context, username, msg string) error {
+	roomID, err := m.directRoom(ctx, id.UserID(username))
+	if err != nil {
+		return err
+	}
+
+	if _, err = m.client.SendText(ctx, roomID, msg); err != nil {
+		return fmt.Errorf("sending direct message: %w", err)
+	}
+
+	return nil
+}
+
+// SendDirectFile uploads data and sends it to the user as a file in a direct
+// chat.
+func (m *Boundary) SendDirectFile(ctx context.Context, username, fileName, mimeType string, data []byte) error {
+	roomID, err := m.directRoom(ctx, id.UserID(username))
+	if err != nil {
+		return err
+	}
+
+	upload, err := m.client.UploadBytesWithName(ctx, data, mimeType, fileName)
+	if err != nil {
+		return fmt.Errorf("uploading file: %w", err)
+	}
+
+	content := &event.MessageEventContent{
+		MsgType:  event.MsgFile,
+		Body:     fileName,
+		FileName: fileName,
+		URL:      upload.ContentURI.CUString(),
+		Info:     &event.FileInfo{MimeType: mimeType, Size: len(data)},
+	}
+
+	if _, err = m.client.SendMessageEvent(ctx, roomID, event.EventMessage, content); err != nil {
+		return fmt.Errorf("sending file: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Boundary) directRoom(ctx context.Context, userID id.UserID) (id.RoomID, error) {
+	m.directMu.Lock()
+	defer m.directMu.Unlock()
+
+	direct := event.DirectChatsEventContent{}
+
+	err := m.client.GetAccountData(ctx, event.AccountDataDirectChats.Type, &direct)
+	if err != nil && !errors.Is(err, mautrix.MNotFound) {
+		return "", fmt.Errorf("getting direct chats: %w", err)
+	}
+
+	if rooms := direct[userID]; len(rooms) > 0 {
+		return rooms[len(rooms)-1], nil
+	}
+
+	resp, err := m.client.CreateRoom(ctx, &mautrix.ReqCreateRoom{
+		Preset:   "trusted_private_chat",
+		IsDirect: true,
+		Invite:   []id.UserID{userID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating direct chat: %w", err)
+	}
+
+	direct[userID] = append(direct[userID], resp.RoomID)
+
+	if err = m.client.SetAccountData(ctx, event.AccountDataDirectChats.Type, direct); err != nil {
+		return "", fmt.Errorf("storing direct chat: %w", err)
+	}
+
+	return resp.RoomID, nil
 }
 
 // func (m *MatrixBoundary) message(ctx context.Context, room id.RoomID, content string) error {
@@ -169,7 +568,7 @@ func (m *Boundary) handleMessageEvent(ctx context.Context, evt *event.Event) {
 //	return nil
 //}
 
-func (m *Boundary) reply(ctx context.Context, room id.RoomID, evt id.EventID, content string, asHTML bool) error {
+func (m *Boundary) reply(ctx context.Context, room id.RoomID, evt id.EventID, content, html string) error {
 	contentJSON := map[string]any{
 		"m.relates_to": map[string]any{
 			"m.in_reply_to": map[string]any{
@@ -180,9 +579,9 @@ func (m *Boundary) reply(ctx context.Context, room id.RoomID, evt id.EventID, co
 		"body":    content,
 	}
 
-	if asHTML {
+	if html != "" {
 		contentJSON["format"] = "org.matrix.custom.html"
-		contentJSON["formatted_body"] = strings.TrimSuffix(content, "\n")
+		contentJSON["formatted_body"] = html
 	}
 
 	if _, err := m.client.SendMessageEvent(ctx, room, event.EventMessage, contentJSON); err != nil {
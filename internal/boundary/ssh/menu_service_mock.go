@@ -0,0 +1,76 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package ssh
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"sync"
+)
+
+// Ensure, that MenuServiceMock does implement MenuService.
+// If this is not the case, regenerate this file with moq.
+var _ MenuService = &MenuServiceMock{}
+
+// MenuServiceMock is a mock implementation of MenuService.
+//
+//	func TestSomethingThatUsesMenuService(t *testing.T) {
+//
+//		// make and configure a mocked MenuService
+//		mockedMenuService := &MenuServiceMock{
+//			GetAllMenusFunc: func(ctx context.Context) ([]entity.Menu, error) {
+//				panic("mock out the GetAllMenus method")
+//			},
+//		}
+//
+//		// use mockedMenuService in code that requires MenuService
+//		// and then make assertions.
+//
+//	}
+type MenuServiceMock struct {
+	// GetAllMenusFunc mocks the GetAllMenus method.
+	GetAllMenusFunc func(ctx context.Context) ([]entity.Menu, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetAllMenus holds details about calls to the GetAllMenus method.
+		GetAllMenus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockGetAllMenus sync.RWMutex
+}
+
+// GetAllMenus calls GetAllMenusFunc.
+func (mock *MenuServiceMock) GetAllMenus(ctx context.Context) ([]entity.Menu, error) {
+	if mock.GetAllMenusFunc == nil {
+		panic("MenuServiceMock.GetAllMenusFunc: method is nil but MenuService.GetAllMenus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllMenus.Lock()
+	mock.calls.GetAllMenus = append(mock.calls.GetAllMenus, callInfo)
+	mock.lockGetAllMenus.Unlock()
+	return mock.GetAllMenusFunc(ctx)
+}
+
+// GetAllMenusCalls gets all the calls that were made to GetAllMenus.
+// Check the length with:
+//
+//	len(mockedMenuService.GetAllMenusCalls())
+func (mock *MenuServiceMock) GetAllMenusCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllMenus.RLock()
+	calls = mock.calls.GetAllMenus
+	mock.lockGetAllMenus.RUnlock()
+	return calls
+}
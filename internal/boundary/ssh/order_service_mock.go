@@ -0,0 +1,325 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package ssh
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that OrderServiceMock does implement OrderService.
+// If this is not the case, regenerate this file with moq.
+var _ OrderService = &OrderServiceMock{}
+
+// OrderServiceMock is a mock implementation of OrderService.
+//
+//	func TestSomethingThatUsesOrderService(t *testing.T) {
+//
+//		// make and configure a mocked OrderService
+//		mockedOrderService := &OrderServiceMock{
+//			AddOrderItemToOrderByNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, shortName string, menuName string) error {
+//				panic("mock out the AddOrderItemToOrderByName method")
+//			},
+//			CreateOrderForMenuNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error) {
+//				panic("mock out the CreateOrderForMenuName method")
+//			},
+//			GetActiveOrderByMenuFunc: func(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+//				panic("mock out the GetActiveOrderByMenu method")
+//			},
+//			GetAllOrderItemsFunc: func(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
+//				panic("mock out the GetAllOrderItems method")
+//			},
+//			RemoveOrderItemFunc: func(ctx context.Context, currentUser *uuid.UUID, orderItemUUID *uuid.UUID) error {
+//				panic("mock out the RemoveOrderItem method")
+//			},
+//		}
+//
+//		// use mockedOrderService in code that requires OrderService
+//		// and then make assertions.
+//
+//	}
+type OrderServiceMock struct {
+	// AddOrderItemToOrderByNameFunc mocks the AddOrderItemToOrderByName method.
+	AddOrderItemToOrderByNameFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, shortName string, menuName string) error
+
+	// CreateOrderForMenuNameFunc mocks the CreateOrderForMenuName method.
+	CreateOrderForMenuNameFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error)
+
+	// GetActiveOrderByMenuFunc mocks the GetActiveOrderByMenu method.
+	GetActiveOrderByMenuFunc func(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error)
+
+	// GetAllOrderItemsFunc mocks the GetAllOrderItems method.
+	GetAllOrderItemsFunc func(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error)
+
+	// RemoveOrderItemFunc mocks the RemoveOrderItem method.
+	RemoveOrderItemFunc func(ctx context.Context, currentUser *uuid.UUID, orderItemUUID *uuid.UUID) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddOrderItemToOrderByName holds details about calls to the AddOrderItemToOrderByName method.
+		AddOrderItemToOrderByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// ShortName is the shortName argument value.
+			ShortName string
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// CreateOrderForMenuName holds details about calls to the CreateOrderForMenuName method.
+		CreateOrderForMenuName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// GetActiveOrderByMenu holds details about calls to the GetActiveOrderByMenu method.
+		GetActiveOrderByMenu []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuUUID is the menuUUID argument value.
+			MenuUUID *uuid.UUID
+		}
+		// GetAllOrderItems holds details about calls to the GetAllOrderItems method.
+		GetAllOrderItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderUUID is the orderUUID argument value.
+			OrderUUID *uuid.UUID
+		}
+		// RemoveOrderItem holds details about calls to the RemoveOrderItem method.
+		RemoveOrderItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// OrderItemUUID is the orderItemUUID argument value.
+			OrderItemUUID *uuid.UUID
+		}
+	}
+	lockAddOrderItemToOrderByName sync.RWMutex
+	lockCreateOrderForMenuName    sync.RWMutex
+	lockGetActiveOrderByMenu      sync.RWMutex
+	lockGetAllOrderItems          sync.RWMutex
+	lockRemoveOrderItem           sync.RWMutex
+}
+
+// AddOrderItemToOrderByName calls AddOrderItemToOrderByNameFunc.
+func (mock *OrderServiceMock) AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, roomID string, shortName string, menuName string) error {
+	if mock.AddOrderItemToOrderByNameFunc == nil {
+		panic("OrderServiceMock.AddOrderItemToOrderByNameFunc: method is nil but OrderService.AddOrderItemToOrderByName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		ShortName   string
+		MenuName    string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		ShortName:   shortName,
+		MenuName:    menuName,
+	}
+	mock.lockAddOrderItemToOrderByName.Lock()
+	mock.calls.AddOrderItemToOrderByName = append(mock.calls.AddOrderItemToOrderByName, callInfo)
+	mock.lockAddOrderItemToOrderByName.Unlock()
+	return mock.AddOrderItemToOrderByNameFunc(ctx, currentUser, roomID, shortName, menuName)
+}
+
+// AddOrderItemToOrderByNameCalls gets all the calls that were made to AddOrderItemToOrderByName.
+// Check the length with:
+//
+//	len(mockedOrderService.AddOrderItemToOrderByNameCalls())
+func (mock *OrderServiceMock) AddOrderItemToOrderByNameCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	ShortName   string
+	MenuName    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		ShortName   string
+		MenuName    string
+	}
+	mock.lockAddOrderItemToOrderByName.RLock()
+	calls = mock.calls.AddOrderItemToOrderByName
+	mock.lockAddOrderItemToOrderByName.RUnlock()
+	return calls
+}
+
+// CreateOrderForMenuName calls CreateOrderForMenuNameFunc.
+func (mock *OrderServiceMock) CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error) {
+	if mock.CreateOrderForMenuNameFunc == nil {
+		panic("OrderServiceMock.CreateOrderForMenuNameFunc: method is nil but OrderService.CreateOrderForMenuName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		MenuName:    menuName,
+	}
+	mock.lockCreateOrderForMenuName.Lock()
+	mock.calls.CreateOrderForMenuName = append(mock.calls.CreateOrderForMenuName, callInfo)
+	mock.lockCreateOrderForMenuName.Unlock()
+	return mock.CreateOrderForMenuNameFunc(ctx, currentUser, roomID, menuName)
+}
+
+// CreateOrderForMenuNameCalls gets all the calls that were made to CreateOrderForMenuName.
+// Check the length with:
+//
+//	len(mockedOrderService.CreateOrderForMenuNameCalls())
+func (mock *OrderServiceMock) CreateOrderForMenuNameCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	MenuName    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}
+	mock.lockCreateOrderForMenuName.RLock()
+	calls = mock.calls.CreateOrderForMenuName
+	mock.lockCreateOrderForMenuName.RUnlock()
+	return calls
+}
+
+// GetActiveOrderByMenu calls GetActiveOrderByMenuFunc.
+func (mock *OrderServiceMock) GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+	if mock.GetActiveOrderByMenuFunc == nil {
+		panic("OrderServiceMock.GetActiveOrderByMenuFunc: method is nil but OrderService.GetActiveOrderByMenu was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		RoomID:   roomID,
+		MenuUUID: menuUUID,
+	}
+	mock.lockGetActiveOrderByMenu.Lock()
+	mock.calls.GetActiveOrderByMenu = append(mock.calls.GetActiveOrderByMenu, callInfo)
+	mock.lockGetActiveOrderByMenu.Unlock()
+	return mock.GetActiveOrderByMenuFunc(ctx, roomID, menuUUID)
+}
+
+// GetActiveOrderByMenuCalls gets all the calls that were made to GetActiveOrderByMenu.
+// Check the length with:
+//
+//	len(mockedOrderService.GetActiveOrderByMenuCalls())
+func (mock *OrderServiceMock) GetActiveOrderByMenuCalls() []struct {
+	Ctx      context.Context
+	RoomID   string
+	MenuUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuUUID *uuid.UUID
+	}
+	mock.lockGetActiveOrderByMenu.RLock()
+	calls = mock.calls.GetActiveOrderByMenu
+	mock.lockGetActiveOrderByMenu.RUnlock()
+	return calls
+}
+
+// GetAllOrderItems calls GetAllOrderItemsFunc.
+func (mock *OrderServiceMock) GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
+	if mock.GetAllOrderItemsFunc == nil {
+		panic("OrderServiceMock.GetAllOrderItemsFunc: method is nil but OrderService.GetAllOrderItems was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		OrderUUID *uuid.UUID
+	}{
+		Ctx:       ctx,
+		OrderUUID: orderUUID,
+	}
+	mock.lockGetAllOrderItems.Lock()
+	mock.calls.GetAllOrderItems = append(mock.calls.GetAllOrderItems, callInfo)
+	mock.lockGetAllOrderItems.Unlock()
+	return mock.GetAllOrderItemsFunc(ctx, orderUUID)
+}
+
+// GetAllOrderItemsCalls gets all the calls that were made to GetAllOrderItems.
+// Check the length with:
+//
+//	len(mockedOrderService.GetAllOrderItemsCalls())
+func (mock *OrderServiceMock) GetAllOrderItemsCalls() []struct {
+	Ctx       context.Context
+	OrderUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		OrderUUID *uuid.UUID
+	}
+	mock.lockGetAllOrderItems.RLock()
+	calls = mock.calls.GetAllOrderItems
+	mock.lockGetAllOrderItems.RUnlock()
+	return calls
+}
+
+// RemoveOrderItem calls RemoveOrderItemFunc.
+func (mock *OrderServiceMock) RemoveOrderItem(ctx context.Context, currentUser *uuid.UUID, orderItemUUID *uuid.UUID) error {
+	if mock.RemoveOrderItemFunc == nil {
+		panic("OrderServiceMock.RemoveOrderItemFunc: method is nil but OrderService.RemoveOrderItem was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		CurrentUser   *uuid.UUID
+		OrderItemUUID *uuid.UUID
+	}{
+		Ctx:           ctx,
+		CurrentUser:   currentUser,
+		OrderItemUUID: orderItemUUID,
+	}
+	mock.lockRemoveOrderItem.Lock()
+	mock.calls.RemoveOrderItem = append(mock.calls.RemoveOrderItem, callInfo)
+	mock.lockRemoveOrderItem.Unlock()
+	return mock.RemoveOrderItemFunc(ctx, currentUser, orderItemUUID)
+}
+
+// RemoveOrderItemCalls gets all the calls that were made to RemoveOrderItem.
+// Check the length with:
+//
+//	len(mockedOrderService.RemoveOrderItemCalls())
+func (mock *OrderServiceMock) RemoveOrderItemCalls() []struct {
+	Ctx           context.Context
+	CurrentUser   *uuid.UUID
+	OrderItemUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx           context.Context
+		CurrentUser   *uuid.UUID
+		OrderItemUUID *uuid.UUID
+	}
+	mock.lockRemoveOrderItem.RLock()
+	calls = mock.calls.RemoveOrderItem
+	mock.lockRemoveOrderItem.RUnlock()
+	return calls
+}
@@ -0,0 +1,154 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	"github.com/charmbracelet/wish/activeterm"
+	"github.com/charmbracelet/wish/bubbletea"
+	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Markus-Schwer/ordaa/internal/config"
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+const (
+	idleTimeout     = 30 * time.Minute
+	shutdownTimeout = 5 * time.Second
+
+	// linkUser is the ssh user name that lets unknown keys in to enter a
+	// link code, e.g. ssh link@host.
+	linkUser = "link"
+)
+
+//go:generate go tool moq -rm -out user_service_mock.go . UserService
+
+type UserService interface {
+	GetUser(ctx context.Context, uuid *uuid.UUID) (*entity.User, error)
+	GetSSHUserByPublicKey(ctx context.Context, publicKey string) (*entity.SSHUser, error)
+	LinkSSHUser(ctx context.Context, code, publicKey string) (*entity.User, error)
+}
+
+//go:generate go tool moq -rm -out order_service_mock.go . OrderService
+
+type OrderService interface {
+	GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error)
+	GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error)
+	CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*entity.Order, error)
+	AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, roomID, shortName, menuName string) error
+	RemoveOrderItem(ctx context.Context, currentUser, orderItemUUID *uuid.UUID) error
+}
+
+//go:generate go tool moq -rm -out menu_service_mock.go . MenuService
+
+type MenuService interface {
+	GetAllMenus(ctx context.Context) ([]entity.Menu, error)
+}
+
+// Boundary serves the terminal ui over ssh. Connecting keys are looked up in
+// ssh_users, unknown keys can only connect as linkUser to be linked to an
+// account with a link code.
+type Boundary struct {
+	server       *ssh.Server
+	userService  UserService
+	orderService OrderService
+	menuService  MenuService
+}
+
+func NewSSHBoundary(
+	ctx context.Context,
+	cfg *config.SSHConfig,
+	userService UserService,
+	orderService OrderService,
+	menuService MenuService,
+) (*Boundary, error) {
+	boundary := &Boundary{
+		userService:  userService,
+		orderService: orderService,
+		menuService:  menuService,
+	}
+
+	server, err := wish.NewServer(
+		wish.WithAddress(cfg.Address),
+		wish.WithHostKeyPath(cfg.HostKeyPath),
+		wish.WithIdleTimeout(idleTimeout),
+		wish.WithPublicKeyAuth(func(ctx ssh.Context, key ssh.PublicKey) bool {
+			return boundary.authorizeKey(ctx, ctx.User(), publicKeyString(key))
+		}),
+		wish.WithMiddleware(
+			bubbletea.Middleware(boundary.teaHandler),
+			activeterm.Middleware(),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating ssh server: %w", err)
+	}
+
+	boundary.server = server
+
+	return boundary, nil
+}
+
+func (b *Boundary) Start(ctx context.Context) error {
+	log.Ctx(ctx).Info().Msgf("starting ssh server on %s", b.server.Addr)
+
+	if err := b.server.ListenAndServe(); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+		return fmt.Errorf("serving ssh: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Boundary) Stop() error {
+	log.Info().Msg("shutting down ssh boundary")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := b.server.Shutdown(ctx); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+		return fmt.Errorf("shutting down ssh server: %w", err)
+	}
+
+	return nil
+}
+
+// authorizeKey lets keys of an account in. Unknown keys are only let in when
+// the user asked for the link code prompt by connecting as linkUser, so
+// clients that offer several keys still log in with the known one. Keys that
+// cannot be checked are rejected.
+func (b *Boundary) authorizeKey(ctx context.Context, user, publicKey string) bool {
+	_, err := b.userService.GetSSHUserByPublicKey(ctx, publicKey)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return user == linkUser
+	} else if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("could not look up ssh key")
+		return false
+	}
+
+	return true
+}
+
+func (b *Boundary) teaHandler(sess ssh.Session) (tea.Model, []tea.ProgramOption) {
+	ctx := log.With().Str("boundary", "ssh").Str("remote", sess.RemoteAddr().String()).Logger().WithContext(sess.Context())
+
+	m := newModel(ctx, b.userService, b.orderService, b.menuService, publicKeyString(sess.PublicKey()))
+	m.styles = newStyles(bubbletea.MakeRenderer(sess))
+
+	return m, []tea.ProgramOption{tea.WithAltScreen()}
+}
+
+func publicKeyString(key ssh.PublicKey) string {
+	if key == nil {
+		return ""
+	}
+
+	return crypto.FormatPublicKey(key)
+}
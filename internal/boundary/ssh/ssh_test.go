@@ -0,0 +1,54 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+func TestAuthorizeKey(t *testing.T) {
+	ctx := t.Context()
+
+	const unknownKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKsVh6nH3Q8yF0o7Zr0Qb1mC2vE4aG9xS5tU8wY3zN6p"
+
+	userService := &UserServiceMock{
+		GetSSHUserByPublicKeyFunc: func(ctx context.Context, key string) (*entity.SSHUser, error) {
+			switch key {
+			case publicKey:
+				return &entity.SSHUser{UserUUID: &userUUID, PublicKey: key}, nil
+			case unknownKey:
+				return nil, repository.ErrUserNotFound
+			default:
+				return nil, errors.New("db down")
+			}
+		},
+	}
+
+	type testCase struct {
+		name      string
+		user      string
+		publicKey string
+		allowed   bool
+	}
+
+	testCases := []testCase{
+		{name: "known key", user: "luca", publicKey: publicKey, allowed: true},
+		{name: "known key as link user", user: linkUser, publicKey: publicKey, allowed: true},
+		{name: "unknown key", user: "luca", publicKey: unknownKey, allowed: false},
+		{name: "unknown key as link user", user: linkUser, publicKey: unknownKey, allowed: true},
+		{name: "lookup fails", user: linkUser, publicKey: "ssh-ed25519 broken", allowed: false},
+	}
+
+	b := &Boundary{userService: userService}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.allowed, b.authorizeKey(ctx, tc.user, tc.publicKey))
+		})
+	}
+}
@@ -0,0 +1,511 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/price"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+type screen int
+
+const (
+	screenLink screen = iota
+	screenMenus
+	screenMenu
+)
+
+// reservedLines is the space the header, help and status lines take up, the
+// rest of the terminal is used for lists.
+const reservedLines = 8
+
+type styles struct {
+	title    lipgloss.Style
+	selected lipgloss.Style
+	faint    lipgloss.Style
+	err      lipgloss.Style
+	column   lipgloss.Style
+}
+
+func newStyles(renderer *lipgloss.Renderer) styles {
+	return styles{
+		title:    renderer.NewStyle().Bold(true),
+		selected: renderer.NewStyle().Foreground(lipgloss.Color("212")).Bold(true),
+		faint:    renderer.NewStyle().Faint(true),
+		err:      renderer.NewStyle().Foreground(lipgloss.Color("9")),
+		column:   renderer.NewStyle().PaddingRight(4),
+	}
+}
+
+type model struct {
+	ctx          context.Context
+	userService  UserService
+	orderService OrderService
+	menuService  MenuService
+	styles       styles
+
+	publicKey string
+	user      *entity.User
+
+	screen screen
+	width  int
+	height int
+
+	// input holds the link code while it is typed
+	input string
+
+	menus      []entity.Menu
+	menuCursor int
+
+	// menu is the selected menu, order its active order if there is one
+	menu       *entity.Menu
+	items      []entity.MenuItem
+	itemCursor int
+	order      *entity.Order
+	orderItems []entity.OrderItem
+	ownItems   []entity.OrderItem
+	ownCursor  int
+	focusOwn   bool
+
+	status string
+	err    error
+}
+
+func newModel(ctx context.Context, userService UserService, orderService OrderService, menuService MenuService, publicKey string) *model {
+	m := &model{
+		ctx:          ctx,
+		userService:  userService,
+		orderService: orderService,
+		menuService:  menuService,
+		styles:       newStyles(lipgloss.DefaultRenderer()),
+		publicKey:    publicKey,
+		screen:       screenLink,
+	}
+
+	sshUser, err := userService.GetSSHUserByPublicKey(ctx, publicKey)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return m
+	} else if err != nil {
+		m.err = err
+		return m
+	}
+
+	if m.user, err = userService.GetUser(ctx, sshUser.UserUUID); err != nil {
+		m.err = err
+		return m
+	}
+
+	m.screen = screenMenus
+	m.loadMenus()
+
+	return m
+}
+
+func (m *model) Init() tea.Cmd {
+	return nil
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+
+		m.status = ""
+		m.err = nil
+
+		switch m.screen {
+		case screenLink:
+			return m, m.updateLink(msg)
+		case screenMenus:
+			return m, m.updateMenus(msg)
+		case screenMenu:
+			return m, m.updateMenu(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *model) updateLink(msg tea.KeyMsg) tea.Cmd {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return tea.Quit
+	case tea.KeyBackspace:
+		if m.input != "" {
+			m.input = m.input[:len(m.input)-1]
+		}
+	case tea.KeyEnter:
+		user, err := m.userService.LinkSSHUser(m.ctx, m.input, m.publicKey)
+		if err != nil {
+			m.err = err
+			return nil
+		}
+
+		m.user = user
+		m.input = ""
+		m.screen = screenMenus
+		m.status = "linked this key to your account"
+		m.loadMenus()
+	case tea.KeyRunes:
+		m.input += string(msg.Runes)
+	}
+
+	return nil
+}
+
+func (m *model) updateMenus(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "q", "esc":
+		return tea.Quit
+	case "up", "k":
+		m.menuCursor = max(m.menuCursor-1, 0)
+	case "down", "j":
+		m.menuCursor = min(m.menuCursor+1, len(m.menus)-1)
+	case "r":
+		m.loadMenus()
+	case "enter":
+		if len(m.menus) == 0 {
+			return nil
+		}
+
+		m.menu = &m.menus[m.menuCursor]
+		m.items = sortedMenuItems(m.menu.Items)
+		m.itemCursor = 0
+		m.ownCursor = 0
+		m.focusOwn = false
+		m.screen = screenMenu
+		m.loadOrder()
+	}
+
+	return nil
+}
+
+func (m *model) updateMenu(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "q":
+		return tea.Quit
+	case "esc", "backspace":
+		m.screen = screenMenus
+		m.loadMenus()
+	case "tab":
+		m.focusOwn = !m.focusOwn && len(m.ownItems) > 0
+	case "up", "k":
+		if m.focusOwn {
+			m.ownCursor = max(m.ownCursor-1, 0)
+		} else {
+			m.itemCursor = max(m.itemCursor-1, 0)
+		}
+	case "down", "j":
+		if m.focusOwn {
+			m.ownCursor = min(m.ownCursor+1, len(m.ownItems)-1)
+		} else {
+			m.itemCursor = min(m.itemCursor+1, len(m.items)-1)
+		}
+	case "r":
+		m.loadOrder()
+	case "s":
+		m.startOrder()
+	case "enter", "a":
+		if !m.focusOwn {
+			m.addItem()
+		}
+	case "d", "x":
+		if m.focusOwn {
+			m.removeItem()
+		}
+	}
+
+	return nil
+}
+
+func (m *model) loadMenus() {
+	menus, err := m.menuService.GetAllMenus(m.ctx)
+	if err != nil {
+		m.err = err
+		return
+	}
+
+	sort.Slice(menus, func(i, j int) bool {
+		return strings.ToLower(menus[i].Name) < strings.ToLower(menus[j].Name)
+	})
+
+	m.menus = menus
+	m.menuCursor = min(m.menuCursor, max(len(menus)-1, 0))
+}
+
+func (m *model) loadOrder() {
+	m.order = nil
+	m.orderItems = nil
+	m.ownItems = nil
+
+	order, err := m.orderService.GetActiveOrderByMenu(m.ctx, entity.NoRoom, m.menu.UUID)
+	if errors.Is(err, repository.ErrOrderNotFound) {
+		m.focusOwn = false
+		return
+	} else if err != nil {
+		m.err = err
+		return
+	}
+
+	orderItems, err := m.orderService.GetAllOrderItems(m.ctx, order.UUID)
+	if err != nil {
+		m.err = err
+		return
+	}
+
+	m.order = order
+	m.orderItems = orderItems
+
+	for _, orderItem := range orderItems {
+		if orderItem.User != nil && *orderItem.User == *m.user.UUID {
+			m.ownItems = append(m.ownItems, orderItem)
+		}
+	}
+
+	m.ownCursor = min(m.ownCursor, max(len(m.ownItems)-1, 0))
+	m.focusOwn = m.focusOwn && len(m.ownItems) > 0
+}
+
+func (m *model) startOrder() {
+	if m.order != nil {
+		m.status = fmt.Sprintf("there already is an active order for %s", m.menu.Name)
+		return
+	}
+
+	if _, err := m.orderService.CreateOrderForMenuName(m.ctx, m.user.UUID, entity.NoRoom, m.menu.Name); err != nil {
+		m.err = err
+		return
+	}
+
+	m.status = fmt.Sprintf("started order for %s", m.menu.Name)
+	m.loadOrder()
+}
+
+func (m *model) addItem() {
+	if len(m.items) == 0 {
+		return
+	}
+
+	if m.order == nil {
+		m.status = "there is no active order, press s to start one"
+		return
+	}
+
+	item := m.items[m.itemCursor]
+
+	if err := m.orderService.AddOrderItemToOrderByName(m.ctx, m.user.UUID, m.order.RoomID, item.ShortName, m.menu.Name); err != nil {
+		m.err = err
+		return
+	}
+
+	m.status = fmt.Sprintf("added %s (%s)", item.ShortName, item.Name)
+	m.loadOrder()
+}
+
+func (m *model) removeItem() {
+	if len(m.ownItems) == 0 {
+		return
+	}
+
+	orderItem := m.ownItems[m.ownCursor]
+
+	if err := m.orderService.RemoveOrderItem(m.ctx, m.user.UUID, orderItem.UUID); err != nil {
+		m.err = err
+		return
+	}
+
+	m.status = fmt.Sprintf("removed %s", m.itemName(orderItem.MenuItemUUID))
+	m.loadOrder()
+}
+
+func (m *model) View() string {
+	var view strings.Builder
+
+	switch m.screen {
+	case screenLink:
+		view.WriteString(m.viewLink())
+	case screenMenus:
+		view.WriteString(m.viewMenus())
+	case screenMenu:
+		view.WriteString(m.viewMenu())
+	}
+
+	view.WriteString("\n")
+
+	if m.err != nil {
+		view.WriteString(m.styles.err.Render(m.err.Error()))
+	} else if m.status != "" {
+		view.WriteString(m.status)
+	}
+
+	view.WriteString("\n")
+
+	return view.String()
+}
+
+func (m *model) viewLink() string {
+	var view strings.Builder
+
+	view.WriteString(m.styles.title.Render("ordaa") + "\n\n")
+	view.WriteString("This key is not linked to an account yet.\n")
+	view.WriteString("Send '.ordaa link' in matrix and enter the code you get here,\n")
+	view.WriteString("or add the key with '.ordaa ssh-key add <key>' and reconnect.\n\n")
+	view.WriteString("link code: " + m.input + "█\n\n")
+	view.WriteString(m.styles.faint.Render("enter link • esc quit"))
+
+	return view.String()
+}
+
+func (m *model) viewMenus() string {
+	var view strings.Builder
+
+	view.WriteString(m.styles.title.Render("ordaa") + m.styles.faint.Render(" logged in as "+m.user.Name) + "\n\n")
+
+	if len(m.menus) == 0 {
+		view.WriteString("there are no menus yet\n")
+	}
+
+	start, end := window(len(m.menus), m.menuCursor, m.listHeight())
+	for i := start; i < end; i++ {
+		line := fmt.Sprintf("%-20s %3d items", m.menus[i].Name, len(m.menus[i].Items))
+		view.WriteString(m.line(line, i == m.menuCursor) + "\n")
+	}
+
+	view.WriteString("\n" + m.styles.faint.Render("↑/↓ select • enter open • r refresh • q quit"))
+
+	return view.String()
+}
+
+func (m *model) viewMenu() string {
+	var (
+		view  strings.Builder
+		items strings.Builder
+		own   strings.Builder
+	)
+
+	view.WriteString(m.styles.title.Render(m.menu.Name) + " " + m.orderSummary() + "\n\n")
+
+	items.WriteString(m.styles.title.Render("menu") + "\n")
+
+	start, end := window(len(m.items), m.itemCursor, m.listHeight())
+	for i := start; i < end; i++ {
+		item := m.items[i]
+		line := fmt.Sprintf("%-6s %-32s %7s", item.ShortName, truncate(item.Name, 32), price.Format(item.Price))
+		items.WriteString(m.line(line, !m.focusOwn && i == m.itemCursor) + "\n")
+	}
+
+	own.WriteString(m.styles.title.Render("your items") + "\n")
+
+	total := 0
+
+	for i, orderItem := range m.ownItems {
+		total += orderItem.Price
+		line := fmt.Sprintf("%-24s %7s", truncate(m.itemName(orderItem.MenuItemUUID), 24), price.Format(orderItem.Price))
+		own.WriteString(m.line(line, m.focusOwn && i == m.ownCursor) + "\n")
+	}
+
+	if len(m.ownItems) > 0 {
+		own.WriteString(fmt.Sprintf("%-24s %7s\n", "total", price.Format(total)))
+	}
+
+	view.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, m.styles.column.Render(items.String()), own.String()))
+	view.WriteString("\n" + m.styles.faint.Render("↑/↓ select • tab switch list • enter add • d remove • s start order • r refresh • esc back"))
+
+	return view.String()
+}
+
+func (m *model) orderSummary() string {
+	if m.order == nil {
+		return m.styles.faint.Render("no active order")
+	}
+
+	participants := map[uuid.UUID]bool{}
+	total := 0
+
+	for _, orderItem := range m.orderItems {
+		if orderItem.User != nil {
+			participants[*orderItem.User] = true
+		}
+
+		total += orderItem.Price
+	}
+
+	return m.styles.faint.Render(fmt.Sprintf(
+		"order %s • %d items from %d people • total %s",
+		m.order.State,
+		len(m.orderItems),
+		len(participants),
+		price.Format(total),
+	))
+}
+
+func (m *model) itemName(menuItemUUID *uuid.UUID) string {
+	for _, item := range m.items {
+		if menuItemUUID != nil && *item.UUID == *menuItemUUID {
+			return item.ShortName + " " + item.Name
+		}
+	}
+
+	return "removed item"
+}
+
+func (m *model) line(text string, selected bool) string {
+	if selected {
+		return m.styles.selected.Render("> " + text)
+	}
+
+	return "  " + text
+}
+
+func (m *model) listHeight() int {
+	if m.height == 0 {
+		return 20
+	}
+
+	return max(m.height-reservedLines, 1)
+}
+
+// window returns the range of a list of length n that fits into height lines
+// and contains the cursor.
+func window(n, cursor, height int) (int, int) {
+	if n <= height {
+		return 0, n
+	}
+
+	start := min(max(cursor-height/2, 0), n-height)
+
+	return start, start + height
+}
+
+func truncate(s string, length int) string {
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+
+	return string(runes[:length-1]) + "…"
+}
+
+func sortedMenuItems(items []entity.MenuItem) []entity.MenuItem {
+	sorted := append([]entity.MenuItem{}, items...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ShortName < sorted[j].ShortName
+	})
+
+	return sorted
+}
@@ -0,0 +1,207 @@
+package ssh
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+const publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEj1rxqPM8u0sxz3QcDg0Tu9O0HyEc2RrT7nnDqC0qkn"
+
+var (
+	userUUID      = uuid.Must(uuid.NewV4())
+	menuUUID      = uuid.Must(uuid.NewV4())
+	orderUUID     = uuid.Must(uuid.NewV4())
+	nanUUID       = uuid.Must(uuid.NewV4())
+	masalaUUID    = uuid.Must(uuid.NewV4())
+	orderItemUUID = uuid.Must(uuid.NewV4())
+)
+
+func keys(s string) []tea.KeyMsg {
+	msgs := make([]tea.KeyMsg, 0, len(s))
+	for _, r := range s {
+		msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	return msgs
+}
+
+func menuService() *MenuServiceMock {
+	return &MenuServiceMock{
+		GetAllMenusFunc: func(ctx context.Context) ([]entity.Menu, error) {
+			return []entity.Menu{{
+				UUID: &menuUUID,
+				Name: "sangam",
+				Items: []entity.MenuItem{
+					{UUID: &masalaUUID, ShortName: "M7", Name: "Chicken Masala", Price: 950},
+					{UUID: &nanUUID, ShortName: "174", Name: "Nan", Price: 320},
+				},
+			}}, nil
+		},
+	}
+}
+
+func knownUserService() *UserServiceMock {
+	return &UserServiceMock{
+		GetSSHUserByPublicKeyFunc: func(ctx context.Context, key string) (*entity.SSHUser, error) {
+			return &entity.SSHUser{UserUUID: &userUUID, PublicKey: key}, nil
+		},
+		GetUserFunc: func(ctx context.Context, uuid *uuid.UUID) (*entity.User, error) {
+			return &entity.User{UUID: &userUUID, Name: "@test:matrix.org"}, nil
+		},
+	}
+}
+
+func openOrderService(orderItems *[]entity.OrderItem) *OrderServiceMock {
+	return &OrderServiceMock{
+		GetActiveOrderByMenuFunc: func(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+			return &entity.Order{UUID: &orderUUID, MenuUUID: menuUUID, State: entity.Open}, nil
+		},
+		GetAllOrderItemsFunc: func(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
+			return *orderItems, nil
+		},
+		AddOrderItemToOrderByNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, shortName, menuName string) error {
+			*orderItems = append(*orderItems, entity.OrderItem{UUID: &orderItemUUID, User: currentUser, MenuItemUUID: &nanUUID, Price: 320})
+			return nil
+		},
+		RemoveOrderItemFunc: func(ctx context.Context, currentUser, orderItemUUID *uuid.UUID) error {
+			*orderItems = nil
+			return nil
+		},
+	}
+}
+
+func TestTUI(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name         string
+		userService  *UserServiceMock
+		orderService *OrderServiceMock
+		keys         []tea.KeyMsg
+		screen       screen
+		status       string
+		err          error
+		check        func(t *testing.T, m *model)
+	}
+
+	testCases := []testCase{
+		{
+			name: "should link unknown key with link code",
+			userService: &UserServiceMock{
+				GetSSHUserByPublicKeyFunc: func(ctx context.Context, key string) (*entity.SSHUser, error) {
+					return nil, repository.ErrUserNotFound
+				},
+				LinkSSHUserFunc: func(ctx context.Context, code, key string) (*entity.User, error) {
+					return &entity.User{UUID: &userUUID, Name: "@test:matrix.org"}, nil
+				},
+			},
+			orderService: &OrderServiceMock{},
+			keys:         append(keys("ABCD23456"), tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyEnter}),
+			screen:       screenMenus,
+			status:       "linked this key to your account",
+			check: func(t *testing.T, m *model) {
+				t.Helper()
+
+				calls := m.userService.(*UserServiceMock).LinkSSHUserCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, "ABCD2345", calls[0].Code)
+				assert.Equal(t, publicKey, calls[0].PublicKey)
+				assert.Len(t, m.menus, 1)
+			},
+		},
+		{
+			name: "should stay on link screen with invalid link code",
+			userService: &UserServiceMock{
+				GetSSHUserByPublicKeyFunc: func(ctx context.Context, key string) (*entity.SSHUser, error) {
+					return nil, repository.ErrUserNotFound
+				},
+				LinkSSHUserFunc: func(ctx context.Context, code, key string) (*entity.User, error) {
+					return nil, repository.ErrLinkCodeNotFound
+				},
+			},
+			orderService: &OrderServiceMock{},
+			keys:         append(keys("WRONG"), tea.KeyMsg{Type: tea.KeyEnter}),
+			screen:       screenLink,
+			err:          repository.ErrLinkCodeNotFound,
+		},
+		{
+			name:        "should ask to start an order when there is none",
+			userService: knownUserService(),
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuFunc: func(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+					return nil, repository.ErrOrderNotFound
+				},
+			},
+			keys:   append([]tea.KeyMsg{{Type: tea.KeyEnter}}, keys("a")...),
+			screen: screenMenu,
+			status: "there is no active order, press s to start one",
+		},
+		{
+			name:         "should add selected item to active order",
+			userService:  knownUserService(),
+			orderService: openOrderService(&[]entity.OrderItem{}),
+			keys:         append([]tea.KeyMsg{{Type: tea.KeyEnter}}, keys("ja")...),
+			screen:       screenMenu,
+			status:       "added M7 (Chicken Masala)",
+			check: func(t *testing.T, m *model) {
+				t.Helper()
+
+				calls := m.orderService.(*OrderServiceMock).AddOrderItemToOrderByNameCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, "M7", calls[0].ShortName)
+				assert.Equal(t, "sangam", calls[0].MenuName)
+				assert.Len(t, m.ownItems, 1)
+			},
+		},
+		{
+			name:         "should remove own item from active order",
+			userService:  knownUserService(),
+			orderService: openOrderService(&[]entity.OrderItem{{UUID: &orderItemUUID, User: &userUUID, MenuItemUUID: &nanUUID}}),
+			keys:         append([]tea.KeyMsg{{Type: tea.KeyEnter}, {Type: tea.KeyTab}}, keys("d")...),
+			screen:       screenMenu,
+			status:       "removed 174 Nan",
+			check: func(t *testing.T, m *model) {
+				t.Helper()
+
+				calls := m.orderService.(*OrderServiceMock).RemoveOrderItemCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, orderItemUUID, *calls[0].OrderItemUUID)
+				assert.Empty(t, m.ownItems)
+				assert.False(t, m.focusOwn)
+			},
+		},
+		{
+			name:         "should go back to menu list",
+			userService:  knownUserService(),
+			orderService: openOrderService(&[]entity.OrderItem{}),
+			keys:         []tea.KeyMsg{{Type: tea.KeyEnter}, {Type: tea.KeyEsc}},
+			screen:       screenMenus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newModel(ctx, tc.userService, tc.orderService, menuService(), publicKey)
+
+			for _, key := range tc.keys {
+				m.Update(key)
+			}
+
+			assert.Equal(t, tc.screen, m.screen)
+			assert.Equal(t, tc.status, m.status)
+			assert.ErrorIs(t, m.err, tc.err)
+			assert.NotEmpty(t, m.View())
+
+			if tc.check != nil {
+				tc.check(t, m)
+			}
+		})
+	}
+}
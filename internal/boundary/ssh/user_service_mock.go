@@ -0,0 +1,189 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package ssh
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that UserServiceMock does implement UserService.
+// If this is not the case, regenerate this file with moq.
+var _ UserService = &UserServiceMock{}
+
+// UserServiceMock is a mock implementation of UserService.
+//
+//	func TestSomethingThatUsesUserService(t *testing.T) {
+//
+//		// make and configure a mocked UserService
+//		mockedUserService := &UserServiceMock{
+//			GetSSHUserByPublicKeyFunc: func(ctx context.Context, publicKey string) (*entity.SSHUser, error) {
+//				panic("mock out the GetSSHUserByPublicKey method")
+//			},
+//			GetUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//			LinkSSHUserFunc: func(ctx context.Context, code string, publicKey string) (*entity.User, error) {
+//				panic("mock out the LinkSSHUser method")
+//			},
+//		}
+//
+//		// use mockedUserService in code that requires UserService
+//		// and then make assertions.
+//
+//	}
+type UserServiceMock struct {
+	// GetSSHUserByPublicKeyFunc mocks the GetSSHUserByPublicKey method.
+	GetSSHUserByPublicKeyFunc func(ctx context.Context, publicKey string) (*entity.SSHUser, error)
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error)
+
+	// LinkSSHUserFunc mocks the LinkSSHUser method.
+	LinkSSHUserFunc func(ctx context.Context, code string, publicKey string) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetSSHUserByPublicKey holds details about calls to the GetSSHUserByPublicKey method.
+		GetSSHUserByPublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicKey is the publicKey argument value.
+			PublicKey string
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// LinkSSHUser holds details about calls to the LinkSSHUser method.
+		LinkSSHUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code string
+			// PublicKey is the publicKey argument value.
+			PublicKey string
+		}
+	}
+	lockGetSSHUserByPublicKey sync.RWMutex
+	lockGetUser               sync.RWMutex
+	lockLinkSSHUser           sync.RWMutex
+}
+
+// GetSSHUserByPublicKey calls GetSSHUserByPublicKeyFunc.
+func (mock *UserServiceMock) GetSSHUserByPublicKey(ctx context.Context, publicKey string) (*entity.SSHUser, error) {
+	if mock.GetSSHUserByPublicKeyFunc == nil {
+		panic("UserServiceMock.GetSSHUserByPublicKeyFunc: method is nil but UserService.GetSSHUserByPublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		PublicKey string
+	}{
+		Ctx:       ctx,
+		PublicKey: publicKey,
+	}
+	mock.lockGetSSHUserByPublicKey.Lock()
+	mock.calls.GetSSHUserByPublicKey = append(mock.calls.GetSSHUserByPublicKey, callInfo)
+	mock.lockGetSSHUserByPublicKey.Unlock()
+	return mock.GetSSHUserByPublicKeyFunc(ctx, publicKey)
+}
+
+// GetSSHUserByPublicKeyCalls gets all the calls that were made to GetSSHUserByPublicKey.
+// Check the length with:
+//
+//	len(mockedUserService.GetSSHUserByPublicKeyCalls())
+func (mock *UserServiceMock) GetSSHUserByPublicKeyCalls() []struct {
+	Ctx       context.Context
+	PublicKey string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		PublicKey string
+	}
+	mock.lockGetSSHUserByPublicKey.RLock()
+	calls = mock.calls.GetSSHUserByPublicKey
+	mock.lockGetSSHUserByPublicKey.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *UserServiceMock) GetUser(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("UserServiceMock.GetUserFunc: method is nil but UserService.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, uuidMoqParam)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedUserService.GetUserCalls())
+func (mock *UserServiceMock) GetUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// LinkSSHUser calls LinkSSHUserFunc.
+func (mock *UserServiceMock) LinkSSHUser(ctx context.Context, code string, publicKey string) (*entity.User, error) {
+	if mock.LinkSSHUserFunc == nil {
+		panic("UserServiceMock.LinkSSHUserFunc: method is nil but UserService.LinkSSHUser was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Code      string
+		PublicKey string
+	}{
+		Ctx:       ctx,
+		Code:      code,
+		PublicKey: publicKey,
+	}
+	mock.lockLinkSSHUser.Lock()
+	mock.calls.LinkSSHUser = append(mock.calls.LinkSSHUser, callInfo)
+	mock.lockLinkSSHUser.Unlock()
+	return mock.LinkSSHUserFunc(ctx, code, publicKey)
+}
+
+// LinkSSHUserCalls gets all the calls that were made to LinkSSHUser.
+// Check the length with:
+//
+//	len(mockedUserService.LinkSSHUserCalls())
+func (mock *UserServiceMock) LinkSSHUserCalls() []struct {
+	Ctx       context.Context
+	Code      string
+	PublicKey string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Code      string
+		PublicKey string
+	}
+	mock.lockLinkSSHUser.RLock()
+	calls = mock.calls.LinkSSHUser
+	mock.lockLinkSSHUser.RUnlock()
+	return calls
+}
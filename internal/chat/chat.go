@@ -0,0 +1,62 @@
+// Package chat is the part of the chat bot that does not depend on the chat
+// network. A front end like the matrix boundary turns the messages it
+// receives into a Request, passes it to the handlers and sends back their
+// Response.
+package chat
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+// Request is a message sent to the bot.
+type Request struct {
+	// Sender identifies the sender in the chat network, e.g. the matrix user
+	// id @alice:matrix.org.
+	Sender string
+	// User is the account of the sender, nil if the sender has none. The
+	// front end looks it up, so handlers don't depend on how a chat network
+	// identifies its users.
+	User *entity.User
+	// Room identifies the room the message was sent in, orders and room
+	// settings belong to it.
+	Room string
+	// Text is the message without formatting.
+	Text string
+	// Attachments are the files sent with the message. In chat networks
+	// where files are separate messages, they are those of the message the
+	// request replies to.
+	Attachments []Attachment
+}
+
+// Attachment is a file sent with a request. Its content is only fetched if
+// a handler reads it.
+type Attachment struct {
+	Name     string
+	MimeType string
+	Fetch    func(ctx context.Context) ([]byte, error)
+}
+
+// Response is the reply to a request.
+type Response struct {
+	Msg string
+	// HTML is the formatted version of Msg, if there is one.
+	HTML string
+	// Redact removes the request from the room, e.g. because it contains a
+	// secret.
+	Redact bool
+}
+
+// Handler handles the requests it matches.
+type Handler interface {
+	Matches(ctx context.Context, req *Request) bool
+	Handle(ctx context.Context, req *Request) *Response
+}
+
+// ConversationHandler is a Handler that also takes follow-up messages
+// without the command prefix, e.g. answers in a direct chat.
+type ConversationHandler interface {
+	Handler
+	InConversation(ctx context.Context, req *Request) bool
+}
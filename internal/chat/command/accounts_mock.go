@@ -0,0 +1,190 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that AccountsMock does implement Accounts.
+// If this is not the case, regenerate this file with moq.
+var _ Accounts = &AccountsMock{}
+
+// AccountsMock is a mock implementation of Accounts.
+//
+//	func TestSomethingThatUsesAccounts(t *testing.T) {
+//
+//		// make and configure a mocked Accounts
+//		mockedAccounts := &AccountsMock{
+//			AccountUUIDFunc: func(ctx context.Context, username string) (*uuid.UUID, error) {
+//				panic("mock out the AccountUUID method")
+//			},
+//			LinkFunc: func(ctx context.Context, req *chat.Request, code string) (*entity.User, error) {
+//				panic("mock out the Link method")
+//			},
+//			RegisterFunc: func(ctx context.Context, req *chat.Request) (*entity.User, error) {
+//				panic("mock out the Register method")
+//			},
+//		}
+//
+//		// use mockedAccounts in code that requires Accounts
+//		// and then make assertions.
+//
+//	}
+type AccountsMock struct {
+	// AccountUUIDFunc mocks the AccountUUID method.
+	AccountUUIDFunc func(ctx context.Context, username string) (*uuid.UUID, error)
+
+	// LinkFunc mocks the Link method.
+	LinkFunc func(ctx context.Context, req *chat.Request, code string) (*entity.User, error)
+
+	// RegisterFunc mocks the Register method.
+	RegisterFunc func(ctx context.Context, req *chat.Request) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AccountUUID holds details about calls to the AccountUUID method.
+		AccountUUID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+		}
+		// Link holds details about calls to the Link method.
+		Link []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *chat.Request
+			// Code is the code argument value.
+			Code string
+		}
+		// Register holds details about calls to the Register method.
+		Register []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *chat.Request
+		}
+	}
+	lockAccountUUID sync.RWMutex
+	lockLink        sync.RWMutex
+	lockRegister    sync.RWMutex
+}
+
+// AccountUUID calls AccountUUIDFunc.
+func (mock *AccountsMock) AccountUUID(ctx context.Context, username string) (*uuid.UUID, error) {
+	if mock.AccountUUIDFunc == nil {
+		panic("AccountsMock.AccountUUIDFunc: method is nil but Accounts.AccountUUID was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+	}{
+		Ctx:      ctx,
+		Username: username,
+	}
+	mock.lockAccountUUID.Lock()
+	mock.calls.AccountUUID = append(mock.calls.AccountUUID, callInfo)
+	mock.lockAccountUUID.Unlock()
+	return mock.AccountUUIDFunc(ctx, username)
+}
+
+// AccountUUIDCalls gets all the calls that were made to AccountUUID.
+// Check the length with:
+//
+//	len(mockedAccounts.AccountUUIDCalls())
+func (mock *AccountsMock) AccountUUIDCalls() []struct {
+	Ctx      context.Context
+	Username string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+	}
+	mock.lockAccountUUID.RLock()
+	calls = mock.calls.AccountUUID
+	mock.lockAccountUUID.RUnlock()
+	return calls
+}
+
+// Link calls LinkFunc.
+func (mock *AccountsMock) Link(ctx context.Context, req *chat.Request, code string) (*entity.User, error) {
+	if mock.LinkFunc == nil {
+		panic("AccountsMock.LinkFunc: method is nil but Accounts.Link was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Req  *chat.Request
+		Code string
+	}{
+		Ctx:  ctx,
+		Req:  req,
+		Code: code,
+	}
+	mock.lockLink.Lock()
+	mock.calls.Link = append(mock.calls.Link, callInfo)
+	mock.lockLink.Unlock()
+	return mock.LinkFunc(ctx, req, code)
+}
+
+// LinkCalls gets all the calls that were made to Link.
+// Check the length with:
+//
+//	len(mockedAccounts.LinkCalls())
+func (mock *AccountsMock) LinkCalls() []struct {
+	Ctx  context.Context
+	Req  *chat.Request
+	Code string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Req  *chat.Request
+		Code string
+	}
+	mock.lockLink.RLock()
+	calls = mock.calls.Link
+	mock.lockLink.RUnlock()
+	return calls
+}
+
+// Register calls RegisterFunc.
+func (mock *AccountsMock) Register(ctx context.Context, req *chat.Request) (*entity.User, error) {
+	if mock.RegisterFunc == nil {
+		panic("AccountsMock.RegisterFunc: method is nil but Accounts.Register was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *chat.Request
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockRegister.Lock()
+	mock.calls.Register = append(mock.calls.Register, callInfo)
+	mock.lockRegister.Unlock()
+	return mock.RegisterFunc(ctx, req)
+}
+
+// RegisterCalls gets all the calls that were made to Register.
+// Check the length with:
+//
+//	len(mockedAccounts.RegisterCalls())
+func (mock *AccountsMock) RegisterCalls() []struct {
+	Ctx context.Context
+	Req *chat.Request
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *chat.Request
+	}
+	mock.lockRegister.RLock()
+	calls = mock.calls.Register
+	mock.lockRegister.RUnlock()
+	return calls
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+// the items are short names or quoted dish names with an optional quantity
+// like 174x2 or "paneer makhni"x2, or a single favourite prefixed with '@'.
+// In a room with a default menu the menu can be left out.
+var addCommand = newCommand("add <menu> <items...>")
+
+type AddHandler struct {
+	OrderService OrderService
+}
+
+func (h *AddHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := addCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *AddHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not add to order: %s", err)}
+	}
+
+	msg := req.Text
+
+	args, ok := addCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, addCommand)
+	}
+
+	menuName, items := args.get("menu"), args.raw("items")
+
+	// with a default menu the first argument is only read as menu if that menu
+	// has an active order, otherwise it is the first item
+	if defaultMenu := defaultMenuName(ctx); defaultMenu != "" && !strings.EqualFold(menuName, defaultMenu) {
+		if _, err = h.OrderService.GetActiveOrderByMenuName(ctx, req.Room, menuName); errors.Is(err, repository.ErrOrderNotFound) {
+			menuName, items = defaultMenu, append(args.raw("menu"), items...)
+		}
+	}
+
+	if favourite, ok := strings.CutPrefix(items[0], "@"); ok {
+		if len(items) > 1 {
+			return &chat.Response{Msg: translatef(ctx, "a favourite must be added on its own")}
+		}
+
+		return h.addFavourite(ctx, currentUser.UUID, req.Room, menuName, favourite)
+	}
+
+	if _, err = h.OrderService.AddOrderItemsToOrderByName(ctx, currentUser.UUID, req.Room, menuName, items); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not add order: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "added %s to active order %s", summarizeItems(items), menuName)}
+}
+
+// summarizeItems merges repeated short names, e.g. "174 174 81" becomes
+// "174x2, 81".
+func summarizeItems(items []string) string {
+	shortNames := []string{}
+	quantities := map[string]int{}
+
+	for _, item := range items {
+		shortName, quantity, err := service.ParseItemQuantity(item)
+		if err != nil {
+			shortName, quantity = item, 1
+		}
+
+		if _, ok := quantities[shortName]; !ok {
+			shortNames = append(shortNames, shortName)
+		}
+
+		quantities[shortName] += quantity
+	}
+
+	summary := make([]string, 0, len(shortNames))
+
+	for _, shortName := range shortNames {
+		if quantities[shortName] > 1 {
+			summary = append(summary, fmt.Sprintf("%sx%d", shortName, quantities[shortName]))
+		} else {
+			summary = append(summary, shortName)
+		}
+	}
+
+	return strings.Join(summary, ", ")
+}
+
+func (h *AddHandler) addFavourite(ctx context.Context, currentUser *uuid.UUID, roomID, menuName, name string) *chat.Response {
+	favouriteOrder, err := h.OrderService.AddFavouriteToOrder(ctx, currentUser, roomID, menuName, name)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not add order: %s", err)}
+	}
+
+	lines := []string{translatef(ctx, "added %d items of favourite %s to active order %s", len(favouriteOrder.Added), name, menuName)}
+	for _, menuItem := range favouriteOrder.Removed {
+		lines = append(lines, translatef(ctx, "warning: %s (%s) is not on the menu anymore and was skipped", menuItem.ShortName, menuItem.Name))
+	}
+
+	return &chat.Response{Msg: strings.Join(lines, "\n")}
+}
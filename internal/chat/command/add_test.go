@@ -0,0 +1,350 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestAdd(t *testing.T) {
+	ctx := t.Context()
+
+	userUUID := uuid.Must(uuid.NewV4())
+
+	type testCase struct {
+		name         string
+		sender       string
+		user         *entity.User
+		msg          string
+		orderService OrderService
+		matches      bool
+		response     *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle add command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add sangam 62", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					if menuName != "sangam" {
+						return nil, repository.ErrMenuNotFound
+					}
+
+					if items[0] != "62" {
+						return nil, repository.ErrMenuItemNotFound
+					}
+
+					return []entity.OrderItem{{}}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added 62 to active order sangam"},
+		},
+		{
+			name:   "should add favourite and warn about removed items",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add sangam @usual", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddFavouriteToOrderFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName, name string,
+				) (*service.FavouriteOrder, error) {
+					if name != "usual" {
+						return nil, repository.ErrFavouriteNotFound
+					}
+
+					return &service.FavouriteOrder{
+						Added:   []entity.OrderItem{{Price: 790}, {Price: 790}},
+						Removed: []entity.MenuItem{{ShortName: "M7", Name: "Chicken Masala"}},
+					}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "added 2 items of favourite usual to active order sangam\n" +
+					"warning: M7 (Chicken Masala) is not on the menu anymore and was skipped",
+			},
+		},
+		{
+			name:     "should handle add command user not found error",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s add sangam 62", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not add to order: user not found"},
+		},
+		{
+			name:    "should not match add command without prefix",
+			msg:     "add",
+			matches: false,
+		},
+		{
+			name:    "should not match add command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match add command without menu name",
+			msg:     fmt.Sprintf("%s add ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:   "should add several items at once",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add sangam M1 174 174 81 M1x2", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					assert.Equal(t, []string{"M1", "174", "174", "81", "M1x2"}, items)
+
+					return make([]entity.OrderItem, 6), nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added M1x3, 174x2, 81 to active order sangam"},
+		},
+		{
+			name:   "should list unknown short names",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add sangam M1 X1 X2", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					return nil, fmt.Errorf("%w: %w: X1, X2", service.ErrAddingOrderItem, service.ErrUnknownShortNames)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not add order: adding order item: unknown short names: X1, X2"},
+		},
+		{
+			name:   "should add quoted dish names",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add sangam M1 \"paneer makhni\"x2 “garlic naan”", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					assert.Equal(t, []string{"M1", `"paneer makhni"x2`, `"garlic naan"`}, items)
+
+					return make([]entity.OrderItem, 4), nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added M1, \"paneer makhni\"x2, \"garlic naan\" to active order sangam"},
+		},
+		{
+			name:   "should list candidates of ambiguous dish name",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add sangam \"naan\"", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					return nil, fmt.Errorf(
+						"%w: %w: 'naan' could be 81 (Naan), 82 (Garlic Naan)",
+						service.ErrAddingOrderItem,
+						service.ErrAmbiguousItem,
+					)
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "could not add order: adding order item: ambiguous item: 'naan' could be 81 (Naan), 82 (Garlic Naan)",
+			},
+		},
+		{
+			name:    "should not match unterminated quote",
+			msg:     fmt.Sprintf("%s add sangam \"paneer makhni", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:         "should reject favourite together with short names",
+			sender:       "@test:matrix.org",
+			msg:          fmt.Sprintf("%s add sangam @usual 62", DefaultPrefix),
+			user:         &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{},
+			matches:      true,
+			response:     &chat.Response{Msg: "a favourite must be added on its own"},
+		},
+		{
+			name:   "should add to menu with quoted name",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s add \"Pizza Mühle\" 62", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					assert.Equal(t, "Pizza Mühle", menuName)
+
+					return []entity.OrderItem{{}}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added 62 to active order Pizza Mühle"},
+		},
+		{
+			name:   "should match command ignoring case",
+			sender: "@test:matrix.org",
+			msg:    ".Ordaa ADD sangam 62",
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					return []entity.OrderItem{{}}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added 62 to active order sangam"},
+		},
+		{
+			name:    "should not match add command with trailing whitespaces",
+			msg:     fmt.Sprintf("%s add sangam ", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := AddHandler{
+				OrderService: tc.orderService,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   tc.user,
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
+
+func TestAddWithDefaultMenu(t *testing.T) {
+	ctx := WithRoomSettings(t.Context(), &entity.RoomSettings{DefaultMenu: &entity.Menu{Name: "sangam"}})
+
+	type testCase struct {
+		name     string
+		msg      string
+		menuName string
+		items    []string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "should add single item to default menu",
+			msg:      fmt.Sprintf("%s add M7", DefaultPrefix),
+			menuName: "sangam",
+			items:    []string{"M7"},
+		},
+		{
+			name:     "should add items to default menu if first item has no active order",
+			msg:      fmt.Sprintf(`%s add M7 "garlic naan"x2`, DefaultPrefix),
+			menuName: "sangam",
+			items:    []string{"M7", `"garlic naan"x2`},
+		},
+		{
+			name:     "should add items to menu with active order",
+			msg:      fmt.Sprintf("%s add pizza 12", DefaultPrefix),
+			menuName: "pizza",
+			items:    []string{"12"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			orderService := &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, menuName string) (*entity.Order, error) {
+					if menuName != "pizza" {
+						return nil, fmt.Errorf("%w: %w", repository.ErrOrderNotFound, errors.New("record not found"))
+					}
+
+					return &entity.Order{}, nil
+				},
+				AddOrderItemsToOrderByNameFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					roomID, menuName string,
+					items []string,
+				) ([]entity.OrderItem, error) {
+					return nil, nil
+				},
+			}
+
+			h := AddHandler{
+				OrderService: orderService,
+			}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				User:   &entity.User{UUID: &userUUID},
+				Text:   tc.msg,
+			}
+
+			assert.True(t, h.Matches(ctx, req))
+			h.Handle(ctx, req)
+
+			calls := orderService.AddOrderItemsToOrderByNameCalls()
+			if assert.Len(t, calls, 1) {
+				assert.Equal(t, tc.menuName, calls[0].MenuName)
+				assert.Equal(t, tc.items, calls[0].Items)
+			}
+		})
+	}
+}
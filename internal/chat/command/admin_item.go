@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/price"
+)
+
+var (
+	adminItemAddCommand    = newCommand("admin item add <menu> <short_name> <price> <name...>")
+	adminItemPriceCommand  = newCommand("admin item price <menu> <short_name> <price>")
+	adminItemRemoveCommand = newCommand("admin item remove <menu> <short_name>")
+)
+
+type AdminItemHandler struct {
+	MenuService MenuService
+}
+
+func (h *AdminItemHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, adminItemAddCommand, adminItemPriceCommand, adminItemRemoveCommand)
+}
+
+func (h *AdminItemHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not manage menu item: %s", err)}
+	}
+
+	msg := req.Text
+
+	if args, ok := adminItemAddCommand.match(ctx, msg); ok {
+		return h.add(ctx, currentUser.UUID, args.get("menu"), args.get("short_name"), args.get("price"), args.get("name"))
+	}
+
+	if args, ok := adminItemPriceCommand.match(ctx, msg); ok {
+		return h.setPrice(ctx, currentUser.UUID, args.get("menu"), args.get("short_name"), args.get("price"))
+	}
+
+	args, ok := adminItemRemoveCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, adminItemRemoveCommand)
+	}
+
+	menuName := args.get("menu")
+
+	menuItem, err := h.MenuService.RemoveMenuItem(ctx, currentUser.UUID, menuName, args.get("short_name"))
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not remove menu item: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "removed %s (%s) from menu %s", menuItem.ShortName, menuItem.Name, menuName)}
+}
+
+func (h *AdminItemHandler) add(ctx context.Context, currentUser *uuid.UUID, menuName, shortName, itemPrice, name string) *chat.Response {
+	cents, err := price.Parse(itemPrice)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not add menu item: %s", err)}
+	}
+
+	menuItem, err := h.MenuService.AddMenuItem(ctx, currentUser, menuName, &entity.MenuItem{ShortName: shortName, Name: name, Price: cents})
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not add menu item: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx,
+		"added %s (%s, %s) to menu %s", menuItem.ShortName, menuItem.Name, formatPrice(ctx, menuItem.Price), menuName,
+	)}
+}
+
+func (h *AdminItemHandler) setPrice(ctx context.Context, currentUser *uuid.UUID, menuName, shortName, itemPrice string) *chat.Response {
+	cents, err := price.Parse(itemPrice)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update menu item: %s", err)}
+	}
+
+	menuItem, err := h.MenuService.UpdateMenuItemPrice(ctx, currentUser, menuName, shortName, cents)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update menu item: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx,
+		"set price of %s (%s) on menu %s to %s", menuItem.ShortName, menuItem.Name, menuName, formatPrice(ctx, menuItem.Price),
+	)}
+}
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestAdminItem(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		menuService MenuService
+		matches     bool
+		response    *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle item add command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin item add sangam M7 9,50 Chicken Masala", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				AddMenuItemFunc: func(
+					ctx context.Context, currentUser *uuid.UUID, menuName string, menuItem *entity.MenuItem,
+				) (*entity.MenuItem, error) {
+					if menuName != "sangam" {
+						return nil, repository.ErrMenuNotFound
+					}
+
+					return menuItem, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added M7 (Chicken Masala, 9.50) to menu sangam"},
+		},
+		{
+			name:   "should handle item add command with existing short name",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin item add sangam M7 9.50 Chicken Masala", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				AddMenuItemFunc: func(
+					ctx context.Context, currentUser *uuid.UUID, menuName string, menuItem *entity.MenuItem,
+				) (*entity.MenuItem, error) {
+					return nil, service.ErrMenuItemExists
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not add menu item: menu item with this short name already exists"},
+		},
+		{
+			name:   "should handle item price command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin item price sangam 174 3.5", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				UpdateMenuItemPriceFunc: func(
+					ctx context.Context, currentUser *uuid.UUID, menuName, shortName string, price int,
+				) (*entity.MenuItem, error) {
+					return &entity.MenuItem{ShortName: shortName, Name: "Nan", Price: price}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "set price of 174 (Nan) on menu sangam to 3.50"},
+		},
+		{
+			name:   "should handle item remove command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin item remove sangam 174", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				RemoveMenuItemFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName, shortName string) (*entity.MenuItem, error) {
+					return &entity.MenuItem{ShortName: shortName, Name: "Nan"}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "removed 174 (Nan) from menu sangam"},
+		},
+		{
+			name:   "should handle item remove command menu item not found error",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin item remove sangam 999", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				RemoveMenuItemFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName, shortName string) (*entity.MenuItem, error) {
+					return nil, repository.ErrMenuItemNotFound
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not remove menu item: menu item not found"},
+		},
+		{
+			name:   "should deny item commands for non admins",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s admin item remove sangam 174", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				RemoveMenuItemFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName, shortName string) (*entity.MenuItem, error) {
+					return nil, service.ErrPermissionDenied
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not remove menu item: permission denied"},
+		},
+		{
+			name:     "should handle item commands from unregistered users",
+			sender:   "@unknown:matrix.org",
+			msg:      fmt.Sprintf("%s admin item remove sangam 174", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not manage menu item: user not found"},
+		},
+		{
+			name:    "should not match item add command without name",
+			msg:     fmt.Sprintf("%s admin item add sangam M7 9.50", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:     "should reject item price command with invalid price",
+			sender:   "@admin:matrix.org",
+			msg:      fmt.Sprintf("%s admin item price sangam M7 cheap", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not update menu item: invalid price: \"cheap\""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := AdminItemHandler{
+				MenuService: tc.menuService,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   adminUser,
+				Text:   tc.msg,
+			}
+
+			if tc.sender == "@unknown:matrix.org" {
+				req.User = nil
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	adminMenuCreateCommand      = newCommand("admin menu create <menu> [url]")
+	adminMenuImportCommand      = newCommand("admin menu import")
+	adminMenuAliasAddCommand    = newCommand("admin menu alias add <menu> <alias>")
+	adminMenuAliasRemoveCommand = newCommand("admin menu alias remove <alias>")
+)
+
+var ErrNoAttachment = errors.New("message has no file and is not a reply to one")
+
+//go:generate go tool moq -rm -out menu_service_mock.go . MenuService
+
+type MenuService interface {
+	GetAllMenus(ctx context.Context) ([]entity.Menu, error)
+	GetMenu(ctx context.Context, uuid *uuid.UUID) (*entity.Menu, error)
+	GetMenuByName(ctx context.Context, name string) (*entity.Menu, error)
+	CreateMenu(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error)
+	ImportMenu(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu, dryRun bool) (*entity.MenuDiff, error)
+	AddMenuItem(ctx context.Context, currentUser *uuid.UUID, menuName string, menuItem *entity.MenuItem) (*entity.MenuItem, error)
+	UpdateMenuItemPrice(ctx context.Context, currentUser *uuid.UUID, menuName, shortName string, price int) (*entity.MenuItem, error)
+	RemoveMenuItem(ctx context.Context, currentUser *uuid.UUID, menuName, shortName string) (*entity.MenuItem, error)
+	AddMenuAlias(ctx context.Context, currentUser *uuid.UUID, menuName, alias string) (*entity.Menu, []entity.MenuAlias, error)
+	RemoveMenuAlias(ctx context.Context, currentUser *uuid.UUID, alias string) (*entity.Menu, []entity.MenuAlias, error)
+}
+
+type AdminMenuHandler struct {
+	MenuService MenuService
+}
+
+func (h *AdminMenuHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, adminMenuCreateCommand, adminMenuImportCommand, adminMenuAliasAddCommand, adminMenuAliasRemoveCommand)
+}
+
+func (h *AdminMenuHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not manage menu: %s", err)}
+	}
+
+	msg := req.Text
+
+	if args, ok := adminMenuAliasAddCommand.match(ctx, msg); ok {
+		menu, aliases, err := h.MenuService.AddMenuAlias(ctx, currentUser.UUID, args.get("menu"), args.get("alias"))
+		if err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not add menu alias: %s", err)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx, "menu %s can now also be called %s", menu.Name, formatAliases(aliases))}
+	}
+
+	if args, ok := adminMenuAliasRemoveCommand.match(ctx, msg); ok {
+		menu, aliases, err := h.MenuService.RemoveMenuAlias(ctx, currentUser.UUID, args.get("alias"))
+		if err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not remove menu alias: %s", err)}
+		}
+
+		if len(aliases) == 0 {
+			return &chat.Response{Msg: translatef(ctx, "removed alias %s, menu %s has no aliases left", args.get("alias"), menu.Name)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx,
+			"removed alias %s, menu %s can still be called %s",
+			args.get("alias"),
+			menu.Name,
+			formatAliases(aliases),
+		)}
+	}
+
+	if args, ok := adminMenuCreateCommand.match(ctx, msg); ok {
+		menu, err := h.MenuService.CreateMenu(ctx, currentUser.UUID, &entity.Menu{Name: args.get("menu"), URL: args.get("url")})
+		if err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not create menu: %s", err)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx, "created menu %s", menu.Name)}
+	}
+
+	if len(req.Attachments) == 0 {
+		return &chat.Response{Msg: translatef(ctx, "could not import menu: %s", ErrNoAttachment)}
+	}
+
+	attachment := req.Attachments[0]
+
+	data, err := attachment.Fetch(ctx)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not import menu: %s", err)}
+	}
+
+	var menu entity.Menu
+	if err = json.Unmarshal(data, &menu); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not import menu: reading %s: %s", attachment.Name, err)}
+	}
+
+	diff, err := h.MenuService.ImportMenu(ctx, currentUser.UUID, &menu, false)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not import menu: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx,
+		"imported menu %s: %d added, %d changed, %d removed",
+		diff.Name,
+		len(diff.Added),
+		len(diff.Changed),
+		len(diff.Removed),
+	)}
+}
+
+func formatAliases(aliases []entity.MenuAlias) string {
+	names := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		names = append(names, alias.Alias)
+	}
+
+	return strings.Join(names, ", ")
+}
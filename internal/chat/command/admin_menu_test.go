@@ -0,0 +1,221 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+// adminUser is the account of every sender, permissions are checked by the
+// menu service.
+var adminUser = &entity.User{UUID: &userUUID}
+
+func TestAdminMenu(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		menuService MenuService
+		attachments []chat.Attachment
+		matches     bool
+		response    *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle menu create command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu create sangam https://sangam.example", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				CreateMenuFunc: func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+					if menu.Name != "sangam" || menu.URL != "https://sangam.example" {
+						return nil, repository.ErrCreatingMenu
+					}
+
+					return menu, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "created menu sangam"},
+		},
+		{
+			name:   "should deny menu create command for non admins",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu create sangam", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				CreateMenuFunc: func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+					return nil, fmt.Errorf("%w: %s required", service.ErrPermissionDenied, service.PermissionManageMenus)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not create menu: permission denied: manage_menus required"},
+		},
+		{
+			name:   "should handle menu import command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu import", DefaultPrefix),
+			attachments: []chat.Attachment{
+				{
+					Name: "sangam.json",
+					Fetch: func(ctx context.Context) ([]byte, error) {
+						return []byte(`{"name": "Sangam", "items": [{"short_name": "174", "name": "Nan", "price": 320}]}`), nil
+					},
+				},
+			},
+			menuService: &MenuServiceMock{
+				ImportMenuFunc: func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu, dryRun bool) (*entity.MenuDiff, error) {
+					return &entity.MenuDiff{Name: menu.Name, Changed: []entity.MenuItemChange{{}}}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "imported menu Sangam: 0 added, 1 changed, 0 removed"},
+		},
+		{
+			name:     "should handle menu import command without file",
+			sender:   "@admin:matrix.org",
+			msg:      fmt.Sprintf("%s admin menu import", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not import menu: message has no file and is not a reply to one"},
+		},
+		{
+			name:   "should handle menu import command with file that cannot be downloaded",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu import", DefaultPrefix),
+			attachments: []chat.Attachment{
+				{
+					Name: "sangam.json",
+					Fetch: func(ctx context.Context) ([]byte, error) {
+						return nil, errors.New("file is larger than 1048576 bytes")
+					},
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not import menu: file is larger than 1048576 bytes"},
+		},
+		{
+			name:   "should handle menu import command with invalid file",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu import", DefaultPrefix),
+			attachments: []chat.Attachment{
+				{
+					Name: "menu.pdf",
+					Fetch: func(ctx context.Context) ([]byte, error) {
+						return []byte("%PDF-1.4"), nil
+					},
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not import menu: reading menu.pdf: invalid character '%' looking for beginning of value"},
+		},
+		{
+			name:    "should not match menu create command without menu name",
+			msg:     fmt.Sprintf("%s admin menu create", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match menu import command with arguments",
+			msg:     fmt.Sprintf("%s admin menu import menu.json", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:   "should handle menu create command with quoted name",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu create „Pizza Mühle“", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				CreateMenuFunc: func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+					return menu, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "created menu Pizza Mühle"},
+		},
+		{
+			name:   "should handle menu alias add command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu alias add \"Pizza Mühle\" pm", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				AddMenuAliasFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					menuName, alias string,
+				) (*entity.Menu, []entity.MenuAlias, error) {
+					assert.Equal(t, "Pizza Mühle", menuName)
+
+					return &entity.Menu{Name: menuName}, []entity.MenuAlias{{Alias: "muehle"}, {Alias: alias}}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "menu Pizza Mühle can now also be called muehle, pm"},
+		},
+		{
+			name:   "should handle menu alias add command with taken alias",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu alias add sangam pm", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				AddMenuAliasFunc: func(
+					ctx context.Context,
+					currentUser *uuid.UUID,
+					menuName, alias string,
+				) (*entity.Menu, []entity.MenuAlias, error) {
+					return nil, nil, fmt.Errorf("%w: %w: pm", service.ErrAddingMenuAlias, repository.ErrMenuAliasExists)
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "could not add menu alias: could not add menu alias: menu name or alias already in use: pm",
+			},
+		},
+		{
+			name:   "should handle menu alias remove command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin menu alias remove pm", DefaultPrefix),
+			menuService: &MenuServiceMock{
+				RemoveMenuAliasFunc: func(ctx context.Context, currentUser *uuid.UUID, alias string) (*entity.Menu, []entity.MenuAlias, error) {
+					return &entity.Menu{Name: "Pizza Mühle"}, nil, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "removed alias pm, menu Pizza Mühle has no aliases left"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := AdminMenuHandler{
+				MenuService: tc.menuService,
+			}
+
+			req := &chat.Request{
+				Sender:      tc.sender,
+				User:        adminUser,
+				Text:        tc.msg,
+				Attachments: tc.attachments,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+// defaultAPITokenTTL is used when a token is created without an expiry.
+const defaultAPITokenTTL = 90 * 24 * time.Hour
+
+var (
+	// the expiry is a number of days like 30d or never
+	apiTokenCreateCommand = newCommand("token create <name> <scope> [expiry]")
+	apiTokenListCommand   = newCommand("token list")
+	apiTokenRevokeCommand = newCommand("token revoke <name>")
+)
+
+type APITokenHandler struct {
+	UserService UserService
+	Messenger   DirectMessenger
+}
+
+func (h *APITokenHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, apiTokenCreateCommand, apiTokenListCommand, apiTokenRevokeCommand)
+}
+
+func (h *APITokenHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not manage api tokens: %s", err)}
+	}
+
+	msg := req.Text
+
+	if args, ok := apiTokenCreateCommand.match(ctx, msg); ok {
+		return h.create(ctx, req.Sender, currentUser.UUID, args.get("name"), args.get("scope"), args.get("expiry"))
+	}
+
+	if args, ok := apiTokenRevokeCommand.match(ctx, msg); ok {
+		return h.revoke(ctx, currentUser.UUID, args.get("name"))
+	}
+
+	return h.list(ctx, currentUser.UUID)
+}
+
+func (h *APITokenHandler) create(
+	ctx context.Context,
+	username string,
+	currentUser *uuid.UUID,
+	name string,
+	scope entity.TokenScope,
+	expiry string,
+) *chat.Response {
+	if !slices.Contains(entity.TokenScopes, scope) {
+		return &chat.Response{Msg: translatef(ctx, "scope must be one of %s", strings.Join(entity.TokenScopes, ", "))}
+	}
+
+	ttl := defaultAPITokenTTL
+
+	if strings.EqualFold(expiry, "never") {
+		ttl = 0
+	} else if expiry != "" {
+		days, ok := strings.CutSuffix(strings.ToLower(expiry), "d")
+
+		n, err := strconv.Atoi(days)
+		if !ok || err != nil || n <= 0 {
+			return &chat.Response{Msg: translatef(ctx, "expiry must be a number of days greater than 0 like 30d or 'never'")}
+		}
+
+		ttl = time.Duration(n) * 24 * time.Hour
+	}
+
+	token, apiToken, err := h.UserService.CreateAPIToken(ctx, currentUser, name, scope, ttl)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not create api token: %s", err)}
+	}
+
+	err = h.Messenger.SendDirectMessage(ctx, username, translatef(
+		ctx,
+		"Your api token %s is %s (scope %s, %s). It is only shown once, send it as 'Authorization: Bearer <token>'.",
+		apiToken.Name,
+		token,
+		apiToken.Scope,
+		formatExpiry(ctx, apiToken.ExpiresAt),
+	))
+	if err != nil {
+		// the secret is lost, so the token must not stay around unusable
+		_, _ = h.UserService.RevokeAPIToken(ctx, currentUser, apiToken.Name)
+		return &chat.Response{Msg: translatef(ctx, "could not send api token: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "sent you the api token %s via direct message", apiToken.Name)}
+}
+
+func (h *APITokenHandler) revoke(ctx context.Context, currentUser *uuid.UUID, name string) *chat.Response {
+	apiToken, err := h.UserService.RevokeAPIToken(ctx, currentUser, name)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not revoke api token: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "revoked api token %s", apiToken.Name)}
+}
+
+func (h *APITokenHandler) list(ctx context.Context, currentUser *uuid.UUID) *chat.Response {
+	apiTokens, err := h.UserService.GetAPITokens(ctx, currentUser)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not list api tokens: %s", err)}
+	}
+
+	if len(apiTokens) == 0 {
+		return &chat.Response{Msg: translatef(ctx, "you have no api tokens")}
+	}
+
+	var tokens strings.Builder
+
+	tokens.WriteString(translatef(ctx, "your api tokens:"))
+
+	for _, apiToken := range apiTokens {
+		lastUsed := translatef(ctx, "never used")
+		if apiToken.LastUsedAt != nil {
+			lastUsed = translatef(ctx, "last used %s", formatDateTime(ctx, *apiToken.LastUsedAt))
+		}
+
+		fmt.Fprintf(&tokens, "\n%s %s, %s, %s", apiToken.Name, apiToken.Scope, formatExpiry(ctx, apiToken.ExpiresAt), lastUsed)
+	}
+
+	return &chat.Response{Msg: tokens.String()}
+}
+
+func formatExpiry(ctx context.Context, expiresAt *time.Time) string {
+	if expiresAt == nil {
+		return translatef(ctx, "never expires")
+	}
+
+	if expiresAt.Before(time.Now()) {
+		return translatef(ctx, "expired %s", formatDate(ctx, *expiresAt))
+	}
+
+	return translatef(ctx, "expires %s", formatDate(ctx, *expiresAt))
+}
@@ -0,0 +1,191 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestAPIToken(t *testing.T) {
+	ctx := t.Context()
+
+	createAPIToken := func(
+		ctx context.Context,
+		user *uuid.UUID,
+		name string,
+		scope entity.TokenScope,
+		ttl time.Duration,
+	) (string, *entity.APIToken, error) {
+		apiToken := &entity.APIToken{UserUUID: user, Name: name, Scope: scope}
+		if ttl > 0 {
+			expiresAt := time.Now().Add(ttl)
+			apiToken.ExpiresAt = &expiresAt
+		}
+
+		return "ordaa_0123456789ab_secret", apiToken, nil
+	}
+
+	sendDirectMessage := func(ctx context.Context, username, msg string) error {
+		if !strings.Contains(msg, "ordaa_0123456789ab_secret") {
+			return fmt.Errorf("token missing in %q", msg)
+		}
+
+		return nil
+	}
+
+	lastUsed := time.Date(2026, 10, 1, 12, 30, 0, 0, time.UTC)
+	expired := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		userService *UserServiceMock
+		messenger   *DirectMessengerMock
+		matches     bool
+		response    *chat.Response
+		directMsgs  int
+		ttl         time.Duration
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should send new token via direct message",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s token create ci order-write", DefaultPrefix),
+			userService: &UserServiceMock{
+				CreateAPITokenFunc: createAPIToken,
+			},
+			messenger:  &DirectMessengerMock{SendDirectMessageFunc: sendDirectMessage},
+			matches:    true,
+			response:   &chat.Response{Msg: "sent you the api token ci via direct message"},
+			directMsgs: 1,
+			ttl:        defaultAPITokenTTL,
+		},
+		{
+			name:   "should create token with expiry in days",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s token create grafana read-only 7d", DefaultPrefix),
+			userService: &UserServiceMock{
+				CreateAPITokenFunc: createAPIToken,
+			},
+			messenger:  &DirectMessengerMock{SendDirectMessageFunc: sendDirectMessage},
+			matches:    true,
+			response:   &chat.Response{Msg: "sent you the api token grafana via direct message"},
+			directMsgs: 1,
+			ttl:        7 * 24 * time.Hour,
+		},
+		{
+			name:   "should create token that never expires",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s token create grafana read-only never", DefaultPrefix),
+			userService: &UserServiceMock{
+				CreateAPITokenFunc: createAPIToken,
+			},
+			messenger:  &DirectMessengerMock{SendDirectMessageFunc: sendDirectMessage},
+			matches:    true,
+			response:   &chat.Response{Msg: "sent you the api token grafana via direct message"},
+			directMsgs: 1,
+		},
+		{
+			name:   "should revoke token when direct message fails",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s token create ci order-write", DefaultPrefix),
+			userService: &UserServiceMock{
+				CreateAPITokenFunc: createAPIToken,
+				RevokeAPITokenFunc: func(ctx context.Context, user *uuid.UUID, name string) (*entity.APIToken, error) {
+					return &entity.APIToken{Name: name}, nil
+				},
+			},
+			messenger: &DirectMessengerMock{
+				SendDirectMessageFunc: func(ctx context.Context, username, msg string) error {
+					return fmt.Errorf("room not found")
+				},
+			},
+			matches:    true,
+			response:   &chat.Response{Msg: "could not send api token: room not found"},
+			directMsgs: 1,
+			ttl:        defaultAPITokenTTL,
+		},
+		{
+			name:   "should list tokens",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s token list", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetAPITokensFunc: func(ctx context.Context, user *uuid.UUID) ([]entity.APIToken, error) {
+					return []entity.APIToken{
+						{Name: "ci", Scope: entity.TokenScopeOrderWrite, LastUsedAt: &lastUsed},
+						{Name: "grafana", Scope: entity.TokenScopeReadOnly, ExpiresAt: &expired},
+					}, nil
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response: &chat.Response{
+				Msg: "your api tokens:\n" +
+					"ci order-write, never expires, last used 2026-10-01 12:30:00\n" +
+					"grafana read-only, expired 2026-01-01, never used",
+			},
+		},
+		{
+			name:   "should revoke token",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s token revoke ci", DefaultPrefix),
+			userService: &UserServiceMock{
+				RevokeAPITokenFunc: func(ctx context.Context, user *uuid.UUID, name string) (*entity.APIToken, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrRevokingAPIToken, repository.ErrAPITokenNotFound)
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "could not revoke api token: could not revoke api token: api token not found"},
+		},
+		{
+			name:        "should reject unknown scope",
+			sender:      "@test:matrix.org",
+			msg:         fmt.Sprintf("%s token create ci admin", DefaultPrefix),
+			userService: &UserServiceMock{},
+			messenger:   &DirectMessengerMock{},
+			matches:     true,
+			response:    &chat.Response{Msg: "scope must be one of read-only, order-write"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := APITokenHandler{
+				UserService: tc.userService,
+				Messenger:   tc.messenger,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   &entity.User{UUID: &userUUID},
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+				assert.Len(t, tc.messenger.SendDirectMessageCalls(), tc.directMsgs)
+
+				if calls := tc.userService.CreateAPITokenCalls(); len(calls) > 0 {
+					assert.Equal(t, tc.ttl, calls[0].TTL)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,367 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrUnterminatedQuote  = errors.New("missing closing quote")
+	ErrAmbiguousCommand   = errors.New("ambiguous command")
+	ErrMissingArgument    = errors.New("missing argument")
+	ErrUnexpectedArgument = errors.New("unexpected argument")
+)
+
+// quotes maps opening quotes to the closing quotes they accept. Typographic
+// quotes are accepted because some clients replace straight quotes while
+// typing.
+var quotes = map[rune]string{
+	'"':  `"`,
+	'\'': "'",
+	'“':  "”“",
+	'„':  "“”",
+	'‘':  "’‘",
+	'«':  "»",
+}
+
+// token is a word of a command message. Quotes only group words if they open
+// at the start of a word. value is the word without the quotes, raw keeps
+// them but with straight double quotes, e.g. "paneer makhni"x2.
+type token struct {
+	value  string
+	raw    string
+	quoted bool
+}
+
+// tokenize splits msg at whitespace outside of quotes. On errors it returns
+// the tokens read so far.
+func tokenize(msg string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(msg)
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		tok := token{}
+
+		var value, raw strings.Builder
+
+		if closing, ok := quotes[runes[i]]; ok {
+			end := slices.IndexFunc(runes[i+1:], func(r rune) bool { return strings.ContainsRune(closing, r) })
+			if end < 0 {
+				return tokens, fmt.Errorf("%w: %s", ErrUnterminatedQuote, string(runes[i:]))
+			}
+
+			value.WriteString(string(runes[i+1 : i+1+end]))
+			fmt.Fprintf(&raw, `"%s"`, string(runes[i+1:i+1+end]))
+			tok.quoted = true
+			i += end + 2
+		}
+
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			value.WriteRune(runes[i])
+			raw.WriteRune(runes[i])
+			i++
+		}
+
+		tok.value, tok.raw = value.String(), raw.String()
+		tokens = append(tokens, tok)
+	}
+
+	return tokens, nil
+}
+
+// quoteArgument quotes s if it has to be quoted to be read as one argument,
+// e.g. to suggest a command with a menu name containing spaces.
+func quoteArgument(s string) string {
+	if s == "" || strings.ContainsFunc(s, unicode.IsSpace) {
+		return fmt.Sprintf("%q", s)
+	}
+
+	return s
+}
+
+// command is an entry of the command grammar. It is defined by its usage, e.g.
+// "fav save <menu> <name> <items...>": the words naming the command followed
+// by required <arguments> and optional [arguments]. The last argument takes the
+// rest of the message if its name ends in "...".
+//
+// Words are matched ignoring case and may be abbreviated as long as the
+// abbreviation is unique, e.g. "hist" for "history".
+type command struct {
+	usage string
+	words []string
+	args  []argument
+}
+
+type argument struct {
+	name     string
+	optional bool
+	rest     bool
+}
+
+// commands holds all commands defined with newCommand, abbreviations are
+// resolved against them.
+var commands []*command
+
+func newCommand(usage string) *command {
+	c := &command{usage: usage}
+
+	for _, field := range strings.Fields(usage) {
+		if !strings.HasPrefix(field, "<") && !strings.HasPrefix(field, "[") {
+			c.words = append(c.words, field)
+			continue
+		}
+
+		name := field[1 : len(field)-1]
+		rest := strings.HasSuffix(name, "...")
+
+		c.args = append(c.args, argument{
+			name:     strings.TrimSuffix(name, "..."),
+			optional: strings.HasPrefix(field, "["),
+			rest:     rest,
+		})
+	}
+
+	commands = append(commands, c)
+
+	return c
+}
+
+// usageLine returns the usage with the command prefix of the room.
+func (c *command) usageLine(ctx context.Context) string {
+	return fmt.Sprintf("%s %s", commandPrefix(ctx), c.usage)
+}
+
+// addressed reports whether msg invokes the command, no matter whether the
+// arguments are valid or can be read at all.
+func (c *command) addressed(ctx context.Context, msg string) bool {
+	line, ok := parseCommandLine(ctx, msg)
+
+	return ok && slices.Equal(line.words, c.words)
+}
+
+// match returns the arguments if msg is a valid invocation of the command.
+func (c *command) match(ctx context.Context, msg string) (arguments, bool) {
+	line, ok := parseCommandLine(ctx, msg)
+	if !ok || line.err != nil || !slices.Equal(line.words, c.words) {
+		return nil, false
+	}
+
+	args, err := c.bind(line.args)
+	if err != nil {
+		args, err = c.bindDefaultMenu(ctx, line.args)
+	}
+
+	return args, err == nil
+}
+
+// bindDefaultMenu binds the tokens after the default menu of the room, so the
+// menu can be left out of commands starting with a <menu> argument.
+func (c *command) bindDefaultMenu(ctx context.Context, tokens []token) (arguments, error) {
+	menuName := defaultMenuName(ctx)
+	if menuName == "" || len(c.args) == 0 || c.args[0].name != "menu" || c.args[0].optional {
+		return nil, fmt.Errorf("%w: <menu>", ErrMissingArgument)
+	}
+
+	menu := token{value: menuName, raw: fmt.Sprintf(`"%s"`, menuName), quoted: true}
+
+	return c.bind(append([]token{menu}, tokens...))
+}
+
+// bind assigns the tokens to the arguments from left to right. Optional
+// arguments only get a token if there are more tokens than required
+// arguments.
+func (c *command) bind(tokens []token) (arguments, error) {
+	required := 0
+
+	for _, arg := range c.args {
+		if !arg.optional {
+			required++
+		}
+	}
+
+	args := arguments{}
+	spare := len(tokens) - required
+
+	for _, arg := range c.args {
+		switch {
+		case len(tokens) == 0 && !arg.optional:
+			return nil, fmt.Errorf("%w: <%s>", ErrMissingArgument, arg.name)
+		case len(tokens) == 0 || arg.optional && spare <= 0:
+			continue
+		case arg.rest:
+			args[arg.name], tokens = tokens, nil
+			continue
+		case arg.optional:
+			spare--
+		}
+
+		args[arg.name], tokens = tokens[:1], tokens[1:]
+	}
+
+	if len(tokens) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedArgument, tokens[0].raw)
+	}
+
+	return args, nil
+}
+
+// matchesAny reports whether msg is a valid invocation of one of the commands.
+func matchesAny(ctx context.Context, msg string, cmds ...*command) bool {
+	for _, c := range cmds {
+		if _, ok := c.match(ctx, msg); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// arguments are the tokens bound to each argument by name.
+type arguments map[string][]token
+
+// get returns the value of the argument, the values of a rest argument are
+// joined with spaces.
+func (a arguments) get(name string) string {
+	return strings.Join(a.values(name), " ")
+}
+
+func (a arguments) values(name string) []string {
+	values := make([]string, 0, len(a[name]))
+	for _, tok := range a[name] {
+		values = append(values, tok.value)
+	}
+
+	return values
+}
+
+// raw returns the argument including quotes, see token.
+func (a arguments) raw(name string) []string {
+	raw := make([]string, 0, len(a[name]))
+	for _, tok := range a[name] {
+		raw = append(raw, tok.raw)
+	}
+
+	return raw
+}
+
+// IsCommand reports whether msg is addressed to the bot, i.e. starts with the
+// command prefix of the room.
+func IsCommand(ctx context.Context, msg string) bool {
+	_, ok := parseCommandLine(ctx, msg)
+
+	return ok
+}
+
+// commandLine is a message addressed to the bot split into the words of the
+// command and its arguments.
+type commandLine struct {
+	words []string
+	args  []token
+	err   error
+}
+
+// parseCommandLine reads msg if it starts with the command prefix. Errors
+// while reading it, e.g. an unterminated quote, are kept in err along with
+// the words read up to the error.
+func parseCommandLine(ctx context.Context, msg string) (*commandLine, bool) {
+	prefix, rest := strings.TrimLeftFunc(msg, unicode.IsSpace), ""
+	if i := strings.IndexFunc(prefix, unicode.IsSpace); i >= 0 {
+		prefix, rest = prefix[:i], prefix[i:]
+	}
+
+	if !strings.EqualFold(prefix, commandPrefix(ctx)) {
+		return nil, false
+	}
+
+	line := &commandLine{}
+
+	tokens, err := tokenize(rest)
+
+	for len(line.words) < len(tokens) {
+		word, resolveErr := resolveWord(line.words, tokens[len(line.words)])
+		if resolveErr != nil {
+			line.err = resolveErr
+			return line, true
+		} else if word == "" {
+			break
+		}
+
+		line.words = append(line.words, word)
+	}
+
+	line.args, line.err = tokens[len(line.words):], err
+
+	return line, true
+}
+
+// resolveWord finds the word of a command that follows the already resolved
+// words and that tok spells out or abbreviates. The word is empty if tok is
+// not a command word, e.g. because it is the first argument.
+func resolveWord(words []string, tok token) (string, error) {
+	if tok.quoted {
+		return "", nil
+	}
+
+	input := strings.ToLower(tok.value)
+	candidates := []string{}
+
+	for _, c := range commands {
+		if len(c.words) <= len(words) || !slices.Equal(c.words[:len(words)], words) {
+			continue
+		}
+
+		word := c.words[len(words)]
+		if word == input {
+			return word, nil
+		}
+
+		if strings.HasPrefix(word, input) && !slices.Contains(candidates, word) {
+			candidates = append(candidates, word)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", nil
+	case 1:
+		return candidates[0], nil
+	default:
+		slices.Sort(candidates)
+
+		return "", fmt.Errorf("%w: '%s' could be %s", ErrAmbiguousCommand, tok.value, strings.Join(candidates, ", "))
+	}
+}
+
+// findCommand returns the command named by words, if any.
+func findCommand(words []string) *command {
+	for _, c := range commands {
+		if slices.Equal(c.words, words) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// commandsStartingWith returns the commands whose words start with words,
+// e.g. all "fav" commands.
+func commandsStartingWith(words []string) []*command {
+	found := []*command{}
+
+	for _, c := range commands {
+		if len(c.words) >= len(words) && slices.Equal(c.words[:len(words)], words) {
+			found = append(found, c)
+		}
+	}
+
+	return found
+}
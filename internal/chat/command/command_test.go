@@ -0,0 +1,171 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+func TestTokenize(t *testing.T) {
+	type testCase struct {
+		name   string
+		msg    string
+		tokens []token
+		err    error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should split at whitespace",
+			msg:    "  add\tsangam  62 ",
+			tokens: []token{{value: "add", raw: "add"}, {value: "sangam", raw: "sangam"}, {value: "62", raw: "62"}},
+		},
+		{
+			name: "should group quoted words",
+			msg:  `start "Pizza Mühle"`,
+			tokens: []token{
+				{value: "start", raw: "start"},
+				{value: "Pizza Mühle", raw: `"Pizza Mühle"`, quoted: true},
+			},
+		},
+		{
+			name: "should normalize typographic quotes and keep suffix",
+			msg:  "“paneer makhni”x2 „garlic naan“ 'dal'",
+			tokens: []token{
+				{value: "paneer makhnix2", raw: `"paneer makhni"x2`, quoted: true},
+				{value: "garlic naan", raw: `"garlic naan"`, quoted: true},
+				{value: "dal", raw: `"dal"`, quoted: true},
+			},
+		},
+		{
+			name:   "should only open quotes at the start of a word",
+			msg:    "Domino's Pizza",
+			tokens: []token{{value: "Domino's", raw: "Domino's"}, {value: "Pizza", raw: "Pizza"}},
+		},
+		{
+			name:   "should fail on unterminated quote",
+			msg:    `add "paneer makhni`,
+			tokens: []token{{value: "add", raw: "add"}},
+			err:    ErrUnterminatedQuote,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokens, err := tokenize(tc.msg)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.tokens, tokens)
+		})
+	}
+}
+
+func TestCommandMatch(t *testing.T) {
+	type testCase struct {
+		name     string
+		command  *command
+		settings *entity.RoomSettings
+		msg      string
+		matches  bool
+		args     map[string]string
+	}
+
+	pizzaMuehle := &entity.Menu{Name: "Pizza Mühle"}
+
+	testCases := []testCase{
+		{
+			name:    "should bind optional arguments from left to right",
+			command: historyCommand,
+			msg:     ".ordaa history pizza",
+			matches: true,
+			args:    map[string]string{"menu": "pizza", "count": ""},
+		},
+		{
+			name:    "should bind rest argument",
+			command: adminItemAddCommand,
+			msg:     `.ordaa admin item add "Pizza Mühle" M7 9,50 Chicken Masala`,
+			matches: true,
+			args:    map[string]string{"menu": "Pizza Mühle", "short_name": "M7", "price": "9,50", "name": "Chicken Masala"},
+		},
+		{
+			name:    "should resolve abbreviated words ignoring case",
+			command: favouriteListCommand,
+			msg:     ".ORDAA Fav L sangam",
+			matches: true,
+			args:    map[string]string{"menu": "sangam"},
+		},
+		{
+			name:    "should not take quoted word as command word",
+			command: sshKeyListCommand,
+			msg:     `.ordaa ssh-key "list"`,
+			matches: false,
+		},
+		{
+			name:    "should not match other command",
+			command: startCommand,
+			msg:     ".ordaa status sangam",
+			matches: false,
+		},
+		{
+			name:    "should not match without prefix",
+			command: startCommand,
+			msg:     "start sangam",
+			matches: false,
+		},
+		{
+			name:     "should match prefix of room",
+			command:  startCommand,
+			settings: &entity.RoomSettings{Prefix: "!food"},
+			msg:      "!FOOD start sangam",
+			matches:  true,
+			args:     map[string]string{"menu": "sangam"},
+		},
+		{
+			name:     "should not match default prefix in room with own prefix",
+			command:  startCommand,
+			settings: &entity.RoomSettings{Prefix: "!food"},
+			msg:      ".ordaa start sangam",
+			matches:  false,
+		},
+		{
+			name:     "should fill in default menu of room",
+			command:  statusCommand,
+			settings: &entity.RoomSettings{DefaultMenu: pizzaMuehle},
+			msg:      ".ordaa status",
+			matches:  true,
+			args:     map[string]string{"menu": "Pizza Mühle"},
+		},
+		{
+			name:     "should fill in default menu if one argument is missing",
+			command:  favouriteSaveCommand,
+			settings: &entity.RoomSettings{DefaultMenu: pizzaMuehle},
+			msg:      ".ordaa fav save lunch M7x2",
+			matches:  true,
+			args:     map[string]string{"menu": "Pizza Mühle", "name": "lunch", "items": "M7x2"},
+		},
+		{
+			name:     "should not fill in default menu without menu argument",
+			command:  linkCommand,
+			settings: &entity.RoomSettings{DefaultMenu: pizzaMuehle},
+			msg:      ".ordaa link a b",
+			matches:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			if tc.settings != nil {
+				ctx = WithRoomSettings(ctx, tc.settings)
+			}
+
+			args, matches := tc.command.match(ctx, tc.msg)
+			assert.Equal(t, tc.matches, matches)
+
+			for name, value := range tc.args {
+				assert.Equal(t, value, args.get(name), name)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that DirectMessengerMock does implement DirectMessenger.
+// If this is not the case, regenerate this file with moq.
+var _ DirectMessenger = &DirectMessengerMock{}
+
+// DirectMessengerMock is a mock implementation of DirectMessenger.
+//
+//	func TestSomethingThatUsesDirectMessenger(t *testing.T) {
+//
+//		// make and configure a mocked DirectMessenger
+//		mockedDirectMessenger := &DirectMessengerMock{
+//			DirectRoomFunc: func(ctx context.Context, username string) (string, error) {
+//				panic("mock out the DirectRoom method")
+//			},
+//			SendDirectFileFunc: func(ctx context.Context, username string, fileName string, mimeType string, data []byte) error {
+//				panic("mock out the SendDirectFile method")
+//			},
+//			SendDirectMessageFunc: func(ctx context.Context, username string, msg string) error {
+//				panic("mock out the SendDirectMessage method")
+//			},
+//		}
+//
+//		// use mockedDirectMessenger in code that requires DirectMessenger
+//		// and then make assertions.
+//
+//	}
+type DirectMessengerMock struct {
+	// DirectRoomFunc mocks the DirectRoom method.
+	DirectRoomFunc func(ctx context.Context, username string) (string, error)
+
+	// SendDirectFileFunc mocks the SendDirectFile method.
+	SendDirectFileFunc func(ctx context.Context, username string, fileName string, mimeType string, data []byte) error
+
+	// SendDirectMessageFunc mocks the SendDirectMessage method.
+	SendDirectMessageFunc func(ctx context.Context, username string, msg string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DirectRoom holds details about calls to the DirectRoom method.
+		DirectRoom []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+		}
+		// SendDirectFile holds details about calls to the SendDirectFile method.
+		SendDirectFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+			// FileName is the fileName argument value.
+			FileName string
+			// MimeType is the mimeType argument value.
+			MimeType string
+			// Data is the data argument value.
+			Data []byte
+		}
+		// SendDirectMessage holds details about calls to the SendDirectMessage method.
+		SendDirectMessage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+			// Msg is the msg argument value.
+			Msg string
+		}
+	}
+	lockDirectRoom        sync.RWMutex
+	lockSendDirectFile    sync.RWMutex
+	lockSendDirectMessage sync.RWMutex
+}
+
+// DirectRoom calls DirectRoomFunc.
+func (mock *DirectMessengerMock) DirectRoom(ctx context.Context, username string) (string, error) {
+	if mock.DirectRoomFunc == nil {
+		panic("DirectMessengerMock.DirectRoomFunc: method is nil but DirectMessenger.DirectRoom was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+	}{
+		Ctx:      ctx,
+		Username: username,
+	}
+	mock.lockDirectRoom.Lock()
+	mock.calls.DirectRoom = append(mock.calls.DirectRoom, callInfo)
+	mock.lockDirectRoom.Unlock()
+	return mock.DirectRoomFunc(ctx, username)
+}
+
+// DirectRoomCalls gets all the calls that were made to DirectRoom.
+// Check the length with:
+//
+//	len(mockedDirectMessenger.DirectRoomCalls())
+func (mock *DirectMessengerMock) DirectRoomCalls() []struct {
+	Ctx      context.Context
+	Username string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+	}
+	mock.lockDirectRoom.RLock()
+	calls = mock.calls.DirectRoom
+	mock.lockDirectRoom.RUnlock()
+	return calls
+}
+
+// SendDirectFile calls SendDirectFileFunc.
+func (mock *DirectMessengerMock) SendDirectFile(ctx context.Context, username string, fileName string, mimeType string, data []byte) error {
+	if mock.SendDirectFileFunc == nil {
+		panic("DirectMessengerMock.SendDirectFileFunc: method is nil but DirectMessenger.SendDirectFile was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+		FileName string
+		MimeType string
+		Data     []byte
+	}{
+		Ctx:      ctx,
+		Username: username,
+		FileName: fileName,
+		MimeType: mimeType,
+		Data:     data,
+	}
+	mock.lockSendDirectFile.Lock()
+	mock.calls.SendDirectFile = append(mock.calls.SendDirectFile, callInfo)
+	mock.lockSendDirectFile.Unlock()
+	return mock.SendDirectFileFunc(ctx, username, fileName, mimeType, data)
+}
+
+// SendDirectFileCalls gets all the calls that were made to SendDirectFile.
+// Check the length with:
+//
+//	len(mockedDirectMessenger.SendDirectFileCalls())
+func (mock *DirectMessengerMock) SendDirectFileCalls() []struct {
+	Ctx      context.Context
+	Username string
+	FileName string
+	MimeType string
+	Data     []byte
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+		FileName string
+		MimeType string
+		Data     []byte
+	}
+	mock.lockSendDirectFile.RLock()
+	calls = mock.calls.SendDirectFile
+	mock.lockSendDirectFile.RUnlock()
+	return calls
+}
+
+// SendDirectMessage calls SendDirectMessageFunc.
+func (mock *DirectMessengerMock) SendDirectMessage(ctx context.Context, username string, msg string) error {
+	if mock.SendDirectMessageFunc == nil {
+		panic("DirectMessengerMock.SendDirectMessageFunc: method is nil but DirectMessenger.SendDirectMessage was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+		Msg      string
+	}{
+		Ctx:      ctx,
+		Username: username,
+		Msg:      msg,
+	}
+	mock.lockSendDirectMessage.Lock()
+	mock.calls.SendDirectMessage = append(mock.calls.SendDirectMessage, callInfo)
+	mock.lockSendDirectMessage.Unlock()
+	return mock.SendDirectMessageFunc(ctx, username, msg)
+}
+
+// SendDirectMessageCalls gets all the calls that were made to SendDirectMessage.
+// Check the length with:
+//
+//	len(mockedDirectMessenger.SendDirectMessageCalls())
+func (mock *DirectMessengerMock) SendDirectMessageCalls() []struct {
+	Ctx      context.Context
+	Username string
+	Msg      string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+		Msg      string
+	}
+	mock.lockSendDirectMessage.RLock()
+	calls = mock.calls.SendDirectMessage
+	mock.lockSendDirectMessage.RUnlock()
+	return calls
+}
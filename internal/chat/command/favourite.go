@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	favouriteSaveCommand   = newCommand("fav save <menu> <name> <items...>")
+	favouriteListCommand   = newCommand("fav list <menu>")
+	favouriteDeleteCommand = newCommand("fav delete <menu> <name>")
+)
+
+// FavouriteHandler manages favourites, adding them to an order is done by the
+// AddHandler with '@name' instead of a short name.
+type FavouriteHandler struct {
+	OrderService OrderService
+}
+
+func (h *FavouriteHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, favouriteSaveCommand, favouriteListCommand, favouriteDeleteCommand)
+}
+
+func (h *FavouriteHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not manage favourites: %s", err)}
+	}
+
+	msg := req.Text
+
+	if args, ok := favouriteSaveCommand.match(ctx, msg); ok {
+		menuName, name, items := args.get("menu"), args.get("name"), args.raw("items")
+
+		if _, err = h.OrderService.SaveFavourite(ctx, currentUser.UUID, menuName, name, items); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not save favourite: %s", err)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx,
+			"saved favourite %s for %s, add it with '%s add %s @%s'",
+			name,
+			menuName,
+			commandPrefix(ctx),
+			quoteArgument(menuName),
+			name,
+		)}
+	}
+
+	if args, ok := favouriteDeleteCommand.match(ctx, msg); ok {
+		menuName, name := args.get("menu"), args.get("name")
+
+		if err = h.OrderService.DeleteFavourite(ctx, currentUser.UUID, menuName, name); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not delete favourite: %s", err)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx, "deleted favourite %s for %s", name, menuName)}
+	}
+
+	args, _ := favouriteListCommand.match(ctx, msg)
+	menuName := args.get("menu")
+
+	favourites, err := h.OrderService.GetFavourites(ctx, currentUser.UUID, menuName)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not list favourites: %s", err)}
+	}
+
+	if len(favourites) == 0 {
+		return &chat.Response{Msg: translatef(ctx, "you have no favourites for %s", menuName)}
+	}
+
+	lines := []string{translatef(ctx, "your favourites for %s:", menuName)}
+	for _, favourite := range favourites {
+		lines = append(lines, fmt.Sprintf("%s: %s", favourite.Name, formatFavouriteItems(ctx, favourite.Items)))
+	}
+
+	return &chat.Response{Msg: strings.Join(lines, "\n")}
+}
+
+func formatFavouriteItems(ctx context.Context, items []entity.FavouriteItem) string {
+	formatted := make([]string, 0, len(items))
+
+	for _, item := range items {
+		s := item.MenuItem.ShortName
+		if item.Quantity > 1 {
+			s = fmt.Sprintf("%sx%d", s, item.Quantity)
+		}
+
+		if item.MenuItem.DeletedAt.Valid {
+			s += translatef(ctx, " (removed from menu)")
+		}
+
+		formatted = append(formatted, s)
+	}
+
+	return strings.Join(formatted, ", ")
+}
@@ -0,0 +1,140 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestFavourite(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name         string
+		msg          string
+		orderService *OrderServiceMock
+		matches      bool
+		response     *chat.Response
+		items        []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "should save favourite",
+			msg:  fmt.Sprintf("%s fav save sangam usual M7 174x2", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				SaveFavouriteFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName, name string, items []string) (*entity.Favourite, error) {
+					return &entity.Favourite{Name: name}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "saved favourite usual for sangam, add it with '.ordaa add sangam @usual'"},
+			items:    []string{"M7", "174x2"},
+		},
+		{
+			name: "should report unknown item",
+			msg:  fmt.Sprintf("%s fav save sangam usual M99", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				SaveFavouriteFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName, name string, items []string) (*entity.Favourite, error) {
+					return nil, fmt.Errorf("%w: M99: %w", service.ErrSavingFavourite, repository.ErrMenuItemNotFound)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not save favourite: could not save favourite: M99: menu item not found"},
+			items:    []string{"M99"},
+		},
+		{
+			name: "should list favourites",
+			msg:  fmt.Sprintf("%s fav list sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetFavouritesFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error) {
+					return []entity.Favourite{
+						{
+							Name: "usual",
+							Items: []entity.FavouriteItem{
+								{Quantity: 1, MenuItem: entity.MenuItem{ShortName: "M7"}},
+								{Quantity: 2, MenuItem: entity.MenuItem{ShortName: "174"}},
+							},
+						},
+						{
+							Name: "veggie",
+							Items: []entity.FavouriteItem{
+								{
+									Quantity: 1,
+									MenuItem: entity.MenuItem{ShortName: "V1", DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "your favourites for sangam:\nusual: M7, 174x2\nveggie: V1 (removed from menu)",
+			},
+		},
+		{
+			name: "should list no favourites",
+			msg:  fmt.Sprintf("%s fav list sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetFavouritesFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error) {
+					return nil, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "you have no favourites for sangam"},
+		},
+		{
+			name: "should delete favourite",
+			msg:  fmt.Sprintf("%s fav delete sangam usual", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				DeleteFavouriteFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName, name string) error {
+					return nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "deleted favourite usual for sangam"},
+		},
+		{
+			name:    "should not match favourite without items",
+			msg:     fmt.Sprintf("%s fav save sangam usual", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := FavouriteHandler{
+				OrderService: tc.orderService,
+			}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				User:   &entity.User{UUID: &userUUID},
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+
+				if calls := tc.orderService.SaveFavouriteCalls(); len(calls) > 0 {
+					assert.Equal(t, tc.items, calls[0].Items)
+				}
+			}
+		})
+	}
+}
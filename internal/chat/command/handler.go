@@ -0,0 +1,29 @@
+// Package command has the chat commands of the bot. They only see chat
+// requests, so every chat front end and a local shell can use them.
+package command
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+// DefaultPrefix is the prefix commands start with in rooms that did not set
+// another one.
+const DefaultPrefix = ".ordaa"
+
+// usageResponse answers a message that does not fit the usage of c.
+func usageResponse(ctx context.Context, c *command) *chat.Response {
+	return &chat.Response{Msg: translatef(ctx, "usage: %s", c.usageLine(ctx))}
+}
+
+// senderAccount returns the account of the sender of req.
+func senderAccount(req *chat.Request) (*entity.User, error) {
+	if req.User == nil {
+		return nil, repository.ErrUserNotFound
+	}
+
+	return req.User, nil
+}
@@ -0,0 +1,23 @@
+package command
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+var helpCommand = newCommand("help")
+
+type HelpHandler struct{}
+
+func (h *HelpHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := helpCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *HelpHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	return &chat.Response{Msg: translatef(ctx, "Hello world")}
+}
@@ -1,11 +1,12 @@
-package handler
+package command
 
 import (
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"maunium.net/go/mautrix/event"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
 )
 
 func TestHelp(t *testing.T) {
@@ -16,15 +17,15 @@ func TestHelp(t *testing.T) {
 		name     string
 		msg      string
 		matches  bool
-		response *CommandResponse
+		response *chat.Response
 	}
 
 	testCases := []testCase{
 		{
 			name:     "should handle help command",
-			msg:      fmt.Sprintf("%s help", MatrixCommandPrefix),
+			msg:      fmt.Sprintf("%s help", DefaultPrefix),
 			matches:  true,
-			response: &CommandResponse{Msg: "Hello world"},
+			response: &chat.Response{Msg: "Hello world"},
 		},
 		{
 			name:    "should not match help command without prefix",
@@ -33,31 +34,27 @@ func TestHelp(t *testing.T) {
 		},
 		{
 			name:    "should not match help command from empty message",
-			msg:     MatrixCommandPrefix,
+			msg:     DefaultPrefix,
 			matches: false,
 		},
 		{
-			name:    "should not match help command with trailing whitespaces",
-			msg:     fmt.Sprintf("%s help ", MatrixCommandPrefix),
+			name:    "should not match help command with arguments",
+			msg:     fmt.Sprintf("%s help me", DefaultPrefix),
 			matches: false,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			evt := &event.Event{
-				Content: event.Content{
-					Parsed: &event.MessageEventContent{
-						Body: tc.msg,
-					},
-				},
+			req := &chat.Request{
+				Text: tc.msg,
 			}
 
-			matches := h.Matches(ctx, evt)
+			matches := h.Matches(ctx, req)
 			assert.Equal(t, tc.matches, matches)
 
 			if matches {
-				resp := h.Handle(ctx, evt)
+				resp := h.Handle(ctx, req)
 
 				if tc.response != nil {
 					assert.NotNil(t, resp)
@@ -0,0 +1,135 @@
+package command
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	historyCommand = newCommand("history [menu] [count]")
+	againCommand   = newCommand("again <menu>")
+)
+
+type HistoryHandler struct {
+	OrderService OrderService
+}
+
+func (h *HistoryHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, historyCommand, againCommand)
+}
+
+func (h *HistoryHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	if args, ok := againCommand.match(ctx, msg); ok {
+		return h.again(ctx, req, args.get("menu"))
+	}
+
+	args, ok := historyCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, historyCommand)
+	}
+
+	menuName, count := args.get("menu"), args.get("count")
+
+	// a single number is the count, a menu called like a number needs both
+	if count == "" && isNumber(menuName) {
+		menuName, count = "", menuName
+	}
+
+	limit := 0
+	if count != "" {
+		var err error
+		if limit, err = strconv.Atoi(count); err != nil || limit == 0 {
+			return &chat.Response{Msg: translatef(ctx, "count must be a number greater than 0")}
+		}
+	}
+
+	orders, err := h.OrderService.GetOrderHistory(ctx, req.Room, menuName, limit)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get order history: %s", err)}
+	}
+
+	if len(orders) == 0 {
+		return &chat.Response{Msg: translatef(ctx, "there are no delivered orders yet")}
+	}
+
+	lines := make([]string, 0, len(orders)+1)
+	lines = append(lines, translatef(ctx, "delivered orders:"))
+
+	for _, order := range orders {
+		lines = append(lines, translatef(
+			ctx,
+			"%s %s by %s, total %s",
+			formatOrderDate(ctx, order.CreatedAt),
+			order.MenuName,
+			initiatorName(ctx, order),
+			formatPrice(ctx, order.Total),
+		))
+	}
+
+	return &chat.Response{Msg: strings.Join(lines, "\n")}
+}
+
+func (h *HistoryHandler) again(ctx context.Context, req *chat.Request, menuName string) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not order again: %s", err)}
+	}
+
+	reorder, err := h.OrderService.ReorderLast(ctx, currentUser.UUID, req.Room, menuName)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not order again: %s", err)}
+	}
+
+	total := 0
+	for _, orderItem := range reorder.Added {
+		total += orderItem.Price
+	}
+
+	resp := translatef(
+		ctx,
+		"added %d items from your order of %s to active order %s, total %s",
+		len(reorder.Added),
+		formatOrderDate(ctx, reorder.Previous.CreatedAt),
+		menuName,
+		formatPrice(ctx, total),
+	)
+
+	if reorder.Skipped > 0 {
+		resp += translatef(ctx, ", %d items are not on the menu anymore", reorder.Skipped)
+	}
+
+	return &chat.Response{Msg: resp}
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+
+	return err == nil
+}
+
+// formatOrderDate formats the creation date, which is unknown for orders
+// from before it was stored.
+func formatOrderDate(ctx context.Context, createdAt *time.Time) string {
+	if createdAt == nil {
+		return translatef(ctx, "unknown date")
+	}
+
+	return formatDate(ctx, *createdAt)
+}
+
+func initiatorName(ctx context.Context, order entity.OrderSummary) string {
+	if order.InitiatorName == "" {
+		return translatef(ctx, "a deleted user")
+	}
+
+	return order.InitiatorName
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestHistory(t *testing.T) {
+	ctx := t.Context()
+
+	createdAt := time.Date(2026, 10, 12, 12, 0, 0, 0, time.UTC)
+
+	history := []entity.OrderSummary{
+		{Order: entity.Order{CreatedAt: &createdAt}, MenuName: "pizza", InitiatorName: "Alice", Total: 4550},
+		{MenuName: "sushi", Total: 1200},
+	}
+
+	type testCase struct {
+		name         string
+		msg          string
+		orderService *OrderServiceMock
+		matches      bool
+		response     *chat.Response
+		menuName     string
+		limit        int
+		language     string
+	}
+
+	testCases := []testCase{
+		{
+			name: "should list delivered orders",
+			msg:  fmt.Sprintf("%s history", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderHistoryFunc: func(ctx context.Context, roomID, menuName string, limit int) ([]entity.OrderSummary, error) {
+					return history, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "delivered orders:\n" +
+					"2026-10-12 pizza by Alice, total 45.50\n" +
+					"unknown date sushi by a deleted user, total 12.00",
+			},
+		},
+		{
+			name: "should list delivered orders of menu",
+			msg:  fmt.Sprintf("%s history pizza 3", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderHistoryFunc: func(ctx context.Context, roomID, menuName string, limit int) ([]entity.OrderSummary, error) {
+					return history[:1], nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "delivered orders:\n2026-10-12 pizza by Alice, total 45.50"},
+			menuName: "pizza",
+			limit:    3,
+		},
+		{
+			name: "should read single number as count",
+			msg:  fmt.Sprintf("%s history 10", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderHistoryFunc: func(ctx context.Context, roomID, menuName string, limit int) ([]entity.OrderSummary, error) {
+					return nil, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "there are no delivered orders yet"},
+			limit:    10,
+		},
+		{
+			name: "should list delivered orders in language of room",
+			msg:  fmt.Sprintf("%s history", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderHistoryFunc: func(ctx context.Context, roomID, menuName string, limit int) ([]entity.OrderSummary, error) {
+					return history, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "gelieferte Bestellungen:\n" +
+					"12.10.2026 pizza von Alice, insgesamt 45,50\n" +
+					"unbekanntes Datum sushi von einem gelöschten Nutzer, insgesamt 12,00",
+			},
+			language: "de",
+		},
+		{
+			name:         "should reject count of zero",
+			msg:          fmt.Sprintf("%s history pizza 0", DefaultPrefix),
+			orderService: &OrderServiceMock{},
+			matches:      true,
+			response:     &chat.Response{Msg: "count must be a number greater than 0"},
+		},
+		{
+			name: "should add items of last order",
+			msg:  fmt.Sprintf("%s again pizza", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				ReorderLastFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*service.Reorder, error) {
+					return &service.Reorder{
+						Previous: history[0],
+						Added:    []entity.OrderItem{{Price: 790}, {Price: 350}},
+						Skipped:  1,
+					}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "added 2 items from your order of 2026-10-12 to active order pizza, total 11.40, " +
+					"1 item is not on the menu anymore",
+			},
+		},
+		{
+			name: "should report missing active order",
+			msg:  fmt.Sprintf("%s again pizza", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				ReorderLastFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*service.Reorder, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrReordering, repository.ErrOrderNotFound)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not order again: could not order again: order not found"},
+		},
+		{
+			name: "should add single item of last order in language of room",
+			msg:  fmt.Sprintf("%s again pizza", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				ReorderLastFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*service.Reorder, error) {
+					return &service.Reorder{
+						Previous: history[0],
+						Added:    []entity.OrderItem{{Price: 123456}},
+						Skipped:  2,
+					}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "1 Gericht aus deiner Bestellung vom 12.10.2026 zur aktiven Bestellung pizza hinzugefügt, insgesamt 1.234,56, " +
+					"2 Gerichte stehen nicht mehr auf der Karte",
+			},
+			language: "de",
+		},
+		{
+			name:    "should not match again without menu",
+			msg:     fmt.Sprintf("%s again", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := HistoryHandler{
+				OrderService: tc.orderService,
+			}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				User:   &entity.User{UUID: &userUUID},
+				Text:   tc.msg,
+			}
+
+			roomCtx := WithRoomSettings(ctx, &entity.RoomSettings{Language: tc.language})
+
+			matches := h.Matches(roomCtx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(roomCtx, req)
+				assert.Equal(t, tc.response, resp)
+
+				if calls := tc.orderService.GetOrderHistoryCalls(); len(calls) > 0 {
+					assert.Equal(t, tc.menuName, calls[0].MenuName)
+					assert.Equal(t, tc.limit, calls[0].Limit)
+				}
+			}
+		})
+	}
+}
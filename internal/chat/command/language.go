@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+var (
+	languageShowCommand  = newCommand("language show")
+	languageSetCommand   = newCommand("language set <language>")
+	languageResetCommand = newCommand("language reset")
+)
+
+// LanguageHandler shows and changes the language the sender gets replies in,
+// which overrides the language of the room.
+type LanguageHandler struct {
+	UserService UserService
+}
+
+func (h *LanguageHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, languageShowCommand, languageSetCommand, languageResetCommand)
+}
+
+func (h *LanguageHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get language: %s", err)}
+	}
+
+	if _, ok := languageShowCommand.match(ctx, msg); ok {
+		return h.show(ctx, currentUser.UUID)
+	}
+
+	lang := ""
+
+	if args, ok := languageSetCommand.match(ctx, msg); ok {
+		lang = strings.ToLower(args.get("language"))
+	} else if _, ok = languageResetCommand.match(ctx, msg); !ok {
+		return usageResponse(ctx, languageSetCommand)
+	}
+
+	user, err := h.UserService.SetLanguage(ctx, currentUser.UUID, lang)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not change language: %s", err)}
+	}
+
+	// the reply is already in the new language
+	ctx = WithUserLanguage(ctx, user.Language)
+
+	if user.Language == "" {
+		return &chat.Response{Msg: translatef(ctx, "you now get replies in the language of the room")}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "your language is now %s", user.Language)}
+}
+
+func (h *LanguageHandler) show(ctx context.Context, userUUID *uuid.UUID) *chat.Response {
+	user, err := h.UserService.GetUser(ctx, userUUID)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get language: %s", err)}
+	}
+
+	if user.Language == "" {
+		return &chat.Response{Msg: translatef(ctx, "your language is %s, like in this room", replyLanguage(ctx))}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "your language is %s", user.Language)}
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestLanguage(t *testing.T) {
+	ctx := WithRoomSettings(t.Context(), &entity.RoomSettings{Language: "en"})
+
+	userUUID := uuid.Must(uuid.NewV4())
+
+	setLanguage := func(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error) {
+		if language == "fr" {
+			return nil, fmt.Errorf("%w: %w: fr, the languages are en, de", service.ErrSettingLanguage, service.ErrUnsupportedLanguage)
+		}
+
+		return &entity.User{UUID: userUUID, Language: language}, nil
+	}
+
+	type testCase struct {
+		name        string
+		msg         string
+		userService *UserServiceMock
+		matches     bool
+		response    *chat.Response
+		language    string
+	}
+
+	testCases := []testCase{
+		{
+			name: "should show language of room",
+			msg:  fmt.Sprintf("%s language show", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetUserFunc: func(ctx context.Context, uuid *uuid.UUID) (*entity.User, error) {
+					return &entity.User{UUID: uuid}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "your language is en, like in this room"},
+		},
+		{
+			name: "should show language of user",
+			msg:  fmt.Sprintf("%s language show", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetUserFunc: func(ctx context.Context, uuid *uuid.UUID) (*entity.User, error) {
+					return &entity.User{UUID: uuid, Language: "de"}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "your language is de"},
+		},
+		{
+			name:        "should reply in new language",
+			msg:         fmt.Sprintf("%s language set DE", DefaultPrefix),
+			userService: &UserServiceMock{SetLanguageFunc: setLanguage},
+			matches:     true,
+			response:    &chat.Response{Msg: "deine Sprache ist jetzt de"},
+			language:    "de",
+		},
+		{
+			name:        "should reset language",
+			msg:         fmt.Sprintf("%s language reset", DefaultPrefix),
+			userService: &UserServiceMock{SetLanguageFunc: setLanguage},
+			matches:     true,
+			response:    &chat.Response{Msg: "you now get replies in the language of the room"},
+		},
+		{
+			name:        "should report unsupported language",
+			msg:         fmt.Sprintf("%s language set fr", DefaultPrefix),
+			userService: &UserServiceMock{SetLanguageFunc: setLanguage},
+			matches:     true,
+			response: &chat.Response{
+				Msg: "could not change language: could not set language: unsupported language: fr, the languages are en, de",
+			},
+			language: "fr",
+		},
+		{
+			name:    "should not match set without language",
+			msg:     fmt.Sprintf("%s language set", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := LanguageHandler{UserService: tc.userService}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				User:   &entity.User{UUID: &userUUID},
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+
+				if calls := tc.userService.SetLanguageCalls(); len(calls) > 0 {
+					assert.Equal(t, &userUUID, calls[0].UserUUID)
+					assert.Equal(t, tc.language, calls[0].Language)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+var linkCommand = newCommand("link [code]")
+
+const linkCodeMessage = "Your link code is %s, it is valid for %d minutes. Send '%s link %s' from your other chat account " +
+	"or enter it when logging in with your password or ssh key to add that login to this account."
+
+//go:generate go tool moq -rm -out direct_messenger_mock.go . DirectMessenger
+
+// DirectMessenger sends messages that must not be visible to the rest of a
+// room, e.g. secrets, in a direct chat with the user.
+type DirectMessenger interface {
+	SendDirectMessage(ctx context.Context, username, msg string) error
+	SendDirectFile(ctx context.Context, username, fileName, mimeType string, data []byte) error
+	DirectRoom(ctx context.Context, username string) (string, error)
+}
+
+type LinkHandler struct {
+	UserService UserService
+	Accounts    Accounts
+	Messenger   DirectMessenger
+}
+
+func (h *LinkHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := linkCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *LinkHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	args, ok := linkCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, linkCommand)
+	}
+
+	if code := args.get("code"); code != "" {
+		return h.redeem(ctx, req, code)
+	}
+
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not create link code: %s", err)}
+	}
+
+	linkCode, err := h.UserService.CreateLinkCode(ctx, currentUser.UUID)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not create link code: %s", err)}
+	}
+
+	err = h.Messenger.SendDirectMessage(ctx, req.Sender, translatef(
+		ctx,
+		linkCodeMessage,
+		linkCode.Code,
+		int(service.LinkCodeTTL.Minutes()),
+		commandPrefix(ctx),
+		linkCode.Code,
+	))
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not send link code: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "sent you a link code via direct message")}
+}
+
+func (h *LinkHandler) redeem(ctx context.Context, req *chat.Request, code string) *chat.Response {
+	var (
+		user *entity.User
+		err  error
+	)
+
+	// a sender without an account is added to the linked account directly
+	// instead of being merged into it
+	if req.User == nil {
+		user, err = h.Accounts.Link(ctx, req, code)
+	} else {
+		user, err = h.UserService.LinkUser(ctx, req.User.UUID, code)
+	}
+
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not link account: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "linked %s to account %s", req.Sender, user.Name)}
+}
@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestLink(t *testing.T) {
+	ctx := t.Context()
+
+	// only @test:matrix.org is registered
+	accountOf := func(sender string) *entity.User {
+		if sender != "@test:matrix.org" {
+			return nil
+		}
+
+		return &entity.User{UUID: &userUUID}
+	}
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		userService UserService
+		accounts    Accounts
+		messenger   *DirectMessengerMock
+		matches     bool
+		response    *chat.Response
+		directMsgs  int
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should send link code via direct message",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s link", DefaultPrefix),
+			userService: &UserServiceMock{
+				CreateLinkCodeFunc: func(ctx context.Context, user *uuid.UUID) (*entity.LinkCode, error) {
+					return &entity.LinkCode{UserUUID: user, Code: "ABCD2345", ExpiresAt: time.Now().Add(service.LinkCodeTTL)}, nil
+				},
+			},
+			messenger: &DirectMessengerMock{
+				SendDirectMessageFunc: func(ctx context.Context, username, msg string) error {
+					if username != "@test:matrix.org" {
+						return fmt.Errorf("wrong recipient %s", username)
+					}
+
+					return nil
+				},
+			},
+			matches:    true,
+			response:   &chat.Response{Msg: "sent you a link code via direct message"},
+			directMsgs: 1,
+		},
+		{
+			name:        "should not create link code for unregistered user",
+			sender:      "@unknown:matrix.org",
+			msg:         fmt.Sprintf("%s link", DefaultPrefix),
+			userService: &UserServiceMock{},
+			messenger:   &DirectMessengerMock{},
+			matches:     true,
+			response:    &chat.Response{Msg: "could not create link code: user not found"},
+		},
+		{
+			name:   "should merge registered user into linked account",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s link abcd-2345", DefaultPrefix),
+			userService: &UserServiceMock{
+				LinkUserFunc: func(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error) {
+					if *currentUser != userUUID || code != "abcd-2345" {
+						return nil, repository.ErrLinkCodeNotFound
+					}
+
+					return &entity.User{Name: "@alice:matrix.org"}, nil
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "linked @test:matrix.org to account @alice:matrix.org"},
+		},
+		{
+			name:        "should add unregistered user to linked account",
+			sender:      "@unknown:matrix.org",
+			msg:         fmt.Sprintf("%s link ABCD2345", DefaultPrefix),
+			userService: &UserServiceMock{},
+			accounts: &AccountsMock{
+				LinkFunc: func(ctx context.Context, req *chat.Request, code string) (*entity.User, error) {
+					if req.Sender != "@unknown:matrix.org" || code != "ABCD2345" {
+						return nil, repository.ErrLinkCodeNotFound
+					}
+
+					return &entity.User{Name: "@alice:matrix.org"}, nil
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "linked @unknown:matrix.org to account @alice:matrix.org"},
+		},
+		{
+			name:   "should handle expired link code",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s link ABCD2345", DefaultPrefix),
+			userService: &UserServiceMock{
+				LinkUserFunc: func(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrLinkingAccount, repository.ErrLinkCodeNotFound)
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "could not link account: could not link account: link code not found or expired"},
+		},
+		{
+			name:    "should not match link command with several arguments",
+			msg:     fmt.Sprintf("%s link ABCD 2345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := LinkHandler{
+				UserService: tc.userService,
+				Accounts:    tc.accounts,
+				Messenger:   tc.messenger,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   accountOf(tc.sender),
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+
+				assert.Len(t, tc.messenger.SendDirectMessageCalls(), tc.directMsgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+type userLanguageKey struct{}
+
+// WithUserLanguage returns a context in which replies are sent in the
+// language the sender chose, an empty language keeps the one of the room.
+func WithUserLanguage(ctx context.Context, lang string) context.Context {
+	return context.WithValue(ctx, userLanguageKey{}, lang)
+}
+
+// replyLanguage returns the language of the sender, else the language of the
+// room, else the default language.
+func replyLanguage(ctx context.Context) string {
+	if lang, ok := ctx.Value(userLanguageKey{}).(string); ok && lang != "" {
+		return lang
+	}
+
+	if lang := roomSettings(ctx).Language; lang != "" {
+		return lang
+	}
+
+	return service.Languages[0]
+}
+
+type dateLayouts struct {
+	date     string
+	dateTime string
+}
+
+// layouts are the date formats of the languages, English uses ISO dates.
+var layouts = map[string]dateLayouts{
+	"en": {date: time.DateOnly, dateTime: time.DateTime},
+	"de": {date: "02.01.2006", dateTime: "02.01.2006 15:04:05"},
+}
+
+func printer(ctx context.Context) *message.Printer {
+	return message.NewPrinter(language.Make(replyLanguage(ctx)), message.Catalog(messages))
+}
+
+// translatef formats a reply in the reply language. The English message is
+// the key into the catalog, so it is used as is if there is no translation.
+func translatef(ctx context.Context, format string, args ...any) string {
+	return printer(ctx).Sprintf(format, args...)
+}
+
+// formatPrice formats a price in cents as euros with the decimal and
+// thousands separators of the reply language, e.g. 790 as "7.90" or "7,90".
+func formatPrice(ctx context.Context, cents int) string {
+	return printer(ctx).Sprint(number.Decimal(float64(cents)/100, number.Scale(2)))
+}
+
+func formatDate(ctx context.Context, t time.Time) string {
+	return t.Format(localLayouts(ctx).date)
+}
+
+func formatDateTime(ctx context.Context, t time.Time) string {
+	return t.Format(localLayouts(ctx).dateTime)
+}
+
+func localLayouts(ctx context.Context) dateLayouts {
+	if l, ok := layouts[replyLanguage(ctx)]; ok {
+		return l
+	}
+
+	return layouts[service.Languages[0]]
+}
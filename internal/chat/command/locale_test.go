@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+func TestTranslate(t *testing.T) {
+	german := WithRoomSettings(t.Context(), &entity.RoomSettings{Language: "de"})
+
+	type testCase struct {
+		name     string
+		ctx      context.Context
+		format   string
+		args     []any
+		expected string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "should default to english",
+			ctx:      t.Context(),
+			format:   "created menu %s",
+			args:     []any{"pizza"},
+			expected: "created menu pizza",
+		},
+		{
+			name:     "should use language of room",
+			ctx:      german,
+			format:   "created menu %s",
+			args:     []any{"pizza"},
+			expected: "Karte pizza angelegt",
+		},
+		{
+			name:     "should prefer language of user",
+			ctx:      WithUserLanguage(german, "en"),
+			format:   "created menu %s",
+			args:     []any{"pizza"},
+			expected: "created menu pizza",
+		},
+		{
+			name:     "should keep language of room without language of user",
+			ctx:      WithUserLanguage(german, ""),
+			format:   "created menu %s",
+			args:     []any{"pizza"},
+			expected: "Karte pizza angelegt",
+		},
+		{
+			name:     "should use singular",
+			ctx:      t.Context(),
+			format:   "added %d items of favourite %s to active order %s",
+			args:     []any{1, "usual", "sangam"},
+			expected: "added 1 item of favourite usual to active order sangam",
+		},
+		{
+			name:     "should use plural",
+			ctx:      german,
+			format:   "added %d items of favourite %s to active order %s",
+			args:     []any{3, "usual", "sangam"},
+			expected: "3 Gerichte aus Favorit usual zur aktiven Bestellung sangam hinzugefügt",
+		},
+		{
+			name:     "should translate order state",
+			ctx:      german,
+			format:   entity.Finalized,
+			expected: "abgeschlossen",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, translatef(tc.ctx, tc.format, tc.args...))
+		})
+	}
+}
+
+func TestGermanMessages(t *testing.T) {
+	english := message.NewPrinter(language.English, message.Catalog(messages))
+	german := message.NewPrinter(language.German, message.Catalog(messages))
+
+	for key := range germanMessages {
+		assert.NotEqual(t, english.Sprintf(key), german.Sprintf(key), "message %q is not translated", key)
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	german := WithRoomSettings(t.Context(), &entity.RoomSettings{Language: "de"})
+
+	assert.Equal(t, "7.90", formatPrice(t.Context(), 790))
+	assert.Equal(t, "0.05", formatPrice(t.Context(), 5))
+	assert.Equal(t, "1,234.50", formatPrice(t.Context(), 123450))
+	assert.Equal(t, "7,90", formatPrice(german, 790))
+	assert.Equal(t, "1.234,50", formatPrice(german, 123450))
+}
+
+func TestFormatDate(t *testing.T) {
+	german := WithRoomSettings(t.Context(), &entity.RoomSettings{Language: "de"})
+	date := time.Date(2026, 10, 12, 13, 5, 0, 0, time.UTC)
+
+	assert.Equal(t, "2026-10-12", formatDate(t.Context(), date))
+	assert.Equal(t, "2026-10-12 13:05:00", formatDateTime(t.Context(), date))
+	assert.Equal(t, "12.10.2026", formatDate(german, date))
+	assert.Equal(t, "12.10.2026 13:05:00", formatDateTime(german, date))
+}
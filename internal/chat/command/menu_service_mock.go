@@ -0,0 +1,605 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that MenuServiceMock does implement MenuService.
+// If this is not the case, regenerate this file with moq.
+var _ MenuService = &MenuServiceMock{}
+
+// MenuServiceMock is a mock implementation of MenuService.
+//
+//	func TestSomethingThatUsesMenuService(t *testing.T) {
+//
+//		// make and configure a mocked MenuService
+//		mockedMenuService := &MenuServiceMock{
+//			AddMenuAliasFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string, alias string) (*entity.Menu, []entity.MenuAlias, error) {
+//				panic("mock out the AddMenuAlias method")
+//			},
+//			AddMenuItemFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string, menuItem *entity.MenuItem) (*entity.MenuItem, error) {
+//				panic("mock out the AddMenuItem method")
+//			},
+//			CreateMenuFunc: func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+//				panic("mock out the CreateMenu method")
+//			},
+//			GetAllMenusFunc: func(ctx context.Context) ([]entity.Menu, error) {
+//				panic("mock out the GetAllMenus method")
+//			},
+//			GetMenuFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Menu, error) {
+//				panic("mock out the GetMenu method")
+//			},
+//			GetMenuByNameFunc: func(ctx context.Context, name string) (*entity.Menu, error) {
+//				panic("mock out the GetMenuByName method")
+//			},
+//			ImportMenuFunc: func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu, dryRun bool) (*entity.MenuDiff, error) {
+//				panic("mock out the ImportMenu method")
+//			},
+//			RemoveMenuAliasFunc: func(ctx context.Context, currentUser *uuid.UUID, alias string) (*entity.Menu, []entity.MenuAlias, error) {
+//				panic("mock out the RemoveMenuAlias method")
+//			},
+//			RemoveMenuItemFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string, shortName string) (*entity.MenuItem, error) {
+//				panic("mock out the RemoveMenuItem method")
+//			},
+//			UpdateMenuItemPriceFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string, shortName string, price int) (*entity.MenuItem, error) {
+//				panic("mock out the UpdateMenuItemPrice method")
+//			},
+//		}
+//
+//		// use mockedMenuService in code that requires MenuService
+//		// and then make assertions.
+//
+//	}
+type MenuServiceMock struct {
+	// AddMenuAliasFunc mocks the AddMenuAlias method.
+	AddMenuAliasFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string, alias string) (*entity.Menu, []entity.MenuAlias, error)
+
+	// AddMenuItemFunc mocks the AddMenuItem method.
+	AddMenuItemFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string, menuItem *entity.MenuItem) (*entity.MenuItem, error)
+
+	// CreateMenuFunc mocks the CreateMenu method.
+	CreateMenuFunc func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error)
+
+	// GetAllMenusFunc mocks the GetAllMenus method.
+	GetAllMenusFunc func(ctx context.Context) ([]entity.Menu, error)
+
+	// GetMenuFunc mocks the GetMenu method.
+	GetMenuFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Menu, error)
+
+	// GetMenuByNameFunc mocks the GetMenuByName method.
+	GetMenuByNameFunc func(ctx context.Context, name string) (*entity.Menu, error)
+
+	// ImportMenuFunc mocks the ImportMenu method.
+	ImportMenuFunc func(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu, dryRun bool) (*entity.MenuDiff, error)
+
+	// RemoveMenuAliasFunc mocks the RemoveMenuAlias method.
+	RemoveMenuAliasFunc func(ctx context.Context, currentUser *uuid.UUID, alias string) (*entity.Menu, []entity.MenuAlias, error)
+
+	// RemoveMenuItemFunc mocks the RemoveMenuItem method.
+	RemoveMenuItemFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string, shortName string) (*entity.MenuItem, error)
+
+	// UpdateMenuItemPriceFunc mocks the UpdateMenuItemPrice method.
+	UpdateMenuItemPriceFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string, shortName string, price int) (*entity.MenuItem, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddMenuAlias holds details about calls to the AddMenuAlias method.
+		AddMenuAlias []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Alias is the alias argument value.
+			Alias string
+		}
+		// AddMenuItem holds details about calls to the AddMenuItem method.
+		AddMenuItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+			// MenuItem is the menuItem argument value.
+			MenuItem *entity.MenuItem
+		}
+		// CreateMenu holds details about calls to the CreateMenu method.
+		CreateMenu []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// Menu is the menu argument value.
+			Menu *entity.Menu
+		}
+		// GetAllMenus holds details about calls to the GetAllMenus method.
+		GetAllMenus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetMenu holds details about calls to the GetMenu method.
+		GetMenu []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GetMenuByName holds details about calls to the GetMenuByName method.
+		GetMenuByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// ImportMenu holds details about calls to the ImportMenu method.
+		ImportMenu []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// Menu is the menu argument value.
+			Menu *entity.Menu
+			// DryRun is the dryRun argument value.
+			DryRun bool
+		}
+		// RemoveMenuAlias holds details about calls to the RemoveMenuAlias method.
+		RemoveMenuAlias []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// Alias is the alias argument value.
+			Alias string
+		}
+		// RemoveMenuItem holds details about calls to the RemoveMenuItem method.
+		RemoveMenuItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+			// ShortName is the shortName argument value.
+			ShortName string
+		}
+		// UpdateMenuItemPrice holds details about calls to the UpdateMenuItemPrice method.
+		UpdateMenuItemPrice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+			// ShortName is the shortName argument value.
+			ShortName string
+			// Price is the price argument value.
+			Price int
+		}
+	}
+	lockAddMenuAlias        sync.RWMutex
+	lockAddMenuItem         sync.RWMutex
+	lockCreateMenu          sync.RWMutex
+	lockGetAllMenus         sync.RWMutex
+	lockGetMenu             sync.RWMutex
+	lockGetMenuByName       sync.RWMutex
+	lockImportMenu          sync.RWMutex
+	lockRemoveMenuAlias     sync.RWMutex
+	lockRemoveMenuItem      sync.RWMutex
+	lockUpdateMenuItemPrice sync.RWMutex
+}
+
+// AddMenuAlias calls AddMenuAliasFunc.
+func (mock *MenuServiceMock) AddMenuAlias(ctx context.Context, currentUser *uuid.UUID, menuName string, alias string) (*entity.Menu, []entity.MenuAlias, error) {
+	if mock.AddMenuAliasFunc == nil {
+		panic("MenuServiceMock.AddMenuAliasFunc: method is nil but MenuService.AddMenuAlias was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		Alias       string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+		Alias:       alias,
+	}
+	mock.lockAddMenuAlias.Lock()
+	mock.calls.AddMenuAlias = append(mock.calls.AddMenuAlias, callInfo)
+	mock.lockAddMenuAlias.Unlock()
+	return mock.AddMenuAliasFunc(ctx, currentUser, menuName, alias)
+}
+
+// AddMenuAliasCalls gets all the calls that were made to AddMenuAlias.
+// Check the length with:
+//
+//	len(mockedMenuService.AddMenuAliasCalls())
+func (mock *MenuServiceMock) AddMenuAliasCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+	Alias       string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		Alias       string
+	}
+	mock.lockAddMenuAlias.RLock()
+	calls = mock.calls.AddMenuAlias
+	mock.lockAddMenuAlias.RUnlock()
+	return calls
+}
+
+// AddMenuItem calls AddMenuItemFunc.
+func (mock *MenuServiceMock) AddMenuItem(ctx context.Context, currentUser *uuid.UUID, menuName string, menuItem *entity.MenuItem) (*entity.MenuItem, error) {
+	if mock.AddMenuItemFunc == nil {
+		panic("MenuServiceMock.AddMenuItemFunc: method is nil but MenuService.AddMenuItem was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		MenuItem    *entity.MenuItem
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+		MenuItem:    menuItem,
+	}
+	mock.lockAddMenuItem.Lock()
+	mock.calls.AddMenuItem = append(mock.calls.AddMenuItem, callInfo)
+	mock.lockAddMenuItem.Unlock()
+	return mock.AddMenuItemFunc(ctx, currentUser, menuName, menuItem)
+}
+
+// AddMenuItemCalls gets all the calls that were made to AddMenuItem.
+// Check the length with:
+//
+//	len(mockedMenuService.AddMenuItemCalls())
+func (mock *MenuServiceMock) AddMenuItemCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+	MenuItem    *entity.MenuItem
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		MenuItem    *entity.MenuItem
+	}
+	mock.lockAddMenuItem.RLock()
+	calls = mock.calls.AddMenuItem
+	mock.lockAddMenuItem.RUnlock()
+	return calls
+}
+
+// CreateMenu calls CreateMenuFunc.
+func (mock *MenuServiceMock) CreateMenu(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+	if mock.CreateMenuFunc == nil {
+		panic("MenuServiceMock.CreateMenuFunc: method is nil but MenuService.CreateMenu was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Menu        *entity.Menu
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		Menu:        menu,
+	}
+	mock.lockCreateMenu.Lock()
+	mock.calls.CreateMenu = append(mock.calls.CreateMenu, callInfo)
+	mock.lockCreateMenu.Unlock()
+	return mock.CreateMenuFunc(ctx, currentUser, menu)
+}
+
+// CreateMenuCalls gets all the calls that were made to CreateMenu.
+// Check the length with:
+//
+//	len(mockedMenuService.CreateMenuCalls())
+func (mock *MenuServiceMock) CreateMenuCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	Menu        *entity.Menu
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Menu        *entity.Menu
+	}
+	mock.lockCreateMenu.RLock()
+	calls = mock.calls.CreateMenu
+	mock.lockCreateMenu.RUnlock()
+	return calls
+}
+
+// GetAllMenus calls GetAllMenusFunc.
+func (mock *MenuServiceMock) GetAllMenus(ctx context.Context) ([]entity.Menu, error) {
+	if mock.GetAllMenusFunc == nil {
+		panic("MenuServiceMock.GetAllMenusFunc: method is nil but MenuService.GetAllMenus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllMenus.Lock()
+	mock.calls.GetAllMenus = append(mock.calls.GetAllMenus, callInfo)
+	mock.lockGetAllMenus.Unlock()
+	return mock.GetAllMenusFunc(ctx)
+}
+
+// GetAllMenusCalls gets all the calls that were made to GetAllMenus.
+// Check the length with:
+//
+//	len(mockedMenuService.GetAllMenusCalls())
+func (mock *MenuServiceMock) GetAllMenusCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllMenus.RLock()
+	calls = mock.calls.GetAllMenus
+	mock.lockGetAllMenus.RUnlock()
+	return calls
+}
+
+// GetMenu calls GetMenuFunc.
+func (mock *MenuServiceMock) GetMenu(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Menu, error) {
+	if mock.GetMenuFunc == nil {
+		panic("MenuServiceMock.GetMenuFunc: method is nil but MenuService.GetMenu was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetMenu.Lock()
+	mock.calls.GetMenu = append(mock.calls.GetMenu, callInfo)
+	mock.lockGetMenu.Unlock()
+	return mock.GetMenuFunc(ctx, uuidMoqParam)
+}
+
+// GetMenuCalls gets all the calls that were made to GetMenu.
+// Check the length with:
+//
+//	len(mockedMenuService.GetMenuCalls())
+func (mock *MenuServiceMock) GetMenuCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetMenu.RLock()
+	calls = mock.calls.GetMenu
+	mock.lockGetMenu.RUnlock()
+	return calls
+}
+
+// GetMenuByName calls GetMenuByNameFunc.
+func (mock *MenuServiceMock) GetMenuByName(ctx context.Context, name string) (*entity.Menu, error) {
+	if mock.GetMenuByNameFunc == nil {
+		panic("MenuServiceMock.GetMenuByNameFunc: method is nil but MenuService.GetMenuByName was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetMenuByName.Lock()
+	mock.calls.GetMenuByName = append(mock.calls.GetMenuByName, callInfo)
+	mock.lockGetMenuByName.Unlock()
+	return mock.GetMenuByNameFunc(ctx, name)
+}
+
+// GetMenuByNameCalls gets all the calls that were made to GetMenuByName.
+// Check the length with:
+//
+//	len(mockedMenuService.GetMenuByNameCalls())
+func (mock *MenuServiceMock) GetMenuByNameCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetMenuByName.RLock()
+	calls = mock.calls.GetMenuByName
+	mock.lockGetMenuByName.RUnlock()
+	return calls
+}
+
+// ImportMenu calls ImportMenuFunc.
+func (mock *MenuServiceMock) ImportMenu(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu, dryRun bool) (*entity.MenuDiff, error) {
+	if mock.ImportMenuFunc == nil {
+		panic("MenuServiceMock.ImportMenuFunc: method is nil but MenuService.ImportMenu was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Menu        *entity.Menu
+		DryRun      bool
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		Menu:        menu,
+		DryRun:      dryRun,
+	}
+	mock.lockImportMenu.Lock()
+	mock.calls.ImportMenu = append(mock.calls.ImportMenu, callInfo)
+	mock.lockImportMenu.Unlock()
+	return mock.ImportMenuFunc(ctx, currentUser, menu, dryRun)
+}
+
+// ImportMenuCalls gets all the calls that were made to ImportMenu.
+// Check the length with:
+//
+//	len(mockedMenuService.ImportMenuCalls())
+func (mock *MenuServiceMock) ImportMenuCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	Menu        *entity.Menu
+	DryRun      bool
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Menu        *entity.Menu
+		DryRun      bool
+	}
+	mock.lockImportMenu.RLock()
+	calls = mock.calls.ImportMenu
+	mock.lockImportMenu.RUnlock()
+	return calls
+}
+
+// RemoveMenuAlias calls RemoveMenuAliasFunc.
+func (mock *MenuServiceMock) RemoveMenuAlias(ctx context.Context, currentUser *uuid.UUID, alias string) (*entity.Menu, []entity.MenuAlias, error) {
+	if mock.RemoveMenuAliasFunc == nil {
+		panic("MenuServiceMock.RemoveMenuAliasFunc: method is nil but MenuService.RemoveMenuAlias was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Alias       string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		Alias:       alias,
+	}
+	mock.lockRemoveMenuAlias.Lock()
+	mock.calls.RemoveMenuAlias = append(mock.calls.RemoveMenuAlias, callInfo)
+	mock.lockRemoveMenuAlias.Unlock()
+	return mock.RemoveMenuAliasFunc(ctx, currentUser, alias)
+}
+
+// RemoveMenuAliasCalls gets all the calls that were made to RemoveMenuAlias.
+// Check the length with:
+//
+//	len(mockedMenuService.RemoveMenuAliasCalls())
+func (mock *MenuServiceMock) RemoveMenuAliasCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	Alias       string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Alias       string
+	}
+	mock.lockRemoveMenuAlias.RLock()
+	calls = mock.calls.RemoveMenuAlias
+	mock.lockRemoveMenuAlias.RUnlock()
+	return calls
+}
+
+// RemoveMenuItem calls RemoveMenuItemFunc.
+func (mock *MenuServiceMock) RemoveMenuItem(ctx context.Context, currentUser *uuid.UUID, menuName string, shortName string) (*entity.MenuItem, error) {
+	if mock.RemoveMenuItemFunc == nil {
+		panic("MenuServiceMock.RemoveMenuItemFunc: method is nil but MenuService.RemoveMenuItem was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		ShortName   string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+		ShortName:   shortName,
+	}
+	mock.lockRemoveMenuItem.Lock()
+	mock.calls.RemoveMenuItem = append(mock.calls.RemoveMenuItem, callInfo)
+	mock.lockRemoveMenuItem.Unlock()
+	return mock.RemoveMenuItemFunc(ctx, currentUser, menuName, shortName)
+}
+
+// RemoveMenuItemCalls gets all the calls that were made to RemoveMenuItem.
+// Check the length with:
+//
+//	len(mockedMenuService.RemoveMenuItemCalls())
+func (mock *MenuServiceMock) RemoveMenuItemCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+	ShortName   string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		ShortName   string
+	}
+	mock.lockRemoveMenuItem.RLock()
+	calls = mock.calls.RemoveMenuItem
+	mock.lockRemoveMenuItem.RUnlock()
+	return calls
+}
+
+// UpdateMenuItemPrice calls UpdateMenuItemPriceFunc.
+func (mock *MenuServiceMock) UpdateMenuItemPrice(ctx context.Context, currentUser *uuid.UUID, menuName string, shortName string, price int) (*entity.MenuItem, error) {
+	if mock.UpdateMenuItemPriceFunc == nil {
+		panic("MenuServiceMock.UpdateMenuItemPriceFunc: method is nil but MenuService.UpdateMenuItemPrice was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		ShortName   string
+		Price       int
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+		ShortName:   shortName,
+		Price:       price,
+	}
+	mock.lockUpdateMenuItemPrice.Lock()
+	mock.calls.UpdateMenuItemPrice = append(mock.calls.UpdateMenuItemPrice, callInfo)
+	mock.lockUpdateMenuItemPrice.Unlock()
+	return mock.UpdateMenuItemPriceFunc(ctx, currentUser, menuName, shortName, price)
+}
+
+// UpdateMenuItemPriceCalls gets all the calls that were made to UpdateMenuItemPrice.
+// Check the length with:
+//
+//	len(mockedMenuService.UpdateMenuItemPriceCalls())
+func (mock *MenuServiceMock) UpdateMenuItemPriceCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+	ShortName   string
+	Price       int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		ShortName   string
+		Price       int
+	}
+	mock.lockUpdateMenuItemPrice.RLock()
+	calls = mock.calls.UpdateMenuItemPrice
+	mock.lockUpdateMenuItemPrice.RUnlock()
+	return calls
+}
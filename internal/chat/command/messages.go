@@ -0,0 +1,284 @@
+package command
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+// messages is the catalog of replies. Replies are looked up by their English
+// message, which only needs an entry here if it depends on a number.
+var messages = newCatalog()
+
+// pluralMessages are the replies that depend on a number, with the index of
+// that argument and the English and German variants.
+var pluralMessages = []struct {
+	key string
+	arg int
+	en  []any
+	de  []any
+}{
+	{
+		key: "added %d items of favourite %s to active order %s",
+		arg: 1,
+		en: []any{
+			"one", "added %d item of favourite %s to active order %s",
+			"other", "added %d items of favourite %s to active order %s",
+		},
+		de: []any{
+			"one", "%d Gericht aus Favorit %s zur aktiven Bestellung %s hinzugefügt",
+			"other", "%d Gerichte aus Favorit %s zur aktiven Bestellung %s hinzugefügt",
+		},
+	},
+	{
+		key: "added %d items from your order of %s to active order %s, total %s",
+		arg: 1,
+		en: []any{
+			"one", "added %d item from your order of %s to active order %s, total %s",
+			"other", "added %d items from your order of %s to active order %s, total %s",
+		},
+		de: []any{
+			"one", "%d Gericht aus deiner Bestellung vom %s zur aktiven Bestellung %s hinzugefügt, insgesamt %s",
+			"other", "%d Gerichte aus deiner Bestellung vom %s zur aktiven Bestellung %s hinzugefügt, insgesamt %s",
+		},
+	},
+	{
+		key: ", %d items are not on the menu anymore",
+		arg: 1,
+		en: []any{
+			"one", ", %d item is not on the menu anymore",
+			"other", ", %d items are not on the menu anymore",
+		},
+		de: []any{
+			"one", ", %d Gericht steht nicht mehr auf der Karte",
+			"other", ", %d Gerichte stehen nicht mehr auf der Karte",
+		},
+	},
+	{
+		key: linkCodeMessage,
+		arg: 2,
+		en: []any{
+			"one", "Your link code is %s, it is valid for %d minute. Send '%s link %s' from your other chat account " +
+				"or enter it when logging in with your password or ssh key to add that login to this account.",
+			"other", linkCodeMessage,
+		},
+		de: []any{
+			"one", "Dein Verknüpfungscode ist %s, er ist %d Minute gültig. Sende '%s link %s' von deinem anderen Chat-Konto " +
+				"oder gib ihn bei der Anmeldung mit Passwort oder SSH-Schlüssel ein, um diese Anmeldung zu diesem Konto hinzuzufügen.",
+			"other", "Dein Verknüpfungscode ist %s, er ist %d Minuten gültig. Sende '%s link %s' von deinem anderen Chat-Konto " +
+				"oder gib ihn bei der Anmeldung mit Passwort oder SSH-Schlüssel ein, um diese Anmeldung zu diesem Konto hinzuzufügen.",
+		},
+	},
+	{
+		key: passwordPromptMessage,
+		arg: 1,
+		en: []any{
+			"one", "Send me the new password for the web and api login in this chat, it needs at least %d character. " +
+				"I remove your messages once I read them. Send 'cancel' to stop.",
+			"other", passwordPromptMessage,
+		},
+		de: []any{
+			"one", "Schick mir in diesem Chat das neue Passwort für die Web- und API-Anmeldung, es braucht mindestens %d Zeichen. " +
+				"Ich entferne deine Nachrichten, sobald ich sie gelesen habe. Sende 'cancel' zum Abbrechen.",
+			"other", "Schick mir in diesem Chat das neue Passwort für die Web- und API-Anmeldung, es braucht mindestens %d Zeichen. " +
+				"Ich entferne deine Nachrichten, sobald ich sie gelesen habe. Sende 'cancel' zum Abbrechen.",
+		},
+	},
+}
+
+// germanMessages translates the replies that do not depend on a number.
+var germanMessages = map[string]string{
+	// order states
+	entity.Open:      "offen",
+	entity.Finalized: "abgeschlossen",
+	entity.Ordered:   "bestellt",
+	entity.Delivered: "geliefert",
+
+	// add
+	"could not add to order: %s":           "konnte nicht zur Bestellung hinzufügen: %s",
+	"could not add order: %s":              "konnte nicht zur Bestellung hinzufügen: %s",
+	"a favourite must be added on its own": "ein Favorit muss allein hinzugefügt werden",
+	"added %s to active order %s":          "%s zur aktiven Bestellung %s hinzugefügt",
+	"warning: %s (%s) is not on the menu anymore and was skipped": "Warnung: %s (%s) steht nicht mehr auf der Karte " +
+		"und wurde übersprungen",
+
+	// admin menu and items
+	"could not manage menu item: %s":                     "konnte Gericht nicht verwalten: %s",
+	"could not remove menu item: %s":                     "konnte Gericht nicht entfernen: %s",
+	"removed %s (%s) from menu %s":                       "%s (%s) von Karte %s entfernt",
+	"could not add menu item: %s":                        "konnte Gericht nicht hinzufügen: %s",
+	"added %s (%s, %s) to menu %s":                       "%s (%s, %s) zu Karte %s hinzugefügt",
+	"could not update menu item: %s":                     "konnte Gericht nicht ändern: %s",
+	"set price of %s (%s) on menu %s to %s":              "Preis von %s (%s) auf Karte %s auf %s gesetzt",
+	"could not manage menu: %s":                          "konnte Karte nicht verwalten: %s",
+	"could not add menu alias: %s":                       "konnte Alias nicht hinzufügen: %s",
+	"menu %s can now also be called %s":                  "Karte %s heißt jetzt auch %s",
+	"could not remove menu alias: %s":                    "konnte Alias nicht entfernen: %s",
+	"removed alias %s, menu %s has no aliases left":      "Alias %s entfernt, Karte %s hat keine Aliase mehr",
+	"removed alias %s, menu %s can still be called %s":   "Alias %s entfernt, Karte %s heißt weiterhin auch %s",
+	"could not create menu: %s":                          "konnte Karte nicht anlegen: %s",
+	"created menu %s":                                    "Karte %s angelegt",
+	"could not import menu: %s":                          "konnte Karte nicht importieren: %s",
+	"could not import menu: reading %s: %s":              "konnte Karte nicht importieren: %s lesen: %s",
+	"imported menu %s: %d added, %d changed, %d removed": "Karte %s importiert: %d neu, %d geändert, %d entfernt",
+
+	// api tokens
+	"could not manage api tokens: %s": "konnte API-Tokens nicht verwalten: %s",
+	"scope must be one of %s":         "der Bereich muss einer von %s sein",
+	"expiry must be a number of days greater than 0 like 30d or 'never'": "die Gültigkeit muss eine Anzahl Tage größer 0 " +
+		"wie 30d oder 'never' sein",
+	"could not create api token: %s": "konnte API-Token nicht erstellen: %s",
+	"Your api token %s is %s (scope %s, %s). It is only shown once, send it as 'Authorization: Bearer <token>'.": "Dein API-Token " +
+		"%s ist %s (Bereich %s, %s). Es wird nur einmal angezeigt, sende es als 'Authorization: Bearer <token>'.",
+	"could not send api token: %s":                 "konnte API-Token nicht senden: %s",
+	"sent you the api token %s via direct message": "dir das API-Token %s per Direktnachricht geschickt",
+	"could not revoke api token: %s":               "konnte API-Token nicht widerrufen: %s",
+	"revoked api token %s":                         "API-Token %s widerrufen",
+	"could not list api tokens: %s":                "konnte API-Tokens nicht auflisten: %s",
+	"you have no api tokens":                       "du hast keine API-Tokens",
+	"your api tokens:":                             "deine API-Tokens:",
+	"never used":                                   "nie benutzt",
+	"last used %s":                                 "zuletzt benutzt %s",
+	"never expires":                                "läuft nie ab",
+	"expired %s":                                   "abgelaufen %s",
+	"expires %s":                                   "läuft ab %s",
+
+	// favourites
+	"could not manage favourites: %s":                        "konnte Favoriten nicht verwalten: %s",
+	"could not save favourite: %s":                           "konnte Favorit nicht speichern: %s",
+	"saved favourite %s for %s, add it with '%s add %s @%s'": "Favorit %s für %s gespeichert, füge ihn mit '%s add %s @%s' hinzu",
+	"could not delete favourite: %s":                         "konnte Favorit nicht löschen: %s",
+	"deleted favourite %s for %s":                            "Favorit %s für %s gelöscht",
+	"could not list favourites: %s":                          "konnte Favoriten nicht auflisten: %s",
+	"you have no favourites for %s":                          "du hast keine Favoriten für %s",
+	"your favourites for %s:":                                "deine Favoriten für %s:",
+	" (removed from menu)":                                   " (nicht mehr auf der Karte)",
+
+	// general
+	"usage: %s":                  "Verwendung: %s",
+	"usage:":                     "Verwendung:",
+	"%s, usage: %s":              "%s, Verwendung: %s",
+	"could not read command: %s": "konnte Befehl nicht lesen: %s",
+	"command not recognized: %s": "Befehl nicht erkannt: %s",
+	"Hello world":                "Hallo Welt",
+
+	// history
+	"count must be a number greater than 0": "die Anzahl muss eine Zahl größer 0 sein",
+	"could not get order history: %s":       "konnte Bestellverlauf nicht laden: %s",
+	"there are no delivered orders yet":     "es gibt noch keine gelieferten Bestellungen",
+	"delivered orders:":                     "gelieferte Bestellungen:",
+	"%s %s by %s, total %s":                 "%s %s von %s, insgesamt %s",
+	"could not order again: %s":             "konnte nicht erneut bestellen: %s",
+	"unknown date":                          "unbekanntes Datum",
+	"a deleted user":                        "einem gelöschten Nutzer",
+
+	// language
+	"could not change language: %s":                   "konnte Sprache nicht ändern: %s",
+	"could not get language: %s":                      "konnte Sprache nicht laden: %s",
+	"your language is %s":                             "deine Sprache ist %s",
+	"your language is %s, like in this room":          "deine Sprache ist %s, wie in diesem Raum",
+	"your language is now %s":                         "deine Sprache ist jetzt %s",
+	"you now get replies in the language of the room": "du bekommst jetzt Antworten in der Sprache des Raums",
+
+	// link
+	"could not create link code: %s":          "konnte Verknüpfungscode nicht erstellen: %s",
+	"could not send link code: %s":            "konnte Verknüpfungscode nicht senden: %s",
+	"sent you a link code via direct message": "dir einen Verknüpfungscode per Direktnachricht geschickt",
+	"could not link account: %s":              "konnte Konto nicht verknüpfen: %s",
+	"linked %s to account %s":                 "%s mit Konto %s verknüpft",
+
+	// password
+	"never send your password to a room, I removed your message. Send '%s password' and answer in our direct chat": "schick " +
+		"dein Passwort nie in einen Raum, ich habe deine Nachricht entfernt. Sende '%s password' und antworte in unserem Direktchat",
+	"your password setup expired, send '%s password' to start again": "die Passworteinrichtung ist abgelaufen, " +
+		"sende '%s password', um neu zu beginnen",
+	"could not set password: %s":                              "konnte Passwort nicht setzen: %s",
+	"could not open direct chat: %s":                          "konnte Direktchat nicht öffnen: %s",
+	"could not send direct message: %s":                       "konnte Direktnachricht nicht senden: %s",
+	"sent you a direct message to set your password":          "dir eine Direktnachricht zum Setzen deines Passworts geschickt",
+	"password setup cancelled":                                "Passworteinrichtung abgebrochen",
+	"%s, send another one":                                    "%s, sende ein anderes",
+	"send the password again to confirm it":                   "sende das Passwort zur Bestätigung noch einmal",
+	"the passwords do not match, send the new password again": "die Passwörter stimmen nicht überein, sende das neue Passwort noch einmal",
+	"your password is set, log in with the username %s":       "dein Passwort ist gesetzt, melde dich mit dem Benutzernamen %s an",
+
+	// privacy
+	"send '%s' to delete the account":            "sende '%s', um das Konto zu löschen",
+	"could not handle privacy request: %s":       "konnte Datenschutzanfrage nicht bearbeiten: %s",
+	"could not delete user data: %s":             "konnte Nutzerdaten nicht löschen: %s",
+	"deleted the account of %s":                  "Konto von %s gelöscht",
+	"could not export user data: %s":             "konnte Nutzerdaten nicht exportieren: %s",
+	"could not send user data: %s":               "konnte Nutzerdaten nicht senden: %s",
+	"sent you the data of %s via direct message": "dir die Daten von %s per Direktnachricht geschickt",
+	"this deletes the account of %s and removes it from all past orders, send '%s' to continue": "das löscht das Konto von %s " +
+		"und entfernt es aus allen vergangenen Bestellungen, sende '%s', um fortzufahren",
+
+	// register and roles
+	"could not register user: %s":      "konnte Nutzer nicht registrieren: %s",
+	"successfully registered user: %s": "Nutzer erfolgreich registriert: %s",
+	"you are registered as %s":         "du bist registriert als %s",
+	"could not grant role: %s":         "konnte Rolle nicht vergeben: %s",
+	"could not revoke role: %s":        "konnte Rolle nicht entziehen: %s",
+	"granted role %s to %s":            "Rolle %s an %s vergeben",
+	"revoked role %s from %s":          "Rolle %s von %s entzogen",
+	"could not get roles: %s":          "konnte Rollen nicht laden: %s",
+	"%s has no roles":                  "%s hat keine Rollen",
+	"roles of %s: %s":                  "Rollen von %s: %s",
+
+	// room
+	"could not update room settings: %s":                    "konnte Raumeinstellungen nicht ändern: %s",
+	"only room admins can change the settings of this room": "nur Raumadmins können die Einstellungen dieses Raums ändern",
+	"set %s of this room to %s":                             "%s dieses Raums auf %s gesetzt",
+	"reset %s of this room to the default":                  "%s dieses Raums auf den Standard zurückgesetzt",
+	"could not get room settings: %s":                       "konnte Raumeinstellungen nicht laden: %s",
+	"%s (default)":                                          "%s (Standard)",
+	"none":                                                  "keine",
+	"settings of this room:":                                "Einstellungen dieses Raums:",
+
+	// same
+	"could not copy order: %s":                                   "konnte Bestellung nicht kopieren: %s",
+	"copied %s from %s to your order at %s, your subtotal is %s": "%s von %s in deine Bestellung bei %s kopiert, deine Zwischensumme ist %s",
+
+	// share
+	"could not share order: %s":                 "konnte Bestellung nicht teilen: %s",
+	"order %s is now shared with all rooms":     "Bestellung %s ist jetzt mit allen Räumen geteilt",
+	"order %s is now only visible in this room": "Bestellung %s ist jetzt nur in diesem Raum sichtbar",
+
+	// ssh keys
+	"could not manage ssh keys: %s": "konnte SSH-Schlüssel nicht verwalten: %s",
+	"could not add ssh key: %s":     "konnte SSH-Schlüssel nicht hinzufügen: %s",
+	"added ssh key %s":              "SSH-Schlüssel %s hinzugefügt",
+	"could not remove ssh key: %s":  "konnte SSH-Schlüssel nicht entfernen: %s",
+	"removed ssh key %s":            "SSH-Schlüssel %s entfernt",
+	"could not list ssh keys: %s":   "konnte SSH-Schlüssel nicht auflisten: %s",
+	"you have no ssh keys":          "du hast keine SSH-Schlüssel",
+	"your ssh keys:":                "deine SSH-Schlüssel:",
+
+	// start, status and state transitions
+	"could not start order: %s":                     "konnte Bestellung nicht starten: %s",
+	"started new order for %s (id: %s)":             "neue Bestellung für %s gestartet (ID: %s)",
+	"could not get status of order: %s":             "konnte Status der Bestellung nicht laden: %s",
+	"%s, shared with all rooms":                     "%s, mit allen Räumen geteilt",
+	"could not update order: %s":                    "konnte Bestellung nicht ändern: %s",
+	"could not update order: no menu name provided": "konnte Bestellung nicht ändern: keine Karte angegeben",
+	"successfully set state of order %s to %s":      "Status der Bestellung %s erfolgreich auf %s gesetzt",
+}
+
+func newCatalog() catalog.Catalog {
+	builder := catalog.NewBuilder(catalog.Fallback(language.English))
+
+	// the messages are fixed, so they can only fail to compile while they are
+	// written, which the tests catch
+	for key, msg := range germanMessages {
+		_ = builder.SetString(language.German, key, msg)
+	}
+
+	for _, m := range pluralMessages {
+		_ = builder.Set(language.English, m.key, plural.Selectf(m.arg, "%d", m.en...))
+		_ = builder.Set(language.German, m.key, plural.Selectf(m.arg, "%d", m.de...))
+	}
+
+	return builder
+}
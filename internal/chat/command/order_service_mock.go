@@ -0,0 +1,1058 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that OrderServiceMock does implement OrderService.
+// If this is not the case, regenerate this file with moq.
+var _ OrderService = &OrderServiceMock{}
+
+// OrderServiceMock is a mock implementation of OrderService.
+//
+//	func TestSomethingThatUsesOrderService(t *testing.T) {
+//
+//		// make and configure a mocked OrderService
+//		mockedOrderService := &OrderServiceMock{
+//			AddFavouriteToOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, name string) (*service.FavouriteOrder, error) {
+//				panic("mock out the AddFavouriteToOrder method")
+//			},
+//			AddOrderItemsToOrderByNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, items []string) ([]entity.OrderItem, error) {
+//				panic("mock out the AddOrderItemsToOrderByName method")
+//			},
+//			CopyOrderItemsFunc: func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, roomID string, menuName string) (*service.CopiedOrderItems, error) {
+//				panic("mock out the CopyOrderItems method")
+//			},
+//			CreateOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+//				panic("mock out the CreateOrder method")
+//			},
+//			CreateOrderForMenuNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error) {
+//				panic("mock out the CreateOrderForMenuName method")
+//			},
+//			DeleteFavouriteFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string, name string) error {
+//				panic("mock out the DeleteFavourite method")
+//			},
+//			GetActiveOrderByMenuFunc: func(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+//				panic("mock out the GetActiveOrderByMenu method")
+//			},
+//			GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID string, name string) (*entity.Order, error) {
+//				panic("mock out the GetActiveOrderByMenuName method")
+//			},
+//			GetAllOrdersFunc: func(ctx context.Context) ([]entity.Order, error) {
+//				panic("mock out the GetAllOrders method")
+//			},
+//			GetFavouritesFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error) {
+//				panic("mock out the GetFavourites method")
+//			},
+//			GetOrderFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Order, error) {
+//				panic("mock out the GetOrder method")
+//			},
+//			GetOrderHistoryFunc: func(ctx context.Context, roomID string, menuName string, limit int) ([]entity.OrderSummary, error) {
+//				panic("mock out the GetOrderHistory method")
+//			},
+//			GetOrderOverviewFunc: func(ctx context.Context, roomID string, menuName string) (*service.OrderOverview, error) {
+//				panic("mock out the GetOrderOverview method")
+//			},
+//			ReorderLastFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*service.Reorder, error) {
+//				panic("mock out the ReorderLast method")
+//			},
+//			SaveFavouriteFunc: func(ctx context.Context, currentUser *uuid.UUID, menuName string, name string, items []string) (*entity.Favourite, error) {
+//				panic("mock out the SaveFavourite method")
+//			},
+//			ShareOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, shared bool) (*entity.Order, error) {
+//				panic("mock out the ShareOrder method")
+//			},
+//			UpdateOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+//				panic("mock out the UpdateOrder method")
+//			},
+//		}
+//
+//		// use mockedOrderService in code that requires OrderService
+//		// and then make assertions.
+//
+//	}
+type OrderServiceMock struct {
+	// AddFavouriteToOrderFunc mocks the AddFavouriteToOrder method.
+	AddFavouriteToOrderFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, name string) (*service.FavouriteOrder, error)
+
+	// AddOrderItemsToOrderByNameFunc mocks the AddOrderItemsToOrderByName method.
+	AddOrderItemsToOrderByNameFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, items []string) ([]entity.OrderItem, error)
+
+	// CopyOrderItemsFunc mocks the CopyOrderItems method.
+	CopyOrderItemsFunc func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, roomID string, menuName string) (*service.CopiedOrderItems, error)
+
+	// CreateOrderFunc mocks the CreateOrder method.
+	CreateOrderFunc func(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error)
+
+	// CreateOrderForMenuNameFunc mocks the CreateOrderForMenuName method.
+	CreateOrderForMenuNameFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error)
+
+	// DeleteFavouriteFunc mocks the DeleteFavourite method.
+	DeleteFavouriteFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string, name string) error
+
+	// GetActiveOrderByMenuFunc mocks the GetActiveOrderByMenu method.
+	GetActiveOrderByMenuFunc func(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error)
+
+	// GetActiveOrderByMenuNameFunc mocks the GetActiveOrderByMenuName method.
+	GetActiveOrderByMenuNameFunc func(ctx context.Context, roomID string, name string) (*entity.Order, error)
+
+	// GetAllOrdersFunc mocks the GetAllOrders method.
+	GetAllOrdersFunc func(ctx context.Context) ([]entity.Order, error)
+
+	// GetFavouritesFunc mocks the GetFavourites method.
+	GetFavouritesFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error)
+
+	// GetOrderFunc mocks the GetOrder method.
+	GetOrderFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Order, error)
+
+	// GetOrderHistoryFunc mocks the GetOrderHistory method.
+	GetOrderHistoryFunc func(ctx context.Context, roomID string, menuName string, limit int) ([]entity.OrderSummary, error)
+
+	// GetOrderOverviewFunc mocks the GetOrderOverview method.
+	GetOrderOverviewFunc func(ctx context.Context, roomID string, menuName string) (*service.OrderOverview, error)
+
+	// ReorderLastFunc mocks the ReorderLast method.
+	ReorderLastFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*service.Reorder, error)
+
+	// SaveFavouriteFunc mocks the SaveFavourite method.
+	SaveFavouriteFunc func(ctx context.Context, currentUser *uuid.UUID, menuName string, name string, items []string) (*entity.Favourite, error)
+
+	// ShareOrderFunc mocks the ShareOrder method.
+	ShareOrderFunc func(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, shared bool) (*entity.Order, error)
+
+	// UpdateOrderFunc mocks the UpdateOrder method.
+	UpdateOrderFunc func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddFavouriteToOrder holds details about calls to the AddFavouriteToOrder method.
+		AddFavouriteToOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Name is the name argument value.
+			Name string
+		}
+		// AddOrderItemsToOrderByName holds details about calls to the AddOrderItemsToOrderByName method.
+		AddOrderItemsToOrderByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Items is the items argument value.
+			Items []string
+		}
+		// CopyOrderItems holds details about calls to the CopyOrderItems method.
+		CopyOrderItems []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// CreateOrder holds details about calls to the CreateOrder method.
+		CreateOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// Order is the order argument value.
+			Order *entity.Order
+		}
+		// CreateOrderForMenuName holds details about calls to the CreateOrderForMenuName method.
+		CreateOrderForMenuName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// DeleteFavourite holds details about calls to the DeleteFavourite method.
+		DeleteFavourite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Name is the name argument value.
+			Name string
+		}
+		// GetActiveOrderByMenu holds details about calls to the GetActiveOrderByMenu method.
+		GetActiveOrderByMenu []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuUUID is the menuUUID argument value.
+			MenuUUID *uuid.UUID
+		}
+		// GetActiveOrderByMenuName holds details about calls to the GetActiveOrderByMenuName method.
+		GetActiveOrderByMenuName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// Name is the name argument value.
+			Name string
+		}
+		// GetAllOrders holds details about calls to the GetAllOrders method.
+		GetAllOrders []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetFavourites holds details about calls to the GetFavourites method.
+		GetFavourites []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// GetOrder holds details about calls to the GetOrder method.
+		GetOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GetOrderHistory holds details about calls to the GetOrderHistory method.
+		GetOrderHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// GetOrderOverview holds details about calls to the GetOrderOverview method.
+		GetOrderOverview []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// ReorderLast holds details about calls to the ReorderLast method.
+		ReorderLast []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+		}
+		// SaveFavourite holds details about calls to the SaveFavourite method.
+		SaveFavourite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Name is the name argument value.
+			Name string
+			// Items is the items argument value.
+			Items []string
+		}
+		// ShareOrder holds details about calls to the ShareOrder method.
+		ShareOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// RoomID is the roomID argument value.
+			RoomID string
+			// MenuName is the menuName argument value.
+			MenuName string
+			// Shared is the shared argument value.
+			Shared bool
+		}
+		// UpdateOrder holds details about calls to the UpdateOrder method.
+		UpdateOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// Order is the order argument value.
+			Order *entity.Order
+		}
+	}
+	lockAddFavouriteToOrder        sync.RWMutex
+	lockAddOrderItemsToOrderByName sync.RWMutex
+	lockCopyOrderItems             sync.RWMutex
+	lockCreateOrder                sync.RWMutex
+	lockCreateOrderForMenuName     sync.RWMutex
+	lockDeleteFavourite            sync.RWMutex
+	lockGetActiveOrderByMenu       sync.RWMutex
+	lockGetActiveOrderByMenuName   sync.RWMutex
+	lockGetAllOrders               sync.RWMutex
+	lockGetFavourites              sync.RWMutex
+	lockGetOrder                   sync.RWMutex
+	lockGetOrderHistory            sync.RWMutex
+	lockGetOrderOverview           sync.RWMutex
+	lockReorderLast                sync.RWMutex
+	lockSaveFavourite              sync.RWMutex
+	lockShareOrder                 sync.RWMutex
+	lockUpdateOrder                sync.RWMutex
+}
+
+// AddFavouriteToOrder calls AddFavouriteToOrderFunc.
+func (mock *OrderServiceMock) AddFavouriteToOrder(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, name string) (*service.FavouriteOrder, error) {
+	if mock.AddFavouriteToOrderFunc == nil {
+		panic("OrderServiceMock.AddFavouriteToOrderFunc: method is nil but OrderService.AddFavouriteToOrder was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+		Name        string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		MenuName:    menuName,
+		Name:        name,
+	}
+	mock.lockAddFavouriteToOrder.Lock()
+	mock.calls.AddFavouriteToOrder = append(mock.calls.AddFavouriteToOrder, callInfo)
+	mock.lockAddFavouriteToOrder.Unlock()
+	return mock.AddFavouriteToOrderFunc(ctx, currentUser, roomID, menuName, name)
+}
+
+// AddFavouriteToOrderCalls gets all the calls that were made to AddFavouriteToOrder.
+// Check the length with:
+//
+//	len(mockedOrderService.AddFavouriteToOrderCalls())
+func (mock *OrderServiceMock) AddFavouriteToOrderCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	MenuName    string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+		Name        string
+	}
+	mock.lockAddFavouriteToOrder.RLock()
+	calls = mock.calls.AddFavouriteToOrder
+	mock.lockAddFavouriteToOrder.RUnlock()
+	return calls
+}
+
+// AddOrderItemsToOrderByName calls AddOrderItemsToOrderByNameFunc.
+func (mock *OrderServiceMock) AddOrderItemsToOrderByName(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, items []string) ([]entity.OrderItem, error) {
+	if mock.AddOrderItemsToOrderByNameFunc == nil {
+		panic("OrderServiceMock.AddOrderItemsToOrderByNameFunc: method is nil but OrderService.AddOrderItemsToOrderByName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+		Items       []string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		MenuName:    menuName,
+		Items:       items,
+	}
+	mock.lockAddOrderItemsToOrderByName.Lock()
+	mock.calls.AddOrderItemsToOrderByName = append(mock.calls.AddOrderItemsToOrderByName, callInfo)
+	mock.lockAddOrderItemsToOrderByName.Unlock()
+	return mock.AddOrderItemsToOrderByNameFunc(ctx, currentUser, roomID, menuName, items)
+}
+
+// AddOrderItemsToOrderByNameCalls gets all the calls that were made to AddOrderItemsToOrderByName.
+// Check the length with:
+//
+//	len(mockedOrderService.AddOrderItemsToOrderByNameCalls())
+func (mock *OrderServiceMock) AddOrderItemsToOrderByNameCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	MenuName    string
+	Items       []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+		Items       []string
+	}
+	mock.lockAddOrderItemsToOrderByName.RLock()
+	calls = mock.calls.AddOrderItemsToOrderByName
+	mock.lockAddOrderItemsToOrderByName.RUnlock()
+	return calls
+}
+
+// CopyOrderItems calls CopyOrderItemsFunc.
+func (mock *OrderServiceMock) CopyOrderItems(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, roomID string, menuName string) (*service.CopiedOrderItems, error) {
+	if mock.CopyOrderItemsFunc == nil {
+		panic("OrderServiceMock.CopyOrderItemsFunc: method is nil but OrderService.CopyOrderItems was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		UserUUID:    userUUID,
+		RoomID:      roomID,
+		MenuName:    menuName,
+	}
+	mock.lockCopyOrderItems.Lock()
+	mock.calls.CopyOrderItems = append(mock.calls.CopyOrderItems, callInfo)
+	mock.lockCopyOrderItems.Unlock()
+	return mock.CopyOrderItemsFunc(ctx, currentUser, userUUID, roomID, menuName)
+}
+
+// CopyOrderItemsCalls gets all the calls that were made to CopyOrderItems.
+// Check the length with:
+//
+//	len(mockedOrderService.CopyOrderItemsCalls())
+func (mock *OrderServiceMock) CopyOrderItemsCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	UserUUID    *uuid.UUID
+	RoomID      string
+	MenuName    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}
+	mock.lockCopyOrderItems.RLock()
+	calls = mock.calls.CopyOrderItems
+	mock.lockCopyOrderItems.RUnlock()
+	return calls
+}
+
+// CreateOrder calls CreateOrderFunc.
+func (mock *OrderServiceMock) CreateOrder(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+	if mock.CreateOrderFunc == nil {
+		panic("OrderServiceMock.CreateOrderFunc: method is nil but OrderService.CreateOrder was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Order       *entity.Order
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		Order:       order,
+	}
+	mock.lockCreateOrder.Lock()
+	mock.calls.CreateOrder = append(mock.calls.CreateOrder, callInfo)
+	mock.lockCreateOrder.Unlock()
+	return mock.CreateOrderFunc(ctx, currentUser, order)
+}
+
+// CreateOrderCalls gets all the calls that were made to CreateOrder.
+// Check the length with:
+//
+//	len(mockedOrderService.CreateOrderCalls())
+func (mock *OrderServiceMock) CreateOrderCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	Order       *entity.Order
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Order       *entity.Order
+	}
+	mock.lockCreateOrder.RLock()
+	calls = mock.calls.CreateOrder
+	mock.lockCreateOrder.RUnlock()
+	return calls
+}
+
+// CreateOrderForMenuName calls CreateOrderForMenuNameFunc.
+func (mock *OrderServiceMock) CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*entity.Order, error) {
+	if mock.CreateOrderForMenuNameFunc == nil {
+		panic("OrderServiceMock.CreateOrderForMenuNameFunc: method is nil but OrderService.CreateOrderForMenuName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		MenuName:    menuName,
+	}
+	mock.lockCreateOrderForMenuName.Lock()
+	mock.calls.CreateOrderForMenuName = append(mock.calls.CreateOrderForMenuName, callInfo)
+	mock.lockCreateOrderForMenuName.Unlock()
+	return mock.CreateOrderForMenuNameFunc(ctx, currentUser, roomID, menuName)
+}
+
+// CreateOrderForMenuNameCalls gets all the calls that were made to CreateOrderForMenuName.
+// Check the length with:
+//
+//	len(mockedOrderService.CreateOrderForMenuNameCalls())
+func (mock *OrderServiceMock) CreateOrderForMenuNameCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	MenuName    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}
+	mock.lockCreateOrderForMenuName.RLock()
+	calls = mock.calls.CreateOrderForMenuName
+	mock.lockCreateOrderForMenuName.RUnlock()
+	return calls
+}
+
+// DeleteFavourite calls DeleteFavouriteFunc.
+func (mock *OrderServiceMock) DeleteFavourite(ctx context.Context, currentUser *uuid.UUID, menuName string, name string) error {
+	if mock.DeleteFavouriteFunc == nil {
+		panic("OrderServiceMock.DeleteFavouriteFunc: method is nil but OrderService.DeleteFavourite was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		Name        string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+		Name:        name,
+	}
+	mock.lockDeleteFavourite.Lock()
+	mock.calls.DeleteFavourite = append(mock.calls.DeleteFavourite, callInfo)
+	mock.lockDeleteFavourite.Unlock()
+	return mock.DeleteFavouriteFunc(ctx, currentUser, menuName, name)
+}
+
+// DeleteFavouriteCalls gets all the calls that were made to DeleteFavourite.
+// Check the length with:
+//
+//	len(mockedOrderService.DeleteFavouriteCalls())
+func (mock *OrderServiceMock) DeleteFavouriteCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		Name        string
+	}
+	mock.lockDeleteFavourite.RLock()
+	calls = mock.calls.DeleteFavourite
+	mock.lockDeleteFavourite.RUnlock()
+	return calls
+}
+
+// GetActiveOrderByMenu calls GetActiveOrderByMenuFunc.
+func (mock *OrderServiceMock) GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+	if mock.GetActiveOrderByMenuFunc == nil {
+		panic("OrderServiceMock.GetActiveOrderByMenuFunc: method is nil but OrderService.GetActiveOrderByMenu was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		RoomID:   roomID,
+		MenuUUID: menuUUID,
+	}
+	mock.lockGetActiveOrderByMenu.Lock()
+	mock.calls.GetActiveOrderByMenu = append(mock.calls.GetActiveOrderByMenu, callInfo)
+	mock.lockGetActiveOrderByMenu.Unlock()
+	return mock.GetActiveOrderByMenuFunc(ctx, roomID, menuUUID)
+}
+
+// GetActiveOrderByMenuCalls gets all the calls that were made to GetActiveOrderByMenu.
+// Check the length with:
+//
+//	len(mockedOrderService.GetActiveOrderByMenuCalls())
+func (mock *OrderServiceMock) GetActiveOrderByMenuCalls() []struct {
+	Ctx      context.Context
+	RoomID   string
+	MenuUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuUUID *uuid.UUID
+	}
+	mock.lockGetActiveOrderByMenu.RLock()
+	calls = mock.calls.GetActiveOrderByMenu
+	mock.lockGetActiveOrderByMenu.RUnlock()
+	return calls
+}
+
+// GetActiveOrderByMenuName calls GetActiveOrderByMenuNameFunc.
+func (mock *OrderServiceMock) GetActiveOrderByMenuName(ctx context.Context, roomID string, name string) (*entity.Order, error) {
+	if mock.GetActiveOrderByMenuNameFunc == nil {
+		panic("OrderServiceMock.GetActiveOrderByMenuNameFunc: method is nil but OrderService.GetActiveOrderByMenuName was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RoomID string
+		Name   string
+	}{
+		Ctx:    ctx,
+		RoomID: roomID,
+		Name:   name,
+	}
+	mock.lockGetActiveOrderByMenuName.Lock()
+	mock.calls.GetActiveOrderByMenuName = append(mock.calls.GetActiveOrderByMenuName, callInfo)
+	mock.lockGetActiveOrderByMenuName.Unlock()
+	return mock.GetActiveOrderByMenuNameFunc(ctx, roomID, name)
+}
+
+// GetActiveOrderByMenuNameCalls gets all the calls that were made to GetActiveOrderByMenuName.
+// Check the length with:
+//
+//	len(mockedOrderService.GetActiveOrderByMenuNameCalls())
+func (mock *OrderServiceMock) GetActiveOrderByMenuNameCalls() []struct {
+	Ctx    context.Context
+	RoomID string
+	Name   string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RoomID string
+		Name   string
+	}
+	mock.lockGetActiveOrderByMenuName.RLock()
+	calls = mock.calls.GetActiveOrderByMenuName
+	mock.lockGetActiveOrderByMenuName.RUnlock()
+	return calls
+}
+
+// GetAllOrders calls GetAllOrdersFunc.
+func (mock *OrderServiceMock) GetAllOrders(ctx context.Context) ([]entity.Order, error) {
+	if mock.GetAllOrdersFunc == nil {
+		panic("OrderServiceMock.GetAllOrdersFunc: method is nil but OrderService.GetAllOrders was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllOrders.Lock()
+	mock.calls.GetAllOrders = append(mock.calls.GetAllOrders, callInfo)
+	mock.lockGetAllOrders.Unlock()
+	return mock.GetAllOrdersFunc(ctx)
+}
+
+// GetAllOrdersCalls gets all the calls that were made to GetAllOrders.
+// Check the length with:
+//
+//	len(mockedOrderService.GetAllOrdersCalls())
+func (mock *OrderServiceMock) GetAllOrdersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllOrders.RLock()
+	calls = mock.calls.GetAllOrders
+	mock.lockGetAllOrders.RUnlock()
+	return calls
+}
+
+// GetFavourites calls GetFavouritesFunc.
+func (mock *OrderServiceMock) GetFavourites(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error) {
+	if mock.GetFavouritesFunc == nil {
+		panic("OrderServiceMock.GetFavouritesFunc: method is nil but OrderService.GetFavourites was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+	}
+	mock.lockGetFavourites.Lock()
+	mock.calls.GetFavourites = append(mock.calls.GetFavourites, callInfo)
+	mock.lockGetFavourites.Unlock()
+	return mock.GetFavouritesFunc(ctx, currentUser, menuName)
+}
+
+// GetFavouritesCalls gets all the calls that were made to GetFavourites.
+// Check the length with:
+//
+//	len(mockedOrderService.GetFavouritesCalls())
+func (mock *OrderServiceMock) GetFavouritesCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+	}
+	mock.lockGetFavourites.RLock()
+	calls = mock.calls.GetFavourites
+	mock.lockGetFavourites.RUnlock()
+	return calls
+}
+
+// GetOrder calls GetOrderFunc.
+func (mock *OrderServiceMock) GetOrder(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.Order, error) {
+	if mock.GetOrderFunc == nil {
+		panic("OrderServiceMock.GetOrderFunc: method is nil but OrderService.GetOrder was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetOrder.Lock()
+	mock.calls.GetOrder = append(mock.calls.GetOrder, callInfo)
+	mock.lockGetOrder.Unlock()
+	return mock.GetOrderFunc(ctx, uuidMoqParam)
+}
+
+// GetOrderCalls gets all the calls that were made to GetOrder.
+// Check the length with:
+//
+//	len(mockedOrderService.GetOrderCalls())
+func (mock *OrderServiceMock) GetOrderCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetOrder.RLock()
+	calls = mock.calls.GetOrder
+	mock.lockGetOrder.RUnlock()
+	return calls
+}
+
+// GetOrderHistory calls GetOrderHistoryFunc.
+func (mock *OrderServiceMock) GetOrderHistory(ctx context.Context, roomID string, menuName string, limit int) ([]entity.OrderSummary, error) {
+	if mock.GetOrderHistoryFunc == nil {
+		panic("OrderServiceMock.GetOrderHistoryFunc: method is nil but OrderService.GetOrderHistory was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuName string
+		Limit    int
+	}{
+		Ctx:      ctx,
+		RoomID:   roomID,
+		MenuName: menuName,
+		Limit:    limit,
+	}
+	mock.lockGetOrderHistory.Lock()
+	mock.calls.GetOrderHistory = append(mock.calls.GetOrderHistory, callInfo)
+	mock.lockGetOrderHistory.Unlock()
+	return mock.GetOrderHistoryFunc(ctx, roomID, menuName, limit)
+}
+
+// GetOrderHistoryCalls gets all the calls that were made to GetOrderHistory.
+// Check the length with:
+//
+//	len(mockedOrderService.GetOrderHistoryCalls())
+func (mock *OrderServiceMock) GetOrderHistoryCalls() []struct {
+	Ctx      context.Context
+	RoomID   string
+	MenuName string
+	Limit    int
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuName string
+		Limit    int
+	}
+	mock.lockGetOrderHistory.RLock()
+	calls = mock.calls.GetOrderHistory
+	mock.lockGetOrderHistory.RUnlock()
+	return calls
+}
+
+// GetOrderOverview calls GetOrderOverviewFunc.
+func (mock *OrderServiceMock) GetOrderOverview(ctx context.Context, roomID string, menuName string) (*service.OrderOverview, error) {
+	if mock.GetOrderOverviewFunc == nil {
+		panic("OrderServiceMock.GetOrderOverviewFunc: method is nil but OrderService.GetOrderOverview was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuName string
+	}{
+		Ctx:      ctx,
+		RoomID:   roomID,
+		MenuName: menuName,
+	}
+	mock.lockGetOrderOverview.Lock()
+	mock.calls.GetOrderOverview = append(mock.calls.GetOrderOverview, callInfo)
+	mock.lockGetOrderOverview.Unlock()
+	return mock.GetOrderOverviewFunc(ctx, roomID, menuName)
+}
+
+// GetOrderOverviewCalls gets all the calls that were made to GetOrderOverview.
+// Check the length with:
+//
+//	len(mockedOrderService.GetOrderOverviewCalls())
+func (mock *OrderServiceMock) GetOrderOverviewCalls() []struct {
+	Ctx      context.Context
+	RoomID   string
+	MenuName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RoomID   string
+		MenuName string
+	}
+	mock.lockGetOrderOverview.RLock()
+	calls = mock.calls.GetOrderOverview
+	mock.lockGetOrderOverview.RUnlock()
+	return calls
+}
+
+// ReorderLast calls ReorderLastFunc.
+func (mock *OrderServiceMock) ReorderLast(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string) (*service.Reorder, error) {
+	if mock.ReorderLastFunc == nil {
+		panic("OrderServiceMock.ReorderLastFunc: method is nil but OrderService.ReorderLast was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		MenuName:    menuName,
+	}
+	mock.lockReorderLast.Lock()
+	mock.calls.ReorderLast = append(mock.calls.ReorderLast, callInfo)
+	mock.lockReorderLast.Unlock()
+	return mock.ReorderLastFunc(ctx, currentUser, roomID, menuName)
+}
+
+// ReorderLastCalls gets all the calls that were made to ReorderLast.
+// Check the length with:
+//
+//	len(mockedOrderService.ReorderLastCalls())
+func (mock *OrderServiceMock) ReorderLastCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	MenuName    string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+	}
+	mock.lockReorderLast.RLock()
+	calls = mock.calls.ReorderLast
+	mock.lockReorderLast.RUnlock()
+	return calls
+}
+
+// SaveFavourite calls SaveFavouriteFunc.
+func (mock *OrderServiceMock) SaveFavourite(ctx context.Context, currentUser *uuid.UUID, menuName string, name string, items []string) (*entity.Favourite, error) {
+	if mock.SaveFavouriteFunc == nil {
+		panic("OrderServiceMock.SaveFavouriteFunc: method is nil but OrderService.SaveFavourite was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		Name        string
+		Items       []string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		MenuName:    menuName,
+		Name:        name,
+		Items:       items,
+	}
+	mock.lockSaveFavourite.Lock()
+	mock.calls.SaveFavourite = append(mock.calls.SaveFavourite, callInfo)
+	mock.lockSaveFavourite.Unlock()
+	return mock.SaveFavouriteFunc(ctx, currentUser, menuName, name, items)
+}
+
+// SaveFavouriteCalls gets all the calls that were made to SaveFavourite.
+// Check the length with:
+//
+//	len(mockedOrderService.SaveFavouriteCalls())
+func (mock *OrderServiceMock) SaveFavouriteCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	MenuName    string
+	Name        string
+	Items       []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		MenuName    string
+		Name        string
+		Items       []string
+	}
+	mock.lockSaveFavourite.RLock()
+	calls = mock.calls.SaveFavourite
+	mock.lockSaveFavourite.RUnlock()
+	return calls
+}
+
+// ShareOrder calls ShareOrderFunc.
+func (mock *OrderServiceMock) ShareOrder(ctx context.Context, currentUser *uuid.UUID, roomID string, menuName string, shared bool) (*entity.Order, error) {
+	if mock.ShareOrderFunc == nil {
+		panic("OrderServiceMock.ShareOrderFunc: method is nil but OrderService.ShareOrder was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+		Shared      bool
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		RoomID:      roomID,
+		MenuName:    menuName,
+		Shared:      shared,
+	}
+	mock.lockShareOrder.Lock()
+	mock.calls.ShareOrder = append(mock.calls.ShareOrder, callInfo)
+	mock.lockShareOrder.Unlock()
+	return mock.ShareOrderFunc(ctx, currentUser, roomID, menuName, shared)
+}
+
+// ShareOrderCalls gets all the calls that were made to ShareOrder.
+// Check the length with:
+//
+//	len(mockedOrderService.ShareOrderCalls())
+func (mock *OrderServiceMock) ShareOrderCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	RoomID      string
+	MenuName    string
+	Shared      bool
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		RoomID      string
+		MenuName    string
+		Shared      bool
+	}
+	mock.lockShareOrder.RLock()
+	calls = mock.calls.ShareOrder
+	mock.lockShareOrder.RUnlock()
+	return calls
+}
+
+// UpdateOrder calls UpdateOrderFunc.
+func (mock *OrderServiceMock) UpdateOrder(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+	if mock.UpdateOrderFunc == nil {
+		panic("OrderServiceMock.UpdateOrderFunc: method is nil but OrderService.UpdateOrder was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CurrentUser  *uuid.UUID
+		UuidMoqParam *uuid.UUID
+		Order        *entity.Order
+	}{
+		Ctx:          ctx,
+		CurrentUser:  currentUser,
+		UuidMoqParam: uuidMoqParam,
+		Order:        order,
+	}
+	mock.lockUpdateOrder.Lock()
+	mock.calls.UpdateOrder = append(mock.calls.UpdateOrder, callInfo)
+	mock.lockUpdateOrder.Unlock()
+	return mock.UpdateOrderFunc(ctx, currentUser, uuidMoqParam, order)
+}
+
+// UpdateOrderCalls gets all the calls that were made to UpdateOrder.
+// Check the length with:
+//
+//	len(mockedOrderService.UpdateOrderCalls())
+func (mock *OrderServiceMock) UpdateOrderCalls() []struct {
+	Ctx          context.Context
+	CurrentUser  *uuid.UUID
+	UuidMoqParam *uuid.UUID
+	Order        *entity.Order
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CurrentUser  *uuid.UUID
+		UuidMoqParam *uuid.UUID
+		Order        *entity.Order
+	}
+	mock.lockUpdateOrder.RLock()
+	calls = mock.calls.UpdateOrder
+	mock.lockUpdateOrder.RUnlock()
+	return calls
+}
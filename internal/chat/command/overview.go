@@ -0,0 +1,209 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+	"github.com/Markus-Schwer/ordaa/internal/templates"
+)
+
+var (
+	callSheetCommand = newCommand("call <menu>")
+	reminderCommand  = newCommand("remind <menu>")
+)
+
+// CallSheetHandler lists the dishes of an order to read out when calling the
+// restaurant.
+type CallSheetHandler struct {
+	OrderService OrderService
+	Templates    *templates.Templates
+}
+
+func (h *CallSheetHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := callSheetCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *CallSheetHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	args, _ := callSheetCommand.match(ctx, msg)
+
+	return renderOverview(ctx, h.OrderService, h.Templates, templates.CallSheet, req.Room, args.get("menu"))
+}
+
+// ReminderHandler reminds everyone who has not paid the sugar person yet.
+type ReminderHandler struct {
+	OrderService OrderService
+	Templates    *templates.Templates
+}
+
+func (h *ReminderHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := reminderCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *ReminderHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	args, _ := reminderCommand.match(ctx, msg)
+
+	return renderOverview(ctx, h.OrderService, h.Templates, templates.Reminder, req.Room, args.get("menu"))
+}
+
+// renderOverview renders the template with the active order of the menu in
+// the room.
+func renderOverview(
+	ctx context.Context,
+	orderService OrderService,
+	tmpl *templates.Templates,
+	name, roomID, menuName string,
+) *chat.Response {
+	overview, err := orderService.GetOrderOverview(ctx, roomID, menuName)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get status of order: %s", err)}
+	}
+
+	text, html, err := tmpl.Render(name, replyLanguage(ctx), orderData(ctx, overview))
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get status of order: %s", err)}
+	}
+
+	return &chat.Response{Msg: text, HTML: html}
+}
+
+// orderData formats the overview for the templates in the reply language.
+func orderData(ctx context.Context, overview *service.OrderOverview) *templates.Order {
+	order := overview.Order
+
+	data := &templates.Order{
+		Menu:        order.MenuName,
+		MenuURL:     overview.MenuURL,
+		State:       formatState(ctx, order.State),
+		Shared:      order.Shared,
+		Initiator:   initiatorName(ctx, order),
+		SugarPerson: order.SugarPersonName,
+		Count:       len(overview.Items),
+		Total:       formatPrice(ctx, order.Total),
+	}
+
+	if order.OrderDeadline != nil {
+		data.Deadline = formatDateTime(ctx, *order.OrderDeadline)
+	}
+
+	if order.Eta != nil {
+		data.ETA = formatDateTime(ctx, *order.Eta)
+	}
+
+	// the items are sorted by user, so the items of a user are next to each other
+	for _, userItems := range chunkBy(overview.Items, sameUser) {
+		user := orderUser(ctx, userItems, order.SugarPerson)
+
+		data.Users = append(data.Users, user)
+		if !user.Paid {
+			data.Debtors = append(data.Debtors, user)
+		}
+	}
+
+	data.Dishes = dishes(ctx, overview.Items)
+
+	return data
+}
+
+func sameUser(a, b entity.OrderItemSummary) bool {
+	return sameUUID(a.User, b.User)
+}
+
+func sameDish(a, b entity.OrderItemSummary) bool {
+	return a.ShortName == b.ShortName && a.Price == b.Price
+}
+
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// chunkBy splits items into runs of neighbours that are the same.
+func chunkBy[E any](items []E, same func(a, b E) bool) [][]E {
+	chunks := [][]E{}
+
+	for i, item := range items {
+		if i == 0 || !same(items[i-1], item) {
+			chunks = append(chunks, []E{})
+		}
+
+		chunks[len(chunks)-1] = append(chunks[len(chunks)-1], item)
+	}
+
+	return chunks
+}
+
+// orderUser sums up the items of one user, the sugar person owes nobody.
+func orderUser(ctx context.Context, items []entity.OrderItemSummary, sugarPerson *uuid.UUID) templates.User {
+	total, due := 0, 0
+
+	for _, item := range items {
+		total += item.Price
+		if !item.Paid {
+			due += item.Price
+		}
+	}
+
+	if sugarPerson != nil && sameUUID(sugarPerson, items[0].User) {
+		due = 0
+	}
+
+	name := items[0].UserName
+	if name == "" {
+		name = translatef(ctx, "a deleted user")
+	}
+
+	return templates.User{
+		Name:  name,
+		Items: dishes(ctx, items),
+		Total: formatPrice(ctx, total),
+		Due:   formatPrice(ctx, due),
+		Paid:  due == 0,
+	}
+}
+
+// dishes adds up the items by dish and price, sorted by short name.
+func dishes(ctx context.Context, items []entity.OrderItemSummary) []templates.Item {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b entity.OrderItemSummary) int {
+		if c := strings.Compare(a.ShortName, b.ShortName); c != 0 {
+			return c
+		}
+
+		return a.Price - b.Price
+	})
+
+	result := []templates.Item{}
+
+	for _, same := range chunkBy(sorted, sameDish) {
+		result = append(result, templates.Item{
+			ShortName: same[0].ShortName,
+			Name:      same[0].Name,
+			Quantity:  len(same),
+			Price:     formatPrice(ctx, same[0].Price),
+			Total:     formatPrice(ctx, same[0].Price*len(same)),
+		})
+	}
+
+	return result
+}
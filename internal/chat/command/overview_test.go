@@ -0,0 +1,202 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+	"github.com/Markus-Schwer/ordaa/internal/templates"
+)
+
+func TestCallSheet(t *testing.T) {
+	ctx := t.Context()
+
+	tmpl, err := templates.Load("")
+	require.NoError(t, err)
+
+	type testCase struct {
+		name         string
+		msg          string
+		language     string
+		orderService OrderService
+		matches      bool
+		response     *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name: "should list dishes for the restaurant",
+			msg:  fmt.Sprintf("%s call sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					assert.Equal(t, "!lunch:matrix.org", roomID)
+					assert.Equal(t, "sangam", name)
+
+					return overview(entity.Finalized, false), nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "sangam (https://sangam.example.com)\n\n2x 12 Butter Chicken\n1x 3 Naan\n\ndishes: 3, total: 28.50",
+				HTML: "<b><a href=\"https://sangam.example.com\">sangam</a></b>\n" +
+					"<ul>\n<li>2x <b>12</b> Butter Chicken</li>\n<li>1x <b>3</b> Naan</li>\n</ul>\ndishes: 3, total: 28.50",
+			},
+		},
+		{
+			name:     "should list dishes in the language of the room",
+			msg:      fmt.Sprintf("%s call sangam", DefaultPrefix),
+			language: "de",
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					return overview(entity.Finalized, false), nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "sangam (https://sangam.example.com)\n\n2x 12 Butter Chicken\n1x 3 Naan\n\nGerichte: 3, insgesamt: 28,50",
+				HTML: "<b><a href=\"https://sangam.example.com\">sangam</a></b>\n" +
+					"<ul>\n<li>2x <b>12</b> Butter Chicken</li>\n<li>1x <b>3</b> Naan</li>\n</ul>\nGerichte: 3, insgesamt: 28,50",
+			},
+		},
+		{
+			name:    "should not match call command without menu name",
+			msg:     fmt.Sprintf("%s call", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := CallSheetHandler{OrderService: tc.orderService, Templates: tmpl}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				Room:   "!lunch:matrix.org",
+				Text:   tc.msg,
+			}
+
+			roomCtx := WithRoomSettings(ctx, &entity.RoomSettings{Language: tc.language})
+
+			matches := h.Matches(roomCtx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				assert.Equal(t, tc.response, h.Handle(roomCtx, req))
+			}
+		})
+	}
+}
+
+func TestReminder(t *testing.T) {
+	ctx := t.Context()
+
+	tmpl, err := templates.Load("")
+	require.NoError(t, err)
+
+	type testCase struct {
+		name         string
+		msg          string
+		language     string
+		orderService OrderService
+		matches      bool
+		response     *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name: "should remind users who have not paid the sugar person",
+			msg:  fmt.Sprintf("%s remind sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					return overview(entity.Delivered, false), nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg:  "please pay Bob for your sangam order:\nAlice: 12.50",
+				HTML: "please pay Bob for your <b>sangam</b> order:\n<ul>\n<li>Alice: 12.50</li>\n</ul>",
+			},
+		},
+		{
+			name: "should tell when everyone has paid",
+			msg:  fmt.Sprintf("%s remind sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					o := overview(entity.Delivered, false)
+					o.Items[1].Paid = true
+
+					return o, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg:  "everyone has paid for the sangam order",
+				HTML: "everyone has paid for the <b>sangam</b> order",
+			},
+		},
+		{
+			name: "should remind deleted users",
+			msg:  fmt.Sprintf("%s remind sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					o := overview(entity.Delivered, false)
+					o.Order.SugarPerson = nil
+					o.Order.SugarPersonName = ""
+					o.Items[2].User = nil
+					o.Items[2].UserName = ""
+
+					return o, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg:  "please pay for your sangam order:\nAlice: 12.50\na deleted user: 3.50",
+				HTML: "please pay for your <b>sangam</b> order:\n<ul>\n<li>Alice: 12.50</li>\n<li>a deleted user: 3.50</li>\n</ul>",
+			},
+		},
+		{
+			name: "should handle order not found error",
+			msg:  fmt.Sprintf("%s remind sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					return nil, repository.ErrOrderNotFound
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not get status of order: order not found"},
+		},
+		{
+			name:    "should not match remind command with arguments after menu name",
+			msg:     fmt.Sprintf("%s remind sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := ReminderHandler{OrderService: tc.orderService, Templates: tmpl}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				Room:   "!lunch:matrix.org",
+				Text:   tc.msg,
+			}
+
+			roomCtx := WithRoomSettings(ctx, &entity.RoomSettings{Language: tc.language})
+
+			matches := h.Matches(roomCtx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				assert.Equal(t, tc.response, h.Handle(roomCtx, req))
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+const passwordSessionTTL = 10 * time.Minute
+
+const passwordPromptMessage = "Send me the new password for the web and api login in this chat, it needs at least %d characters. " +
+	"I remove your messages once I read them. Send 'cancel' to stop."
+
+var passwordCommand = newCommand("password")
+
+type passwordStep int
+
+const (
+	passwordStepNew passwordStep = iota
+	passwordStepConfirm
+)
+
+// passwordSession is a password setup that waits for the next message of the
+// user in the direct chat.
+type passwordSession struct {
+	roomID    string
+	userUUID  *uuid.UUID
+	step      passwordStep
+	password  string
+	expiresAt time.Time
+}
+
+// PasswordHandler sets the password for the web and api login. The password
+// itself is only accepted as plain message in the direct chat with the bot,
+// it is never accepted in a shared room.
+type PasswordHandler struct {
+	UserService UserService
+	Messenger   DirectMessenger
+
+	mu       sync.Mutex
+	sessions map[string]*passwordSession
+}
+
+func (h *PasswordHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	// the command is also taken with arguments to catch passwords sent along
+	// with it
+	return passwordCommand.addressed(ctx, msg) || h.InConversation(ctx, req)
+}
+
+// InConversation reports whether req answers a running password setup.
+func (h *PasswordHandler) InConversation(ctx context.Context, req *chat.Request) bool {
+	if _, ok := parseCommandLine(ctx, req.Text); ok {
+		return false
+	}
+
+	return h.session(req.Sender, req.Room) != nil
+}
+
+func (h *PasswordHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	if _, ok := passwordCommand.match(ctx, msg); ok {
+		return h.start(ctx, req)
+	}
+
+	if passwordCommand.addressed(ctx, msg) {
+		return &chat.Response{
+			Msg: translatef(ctx,
+				"never send your password to a room, I removed your message. Send '%s password' and answer in our direct chat",
+				commandPrefix(ctx),
+			),
+			Redact: true,
+		}
+	}
+
+	session := h.session(req.Sender, req.Room)
+	if session == nil {
+		return &chat.Response{Msg: translatef(ctx, "your password setup expired, send '%s password' to start again", commandPrefix(ctx))}
+	}
+
+	return h.answer(ctx, req.Sender, session, msg)
+}
+
+func (h *PasswordHandler) start(ctx context.Context, req *chat.Request) *chat.Response {
+	sender := req.Sender
+
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not set password: %s", err)}
+	}
+
+	roomID, err := h.Messenger.DirectRoom(ctx, sender)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not open direct chat: %s", err)}
+	}
+
+	h.mu.Lock()
+	if h.sessions == nil {
+		h.sessions = map[string]*passwordSession{}
+	}
+
+	h.sessions[sender] = &passwordSession{
+		roomID:    roomID,
+		userUUID:  currentUser.UUID,
+		step:      passwordStepNew,
+		expiresAt: time.Now().Add(passwordSessionTTL),
+	}
+	h.mu.Unlock()
+
+	err = h.Messenger.SendDirectMessage(ctx, sender, translatef(
+		ctx,
+		passwordPromptMessage,
+		service.MinPasswordLength,
+	))
+	if err != nil {
+		h.end(sender)
+		return &chat.Response{Msg: translatef(ctx, "could not send direct message: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "sent you a direct message to set your password")}
+}
+
+func (h *PasswordHandler) answer(ctx context.Context, sender string, session *passwordSession, msg string) *chat.Response {
+	if strings.EqualFold(strings.TrimSpace(msg), "cancel") {
+		h.end(sender)
+		return &chat.Response{Msg: translatef(ctx, "password setup cancelled")}
+	}
+
+	if session.step == passwordStepNew {
+		if utf8.RuneCountInString(msg) < service.MinPasswordLength {
+			return &chat.Response{Msg: translatef(ctx, "%s, send another one", service.ErrPasswordTooShort), Redact: true}
+		}
+
+		h.mu.Lock()
+		session.password = msg
+		session.step = passwordStepConfirm
+		h.mu.Unlock()
+
+		return &chat.Response{Msg: translatef(ctx, "send the password again to confirm it"), Redact: true}
+	}
+
+	if msg != session.password {
+		h.mu.Lock()
+		session.password = ""
+		session.step = passwordStepNew
+		h.mu.Unlock()
+
+		return &chat.Response{Msg: translatef(ctx, "the passwords do not match, send the new password again"), Redact: true}
+	}
+
+	h.end(sender)
+
+	passwordUser, err := h.UserService.SetPassword(ctx, session.userUUID, sender, msg)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not set password: %s", err), Redact: true}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "your password is set, log in with the username %s", passwordUser.Username), Redact: true}
+}
+
+// session returns the running setup of the user in the room, if any.
+func (h *PasswordHandler) session(sender string, roomID string) *passwordSession {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	session := h.sessions[sender]
+	if session == nil {
+		return nil
+	}
+
+	if time.Now().After(session.expiresAt) {
+		delete(h.sessions, sender)
+		return nil
+	}
+
+	if session.roomID != roomID {
+		return nil
+	}
+
+	return session
+}
+
+func (h *PasswordHandler) end(sender string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.sessions, sender)
+}
@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+func TestPassword(t *testing.T) {
+	ctx := t.Context()
+
+	const (
+		sharedRoom = "!shared:matrix.org"
+		directRoom = "!direct:matrix.org"
+		password   = "correct horse battery"
+	)
+
+	startMsg := fmt.Sprintf("%s password", DefaultPrefix)
+	started := &chat.Response{Msg: "sent you a direct message to set your password"}
+
+	type message struct {
+		room     string
+		msg      string
+		matches  bool
+		response *chat.Response
+	}
+
+	type testCase struct {
+		name      string
+		messages  []message
+		expire    bool
+		passwords []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "should set password after confirmation in direct chat",
+			messages: []message{
+				{sharedRoom, startMsg, true, started},
+				{directRoom, "short", true, &chat.Response{Msg: "password must have at least 12 characters, send another one", Redact: true}},
+				{directRoom, password, true, &chat.Response{Msg: "send the password again to confirm it", Redact: true}},
+				{directRoom, password, true, &chat.Response{Msg: "your password is set, log in with the username @test:matrix.org", Redact: true}},
+				{directRoom, password, false, nil},
+			},
+			passwords: []string{password},
+		},
+		{
+			name: "should start again when confirmation does not match",
+			messages: []message{
+				{sharedRoom, startMsg, true, started},
+				{directRoom, password, true, &chat.Response{Msg: "send the password again to confirm it", Redact: true}},
+				{directRoom, password + "!", true, &chat.Response{Msg: "the passwords do not match, send the new password again", Redact: true}},
+				{directRoom, password + "!", true, &chat.Response{Msg: "send the password again to confirm it", Redact: true}},
+			},
+		},
+		{
+			name: "should ignore answers in shared room",
+			messages: []message{
+				{sharedRoom, startMsg, true, started},
+				{sharedRoom, password, false, nil},
+			},
+		},
+		{
+			name: "should refuse password sent with the command",
+			messages: []message{
+				{sharedRoom, startMsg + " " + password, true, &chat.Response{
+					Msg:    "never send your password to a room, I removed your message. Send '.ordaa password' and answer in our direct chat",
+					Redact: true,
+				}},
+			},
+		},
+		{
+			name: "should refuse password with quote sent with the command",
+			messages: []message{
+				{sharedRoom, startMsg + ` "` + password, true, &chat.Response{
+					Msg:    "never send your password to a room, I removed your message. Send '.ordaa password' and answer in our direct chat",
+					Redact: true,
+				}},
+			},
+		},
+		{
+			name: "should cancel password setup",
+			messages: []message{
+				{sharedRoom, startMsg, true, started},
+				{directRoom, "Cancel", true, &chat.Response{Msg: "password setup cancelled"}},
+				{directRoom, password, false, nil},
+			},
+		},
+		{
+			name: "should not take commands in direct chat as password",
+			messages: []message{
+				{sharedRoom, startMsg, true, started},
+				{directRoom, fmt.Sprintf("%s help", DefaultPrefix), false, nil},
+			},
+		},
+		{
+			name:   "should ignore answers after setup expired",
+			expire: true,
+			messages: []message{
+				{sharedRoom, startMsg, true, started},
+				{directRoom, password, false, nil},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userService := &UserServiceMock{
+				SetPasswordFunc: func(ctx context.Context, user *uuid.UUID, username, password string) (*entity.PasswordUser, error) {
+					return &entity.PasswordUser{UserUUID: user, Username: username}, nil
+				},
+			}
+
+			h := &PasswordHandler{
+				UserService: userService,
+				Messenger: &DirectMessengerMock{
+					DirectRoomFunc: func(ctx context.Context, username string) (string, error) {
+						return directRoom, nil
+					},
+					SendDirectMessageFunc: func(ctx context.Context, username, msg string) error {
+						return nil
+					},
+				},
+			}
+
+			for i, msg := range tc.messages {
+				req := &chat.Request{
+					Sender: "@test:matrix.org",
+					User:   &entity.User{UUID: &userUUID},
+					Room:   msg.room,
+					Text:   msg.msg,
+				}
+
+				matches := h.Matches(ctx, req)
+				assert.Equal(t, msg.matches, matches, "message %d", i)
+
+				if matches {
+					assert.Equal(t, msg.response, h.Handle(ctx, req), "message %d", i)
+				}
+
+				if tc.expire {
+					for _, session := range h.sessions {
+						session.expiresAt = time.Now().Add(-time.Second)
+					}
+				}
+			}
+
+			passwords := []string{}
+			for _, call := range userService.SetPasswordCalls() {
+				passwords = append(passwords, call.Password)
+			}
+
+			if tc.passwords == nil {
+				tc.passwords = []string{}
+			}
+
+			assert.Equal(t, tc.passwords, passwords)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+var (
+	privacyExportCommand      = newCommand("privacy export")
+	privacyDeleteCommand      = newCommand("privacy delete [confirm]")
+	adminPrivacyExportCommand = newCommand("admin privacy export <user>")
+	adminPrivacyDeleteCommand = newCommand("admin privacy delete <user> [confirm]")
+)
+
+// PrivacyHandler answers data subject requests. Users can export and delete
+// their own data, admins can do the same for everybody.
+type PrivacyHandler struct {
+	UserService UserService
+	Accounts    Accounts
+	Messenger   DirectMessenger
+}
+
+func (h *PrivacyHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, privacyExportCommand, privacyDeleteCommand, adminPrivacyExportCommand, adminPrivacyDeleteCommand)
+}
+
+func (h *PrivacyHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+	sender := req.Sender
+
+	var (
+		username, command string
+		args              arguments
+		ok                bool
+	)
+
+	export := false
+
+	if _, ok = privacyExportCommand.match(ctx, msg); ok {
+		username, export = sender, true
+	} else if args, ok = privacyDeleteCommand.match(ctx, msg); ok {
+		username, command = sender, fmt.Sprintf("%s privacy delete confirm", commandPrefix(ctx))
+	} else if args, ok = adminPrivacyExportCommand.match(ctx, msg); ok {
+		username, export = args.get("user"), true
+	} else if args, ok = adminPrivacyDeleteCommand.match(ctx, msg); ok {
+		username = args.get("user")
+		command = fmt.Sprintf("%s admin privacy delete %s confirm", commandPrefix(ctx), username)
+	} else {
+		return usageResponse(ctx, privacyDeleteCommand)
+	}
+
+	confirm := args.get("confirm")
+	if confirm != "" && !strings.EqualFold(confirm, "confirm") {
+		return &chat.Response{Msg: translatef(ctx, "send '%s' to delete the account", command)}
+	}
+
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not handle privacy request: %s", err)}
+	}
+
+	userUUID := currentUser.UUID
+	if username != sender {
+		if userUUID, err = h.Accounts.AccountUUID(ctx, username); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not handle privacy request: %s", err)}
+		}
+	}
+
+	if export {
+		return h.export(ctx, sender, currentUser.UUID, userUUID, username)
+	}
+
+	if confirm == "" {
+		return &chat.Response{Msg: translatef(ctx,
+			"this deletes the account of %s and removes it from all past orders, send '%s' to continue",
+			username,
+			command,
+		)}
+	}
+
+	if err = h.UserService.DeleteUser(ctx, currentUser.UUID, userUUID); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not delete user data: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "deleted the account of %s", username)}
+}
+
+// export sends the data as a file to the sender, which for admin requests is
+// not the user the data belongs to.
+func (h *PrivacyHandler) export(ctx context.Context, sender string, currentUser, userUUID *uuid.UUID, username string) *chat.Response {
+	data, err := h.UserService.ExportUserData(ctx, currentUser, userUUID)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not export user data: %s", err)}
+	}
+
+	fileName := fmt.Sprintf("ordaa-export-%s.json", time.Now().Format(time.DateOnly))
+
+	if err = h.Messenger.SendDirectFile(ctx, sender, fileName, "application/json", data); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not send user data: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "sent you the data of %s via direct message", username)}
+}
@@ -0,0 +1,224 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestPrivacy(t *testing.T) {
+	ctx := t.Context()
+
+	otherUUID := uuid.Must(uuid.NewV4())
+
+	accountUUID := func(ctx context.Context, username string) (*uuid.UUID, error) {
+		switch username {
+		case "@test:matrix.org":
+			return &userUUID, nil
+		case "@other:matrix.org":
+			return &otherUUID, nil
+		default:
+			return nil, repository.ErrUserNotFound
+		}
+	}
+
+	accountOf := func(sender string) *entity.User {
+		account, err := accountUUID(ctx, sender)
+		if err != nil {
+			return nil
+		}
+
+		return &entity.User{UUID: account}
+	}
+
+	exportUserData := func(ctx context.Context, currentUser, user *uuid.UUID) ([]byte, error) {
+		return []byte(`{"user":{}}`), nil
+	}
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		userService *UserServiceMock
+		messenger   *DirectMessengerMock
+		matches     bool
+		response    *chat.Response
+		files       int
+		deleted     *uuid.UUID
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should send export via direct message",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s privacy export", DefaultPrefix),
+			userService: &UserServiceMock{
+				ExportUserDataFunc: exportUserData,
+			},
+			messenger: &DirectMessengerMock{
+				SendDirectFileFunc: func(ctx context.Context, username, fileName, mimeType string, data []byte) error {
+					assert.Equal(t, "@test:matrix.org", username)
+					assert.Equal(t, "application/json", mimeType)
+
+					return nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "sent you the data of @test:matrix.org via direct message"},
+			files:    1,
+		},
+		{
+			name:   "should send export of other user to admin",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s admin privacy export @other:matrix.org", DefaultPrefix),
+			userService: &UserServiceMock{
+				ExportUserDataFunc: func(ctx context.Context, currentUser, user *uuid.UUID) ([]byte, error) {
+					assert.Equal(t, otherUUID, *user)
+
+					return []byte(`{"user":{}}`), nil
+				},
+			},
+			messenger: &DirectMessengerMock{
+				SendDirectFileFunc: func(ctx context.Context, username, fileName, mimeType string, data []byte) error {
+					assert.Equal(t, "@test:matrix.org", username)
+
+					return nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "sent you the data of @other:matrix.org via direct message"},
+			files:    1,
+		},
+		{
+			name:   "should not export other user without permission",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s admin privacy export @other:matrix.org", DefaultPrefix),
+			userService: &UserServiceMock{
+				ExportUserDataFunc: func(ctx context.Context, currentUser, user *uuid.UUID) ([]byte, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrExportingUserData, service.ErrPermissionDenied)
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "could not export user data: could not export user data: permission denied"},
+		},
+		{
+			name:        "should ask for confirmation before deleting",
+			sender:      "@test:matrix.org",
+			msg:         fmt.Sprintf("%s privacy delete", DefaultPrefix),
+			userService: &UserServiceMock{},
+			messenger:   &DirectMessengerMock{},
+			matches:     true,
+			response: &chat.Response{
+				Msg: "this deletes the account of @test:matrix.org and removes it from all past orders, " +
+					"send '.ordaa privacy delete confirm' to continue",
+			},
+		},
+		{
+			name:   "should delete own account",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s privacy delete confirm", DefaultPrefix),
+			userService: &UserServiceMock{
+				DeleteUserFunc: func(ctx context.Context, currentUser, user *uuid.UUID) error {
+					return nil
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "deleted the account of @test:matrix.org"},
+			deleted:   &userUUID,
+		},
+		{
+			name:        "should ask admin for confirmation before deleting",
+			sender:      "@test:matrix.org",
+			msg:         fmt.Sprintf("%s admin privacy delete @other:matrix.org", DefaultPrefix),
+			userService: &UserServiceMock{},
+			messenger:   &DirectMessengerMock{},
+			matches:     true,
+			response: &chat.Response{
+				Msg: "this deletes the account of @other:matrix.org and removes it from all past orders, " +
+					"send '.ordaa admin privacy delete @other:matrix.org confirm' to continue",
+			},
+		},
+		{
+			name:   "should delete account of other user",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s admin privacy delete @other:matrix.org confirm", DefaultPrefix),
+			userService: &UserServiceMock{
+				DeleteUserFunc: func(ctx context.Context, currentUser, user *uuid.UUID) error {
+					return nil
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response:  &chat.Response{Msg: "deleted the account of @other:matrix.org"},
+			deleted:   &otherUUID,
+		},
+		{
+			name:   "should not delete the last admin",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s privacy delete confirm", DefaultPrefix),
+			userService: &UserServiceMock{
+				DeleteUserFunc: func(ctx context.Context, currentUser, user *uuid.UUID) error {
+					return fmt.Errorf("%w: %w", repository.ErrDeletingUser, service.ErrLastAdmin)
+				},
+			},
+			messenger: &DirectMessengerMock{},
+			matches:   true,
+			response: &chat.Response{
+				Msg: "could not delete user data: could not delete user: cannot revoke the admin role from the last admin",
+			},
+			deleted: &userUUID,
+		},
+		{
+			name:    "should not match confirmed export",
+			msg:     fmt.Sprintf("%s admin privacy export @other:matrix.org confirm", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match admin command without user",
+			msg:     fmt.Sprintf("%s admin privacy delete", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := PrivacyHandler{
+				UserService: tc.userService,
+				Accounts:    &AccountsMock{AccountUUIDFunc: accountUUID},
+				Messenger:   tc.messenger,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   accountOf(tc.sender),
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+				assert.Len(t, tc.messenger.SendDirectFileCalls(), tc.files)
+
+				if deleteCalls := tc.userService.DeleteUserCalls(); tc.deleted != nil {
+					assert.Len(t, deleteCalls, 1)
+					assert.Equal(t, *tc.deleted, *deleteCalls[0].UuidMoqParam)
+				} else {
+					assert.Empty(t, deleteCalls)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var registerCommand = newCommand("register")
+
+//go:generate go tool moq -rm -out user_service_mock.go . UserService
+
+type UserService interface {
+	GetAllUsers(ctx context.Context) ([]entity.User, error)
+	GetUser(ctx context.Context, uuid *uuid.UUID) (*entity.User, error)
+	CreateUser(ctx context.Context, user *entity.User) (*entity.User, error)
+	UpdateUser(ctx context.Context, currentUser, uuid *uuid.UUID, user *entity.User) (*entity.User, error)
+	DeleteUser(ctx context.Context, currentUser, uuid *uuid.UUID) error
+	SetLanguage(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error)
+	AddPublicKey(ctx context.Context, userUUID *uuid.UUID, authorizedKey string) (*entity.SSHUser, error)
+	GetPublicKeys(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error)
+	RemovePublicKey(ctx context.Context, userUUID *uuid.UUID, fingerprint string) (*entity.SSHUser, error)
+	GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error)
+	GrantRole(ctx context.Context, currentUser, userUUID *uuid.UUID, role entity.Role) error
+	RevokeRole(ctx context.Context, currentUser, userUUID *uuid.UUID, role entity.Role) error
+	CreateLinkCode(ctx context.Context, userUUID *uuid.UUID) (*entity.LinkCode, error)
+	LinkUser(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error)
+	CreateAPIToken(
+		ctx context.Context,
+		userUUID *uuid.UUID,
+		name string,
+		scope entity.TokenScope,
+		ttl time.Duration,
+	) (string, *entity.APIToken, error)
+	GetAPITokens(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error)
+	RevokeAPIToken(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error)
+	SetPassword(ctx context.Context, userUUID *uuid.UUID, username, password string) (*entity.PasswordUser, error)
+	ExportUserData(ctx context.Context, currentUser, userUUID *uuid.UUID) ([]byte, error)
+}
+
+//go:generate go tool moq -rm -out accounts_mock.go . Accounts
+
+// Accounts maps the users of a chat network to accounts. The front end
+// implements it, because only it knows how the chat network identifies users.
+type Accounts interface {
+	// Register creates an account for the sender of req.
+	Register(ctx context.Context, req *chat.Request) (*entity.User, error)
+	// AccountUUID returns the account of a user of the chat network, e.g.
+	// one named in a command.
+	AccountUUID(ctx context.Context, username string) (*uuid.UUID, error)
+	// Link adds the sender of req as a login to the account of the link
+	// code.
+	Link(ctx context.Context, req *chat.Request, code string) (*entity.User, error)
+}
+
+type RegisterHandler struct {
+	Accounts Accounts
+}
+
+func (h *RegisterHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+	_, ok := registerCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *RegisterHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	// with auto registration the account was already created for this
+	// command, so an existing account is not an error
+	if req.User != nil {
+		return &chat.Response{Msg: translatef(ctx, "you are registered as %s", req.User.Name)}
+	}
+
+	user, err := h.Accounts.Register(ctx, req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not register user: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "successfully registered user: %s", user.Name)}
+}
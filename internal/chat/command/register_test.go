@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+func TestRegister(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name     string
+		sender   string
+		user     *entity.User
+		msg      string
+		accounts Accounts
+		matches  bool
+		response *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle register command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s register", DefaultPrefix),
+			accounts: &AccountsMock{
+				RegisterFunc: func(ctx context.Context, req *chat.Request) (*entity.User, error) {
+					assert.Equal(t, "@test:matrix.org", req.Sender)
+
+					return &entity.User{Name: "Test"}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "successfully registered user: Test"},
+		},
+		{
+			name:   "should handle register command error",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s register", DefaultPrefix),
+			accounts: &AccountsMock{
+				RegisterFunc: func(ctx context.Context, req *chat.Request) (*entity.User, error) {
+					return nil, repository.ErrCreatingUser
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not register user: could not create user"},
+		},
+		{
+			name:     "should accept an existing account",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s register", DefaultPrefix),
+			user:     &entity.User{UUID: &userUUID, Name: "Test"},
+			matches:  true,
+			response: &chat.Response{Msg: "you are registered as Test"},
+		},
+		{
+			name:    "should not match register command without prefix",
+			msg:     "register",
+			matches: false,
+		},
+		{
+			name:    "should not match register command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match register command with arguments",
+			msg:     fmt.Sprintf("%s register me", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := RegisterHandler{Accounts: tc.accounts}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   tc.user,
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
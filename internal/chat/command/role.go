@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+var (
+	roleGrantCommand  = newCommand("admin role grant <user> <role>")
+	roleRevokeCommand = newCommand("admin role revoke <user> <role>")
+	rolesCommand      = newCommand("roles [user]")
+)
+
+type RoleHandler struct {
+	UserService UserService
+	Accounts    Accounts
+}
+
+func (h *RoleHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, roleGrantCommand, roleRevokeCommand, rolesCommand)
+}
+
+func (h *RoleHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	if args, ok := rolesCommand.match(ctx, msg); ok {
+		return h.listRoles(ctx, req, args.get("user"))
+	}
+
+	grant, failure := true, "could not grant role: %s"
+
+	args, ok := roleGrantCommand.match(ctx, msg)
+	if !ok {
+		grant, failure = false, "could not revoke role: %s"
+
+		if args, ok = roleRevokeCommand.match(ctx, msg); !ok {
+			return usageResponse(ctx, roleGrantCommand)
+		}
+	}
+
+	username, role := args.get("user"), strings.ToLower(args.get("role"))
+
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, failure, err)}
+	}
+
+	userUUID, err := h.Accounts.AccountUUID(ctx, username)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, failure, err)}
+	}
+
+	if grant {
+		if err = h.UserService.GrantRole(ctx, currentUser.UUID, userUUID, role); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not grant role: %s", err)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx, "granted role %s to %s", role, username)}
+	}
+
+	if err = h.UserService.RevokeRole(ctx, currentUser.UUID, userUUID, role); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not revoke role: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "revoked role %s from %s", role, username)}
+}
+
+// listRoles lists the roles of the user, or of the sender if username is
+// empty.
+func (h *RoleHandler) listRoles(ctx context.Context, req *chat.Request, username string) *chat.Response {
+	var userUUID *uuid.UUID
+
+	if username == "" {
+		currentUser, err := senderAccount(req)
+		if err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not get roles: %s", err)}
+		}
+
+		username, userUUID = req.Sender, currentUser.UUID
+	} else {
+		var err error
+		if userUUID, err = h.Accounts.AccountUUID(ctx, username); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not get roles: %s", err)}
+		}
+	}
+
+	roles, err := h.UserService.GetRoles(ctx, userUUID)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get roles: %s", err)}
+	}
+
+	if len(roles) == 0 {
+		return &chat.Response{Msg: translatef(ctx, "%s has no roles", username)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "roles of %s: %s", username, strings.Join(roles, ", "))}
+}
@@ -0,0 +1,176 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestRole(t *testing.T) {
+	ctx := t.Context()
+
+	otherUserUUID := uuid.Must(uuid.NewV4())
+
+	accountUUID := func(ctx context.Context, username string) (*uuid.UUID, error) {
+		switch username {
+		case "@admin:matrix.org":
+			return &userUUID, nil
+		case "@test:matrix.org":
+			return &otherUserUUID, nil
+		default:
+			return nil, repository.ErrUserNotFound
+		}
+	}
+
+	accountOf := func(sender string) *entity.User {
+		account, err := accountUUID(ctx, sender)
+		if err != nil {
+			return nil
+		}
+
+		return &entity.User{UUID: account}
+	}
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		userService UserService
+		matches     bool
+		response    *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle role grant command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin role grant @test:matrix.org admin", DefaultPrefix),
+			userService: &UserServiceMock{
+				GrantRoleFunc: func(ctx context.Context, currentUser, user *uuid.UUID, role entity.Role) error {
+					if *currentUser != userUUID || *user != otherUserUUID || role != entity.RoleAdmin {
+						return repository.ErrGrantingRole
+					}
+
+					return nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "granted role admin to @test:matrix.org"},
+		},
+		{
+			name:   "should handle role grant command without permission",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s admin role grant @test:matrix.org admin", DefaultPrefix),
+			userService: &UserServiceMock{
+				GrantRoleFunc: func(ctx context.Context, currentUser, user *uuid.UUID, role entity.Role) error {
+					return fmt.Errorf("%w: %w", service.ErrGrantingRole, service.ErrPermissionDenied)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not grant role: could not grant role: permission denied"},
+		},
+		{
+			name:        "should handle role grant command for unknown user",
+			sender:      "@admin:matrix.org",
+			msg:         fmt.Sprintf("%s admin role grant @nobody:matrix.org admin", DefaultPrefix),
+			userService: &UserServiceMock{},
+			matches:     true,
+			response:    &chat.Response{Msg: "could not grant role: user not found"},
+		},
+		{
+			name:   "should handle role revoke command",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin role revoke @test:matrix.org Admin", DefaultPrefix),
+			userService: &UserServiceMock{
+				RevokeRoleFunc: func(ctx context.Context, currentUser, user *uuid.UUID, role entity.Role) error {
+					return nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "revoked role admin from @test:matrix.org"},
+		},
+		{
+			name:   "should handle role revoke command for last admin",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s admin role revoke @admin:matrix.org admin", DefaultPrefix),
+			userService: &UserServiceMock{
+				RevokeRoleFunc: func(ctx context.Context, currentUser, user *uuid.UUID, role entity.Role) error {
+					return service.ErrLastAdmin
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not revoke role: cannot revoke the admin role from the last admin"},
+		},
+		{
+			name:   "should handle roles command for sender",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s roles", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetRolesFunc: func(ctx context.Context, user *uuid.UUID) ([]entity.Role, error) {
+					return []entity.Role{entity.RoleAdmin, entity.RoleMember}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "roles of @admin:matrix.org: admin, member"},
+		},
+		{
+			name:   "should handle roles command for other user without roles",
+			sender: "@admin:matrix.org",
+			msg:    fmt.Sprintf("%s roles @test:matrix.org", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetRolesFunc: func(ctx context.Context, user *uuid.UUID) ([]entity.Role, error) {
+					return []entity.Role{}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "@test:matrix.org has no roles"},
+		},
+		{
+			name:    "should not match role grant command without role",
+			msg:     fmt.Sprintf("%s admin role grant @test:matrix.org", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match role command with unknown action",
+			msg:     fmt.Sprintf("%s admin role promote @test:matrix.org admin", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := RoleHandler{
+				UserService: tc.userService,
+				Accounts:    &AccountsMock{AccountUUIDFunc: accountUUID},
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   accountOf(tc.sender),
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+var (
+	roomShowCommand  = newCommand("room show")
+	roomSetCommand   = newCommand("room set <setting> <value>")
+	roomResetCommand = newCommand("room reset <setting>")
+)
+
+//go:generate go tool moq -rm -out room_service_mock.go . RoomService
+type RoomService interface {
+	GetRoomSettings(ctx context.Context, roomID string) (*entity.RoomSettings, error)
+	UpdateRoomSetting(ctx context.Context, roomID, setting, value string) (*entity.RoomSettings, error)
+}
+
+//go:generate go tool moq -rm -out room_permissions_mock.go . RoomPermissions
+type RoomPermissions interface {
+	IsRoomAdmin(ctx context.Context, roomID, userID string) (bool, error)
+}
+
+type roomSettingsKey struct{}
+
+// WithRoomSettings returns a context in which commands are read with the
+// prefix and default menu of the room they were sent in.
+func WithRoomSettings(ctx context.Context, settings *entity.RoomSettings) context.Context {
+	return context.WithValue(ctx, roomSettingsKey{}, settings)
+}
+
+func roomSettings(ctx context.Context) *entity.RoomSettings {
+	if settings, ok := ctx.Value(roomSettingsKey{}).(*entity.RoomSettings); ok {
+		return settings
+	}
+
+	return &entity.RoomSettings{}
+}
+
+// commandPrefix returns the prefix commands start with in the room,
+// DefaultPrefix unless the room set another one.
+func commandPrefix(ctx context.Context) string {
+	if prefix := roomSettings(ctx).Prefix; prefix != "" {
+		return prefix
+	}
+
+	return DefaultPrefix
+}
+
+func defaultMenuName(ctx context.Context) string {
+	if menu := roomSettings(ctx).DefaultMenu; menu != nil {
+		return menu.Name
+	}
+
+	return ""
+}
+
+// RoomHandler shows and changes the settings of the room, which only room
+// admins may change.
+type RoomHandler struct {
+	RoomService RoomService
+	Permissions RoomPermissions
+	// AutoRegister is used in rooms that do not set auto-register.
+	AutoRegister bool
+}
+
+func (h *RoomHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, roomShowCommand, roomSetCommand, roomResetCommand)
+}
+
+func (h *RoomHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	if _, ok := roomShowCommand.match(ctx, msg); ok {
+		return h.show(ctx, req.Room)
+	}
+
+	isAdmin, err := h.Permissions.IsRoomAdmin(ctx, req.Room, req.Sender)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update room settings: %s", err)}
+	}
+
+	if !isAdmin {
+		return &chat.Response{Msg: translatef(ctx, "only room admins can change the settings of this room")}
+	}
+
+	if args, ok := roomSetCommand.match(ctx, msg); ok {
+		setting, value := strings.ToLower(args.get("setting")), args.get("value")
+
+		if _, err = h.RoomService.UpdateRoomSetting(ctx, req.Room, setting, value); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "could not update room settings: %s", err)}
+		}
+
+		return &chat.Response{Msg: translatef(ctx, "set %s of this room to %s", setting, value)}
+	}
+
+	args, ok := roomResetCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, roomSetCommand)
+	}
+
+	setting := strings.ToLower(args.get("setting"))
+
+	if _, err = h.RoomService.UpdateRoomSetting(ctx, req.Room, setting, ""); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update room settings: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "reset %s of this room to the default", setting)}
+}
+
+func (h *RoomHandler) show(ctx context.Context, roomID string) *chat.Response {
+	settings, err := h.RoomService.GetRoomSettings(ctx, roomID)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not get room settings: %s", err)}
+	}
+
+	prefix := settings.Prefix
+	if prefix == "" {
+		prefix = translatef(ctx, "%s (default)", DefaultPrefix)
+	}
+
+	language := settings.Language
+	if language == "" {
+		language = translatef(ctx, "%s (default)", service.Languages[0])
+	}
+
+	defaultMenu := translatef(ctx, "none")
+	if settings.DefaultMenu != nil {
+		defaultMenu = settings.DefaultMenu.Name
+	}
+
+	autoRegister := translatef(ctx, "%s (default)", formatOnOff(h.AutoRegister))
+	if settings.AutoRegister != nil {
+		autoRegister = formatOnOff(*settings.AutoRegister)
+	}
+
+	return &chat.Response{Msg: strings.Join([]string{
+		translatef(ctx, "settings of this room:"),
+		fmt.Sprintf("%s: %s", service.RoomSettingPrefix, prefix),
+		fmt.Sprintf("%s: %s", service.RoomSettingLanguage, language),
+		fmt.Sprintf("%s: %s", service.RoomSettingDefaultMenu, defaultMenu),
+		fmt.Sprintf("%s: %s", service.RoomSettingAutoRegister, autoRegister),
+	}, "\n")}
+}
+
+func formatOnOff(on bool) string {
+	if on {
+		return "on"
+	}
+
+	return "off"
+}
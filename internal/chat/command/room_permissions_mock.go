@@ -0,0 +1,87 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that RoomPermissionsMock does implement RoomPermissions.
+// If this is not the case, regenerate this file with moq.
+var _ RoomPermissions = &RoomPermissionsMock{}
+
+// RoomPermissionsMock is a mock implementation of RoomPermissions.
+//
+//	func TestSomethingThatUsesRoomPermissions(t *testing.T) {
+//
+//		// make and configure a mocked RoomPermissions
+//		mockedRoomPermissions := &RoomPermissionsMock{
+//			IsRoomAdminFunc: func(ctx context.Context, roomID string, userID string) (bool, error) {
+//				panic("mock out the IsRoomAdmin method")
+//			},
+//		}
+//
+//		// use mockedRoomPermissions in code that requires RoomPermissions
+//		// and then make assertions.
+//
+//	}
+type RoomPermissionsMock struct {
+	// IsRoomAdminFunc mocks the IsRoomAdmin method.
+	IsRoomAdminFunc func(ctx context.Context, roomID string, userID string) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// IsRoomAdmin holds details about calls to the IsRoomAdmin method.
+		IsRoomAdmin []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// UserID is the userID argument value.
+			UserID string
+		}
+	}
+	lockIsRoomAdmin sync.RWMutex
+}
+
+// IsRoomAdmin calls IsRoomAdminFunc.
+func (mock *RoomPermissionsMock) IsRoomAdmin(ctx context.Context, roomID string, userID string) (bool, error) {
+	if mock.IsRoomAdminFunc == nil {
+		panic("RoomPermissionsMock.IsRoomAdminFunc: method is nil but RoomPermissions.IsRoomAdmin was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RoomID string
+		UserID string
+	}{
+		Ctx:    ctx,
+		RoomID: roomID,
+		UserID: userID,
+	}
+	mock.lockIsRoomAdmin.Lock()
+	mock.calls.IsRoomAdmin = append(mock.calls.IsRoomAdmin, callInfo)
+	mock.lockIsRoomAdmin.Unlock()
+	return mock.IsRoomAdminFunc(ctx, roomID, userID)
+}
+
+// IsRoomAdminCalls gets all the calls that were made to IsRoomAdmin.
+// Check the length with:
+//
+//	len(mockedRoomPermissions.IsRoomAdminCalls())
+func (mock *RoomPermissionsMock) IsRoomAdminCalls() []struct {
+	Ctx    context.Context
+	RoomID string
+	UserID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RoomID string
+		UserID string
+	}
+	mock.lockIsRoomAdmin.RLock()
+	calls = mock.calls.IsRoomAdmin
+	mock.lockIsRoomAdmin.RUnlock()
+	return calls
+}
@@ -0,0 +1,144 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"sync"
+)
+
+// Ensure, that RoomServiceMock does implement RoomService.
+// If this is not the case, regenerate this file with moq.
+var _ RoomService = &RoomServiceMock{}
+
+// RoomServiceMock is a mock implementation of RoomService.
+//
+//	func TestSomethingThatUsesRoomService(t *testing.T) {
+//
+//		// make and configure a mocked RoomService
+//		mockedRoomService := &RoomServiceMock{
+//			GetRoomSettingsFunc: func(ctx context.Context, roomID string) (*entity.RoomSettings, error) {
+//				panic("mock out the GetRoomSettings method")
+//			},
+//			UpdateRoomSettingFunc: func(ctx context.Context, roomID string, setting string, value string) (*entity.RoomSettings, error) {
+//				panic("mock out the UpdateRoomSetting method")
+//			},
+//		}
+//
+//		// use mockedRoomService in code that requires RoomService
+//		// and then make assertions.
+//
+//	}
+type RoomServiceMock struct {
+	// GetRoomSettingsFunc mocks the GetRoomSettings method.
+	GetRoomSettingsFunc func(ctx context.Context, roomID string) (*entity.RoomSettings, error)
+
+	// UpdateRoomSettingFunc mocks the UpdateRoomSetting method.
+	UpdateRoomSettingFunc func(ctx context.Context, roomID string, setting string, value string) (*entity.RoomSettings, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetRoomSettings holds details about calls to the GetRoomSettings method.
+		GetRoomSettings []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+		}
+		// UpdateRoomSetting holds details about calls to the UpdateRoomSetting method.
+		UpdateRoomSetting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RoomID is the roomID argument value.
+			RoomID string
+			// Setting is the setting argument value.
+			Setting string
+			// Value is the value argument value.
+			Value string
+		}
+	}
+	lockGetRoomSettings   sync.RWMutex
+	lockUpdateRoomSetting sync.RWMutex
+}
+
+// GetRoomSettings calls GetRoomSettingsFunc.
+func (mock *RoomServiceMock) GetRoomSettings(ctx context.Context, roomID string) (*entity.RoomSettings, error) {
+	if mock.GetRoomSettingsFunc == nil {
+		panic("RoomServiceMock.GetRoomSettingsFunc: method is nil but RoomService.GetRoomSettings was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		RoomID string
+	}{
+		Ctx:    ctx,
+		RoomID: roomID,
+	}
+	mock.lockGetRoomSettings.Lock()
+	mock.calls.GetRoomSettings = append(mock.calls.GetRoomSettings, callInfo)
+	mock.lockGetRoomSettings.Unlock()
+	return mock.GetRoomSettingsFunc(ctx, roomID)
+}
+
+// GetRoomSettingsCalls gets all the calls that were made to GetRoomSettings.
+// Check the length with:
+//
+//	len(mockedRoomService.GetRoomSettingsCalls())
+func (mock *RoomServiceMock) GetRoomSettingsCalls() []struct {
+	Ctx    context.Context
+	RoomID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		RoomID string
+	}
+	mock.lockGetRoomSettings.RLock()
+	calls = mock.calls.GetRoomSettings
+	mock.lockGetRoomSettings.RUnlock()
+	return calls
+}
+
+// UpdateRoomSetting calls UpdateRoomSettingFunc.
+func (mock *RoomServiceMock) UpdateRoomSetting(ctx context.Context, roomID string, setting string, value string) (*entity.RoomSettings, error) {
+	if mock.UpdateRoomSettingFunc == nil {
+		panic("RoomServiceMock.UpdateRoomSettingFunc: method is nil but RoomService.UpdateRoomSetting was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		RoomID  string
+		Setting string
+		Value   string
+	}{
+		Ctx:     ctx,
+		RoomID:  roomID,
+		Setting: setting,
+		Value:   value,
+	}
+	mock.lockUpdateRoomSetting.Lock()
+	mock.calls.UpdateRoomSetting = append(mock.calls.UpdateRoomSetting, callInfo)
+	mock.lockUpdateRoomSetting.Unlock()
+	return mock.UpdateRoomSettingFunc(ctx, roomID, setting, value)
+}
+
+// UpdateRoomSettingCalls gets all the calls that were made to UpdateRoomSetting.
+// Check the length with:
+//
+//	len(mockedRoomService.UpdateRoomSettingCalls())
+func (mock *RoomServiceMock) UpdateRoomSettingCalls() []struct {
+	Ctx     context.Context
+	RoomID  string
+	Setting string
+	Value   string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		RoomID  string
+		Setting string
+		Value   string
+	}
+	mock.lockUpdateRoomSetting.RLock()
+	calls = mock.calls.UpdateRoomSetting
+	mock.lockUpdateRoomSetting.RUnlock()
+	return calls
+}
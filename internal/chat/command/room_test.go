@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestRoom(t *testing.T) {
+	ctx := t.Context()
+
+	roomID := "!lunch:matrix.org"
+	autoRegister := true
+
+	permissions := &RoomPermissionsMock{
+		IsRoomAdminFunc: func(ctx context.Context, roomID, userID string) (bool, error) {
+			return userID == "@admin:matrix.org", nil
+		},
+	}
+
+	updateRoomSetting := func(ctx context.Context, roomID, setting, value string) (*entity.RoomSettings, error) {
+		if setting != service.RoomSettingPrefix {
+			return nil, fmt.Errorf("%w: %w: %s", service.ErrUpdatingRoomSettings, service.ErrUnknownRoomSetting, setting)
+		}
+
+		return &entity.RoomSettings{RoomID: roomID, Prefix: value}, nil
+	}
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		roomService *RoomServiceMock
+		matches     bool
+		response    *chat.Response
+		setting     string
+		value       string
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should show default settings",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s room show", DefaultPrefix),
+			roomService: &RoomServiceMock{
+				GetRoomSettingsFunc: func(ctx context.Context, roomID string) (*entity.RoomSettings, error) {
+					return &entity.RoomSettings{RoomID: roomID}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{Msg: "settings of this room:\n" +
+				"prefix: .ordaa (default)\n" +
+				"language: en (default)\n" +
+				"default-menu: none\n" +
+				"auto-register: off (default)"},
+		},
+		{
+			name:   "should show settings of room",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s room show", DefaultPrefix),
+			roomService: &RoomServiceMock{
+				GetRoomSettingsFunc: func(ctx context.Context, roomID string) (*entity.RoomSettings, error) {
+					return &entity.RoomSettings{
+						RoomID:       roomID,
+						Prefix:       "!food",
+						Language:     "en",
+						DefaultMenu:  &entity.Menu{Name: "sangam"},
+						AutoRegister: &autoRegister,
+					}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{Msg: "settings of this room:\n" +
+				"prefix: !food\n" +
+				"language: en\n" +
+				"default-menu: sangam\n" +
+				"auto-register: on"},
+		},
+		{
+			name:        "should set setting as room admin",
+			sender:      "@admin:matrix.org",
+			msg:         fmt.Sprintf("%s room set Prefix !food", DefaultPrefix),
+			roomService: &RoomServiceMock{UpdateRoomSettingFunc: updateRoomSetting},
+			matches:     true,
+			response:    &chat.Response{Msg: "set prefix of this room to !food"},
+			setting:     "prefix",
+			value:       "!food",
+		},
+		{
+			name:        "should reset setting as room admin",
+			sender:      "@admin:matrix.org",
+			msg:         fmt.Sprintf("%s room reset prefix", DefaultPrefix),
+			roomService: &RoomServiceMock{UpdateRoomSettingFunc: updateRoomSetting},
+			matches:     true,
+			response:    &chat.Response{Msg: "reset prefix of this room to the default"},
+			setting:     "prefix",
+		},
+		{
+			name:        "should report unknown setting",
+			sender:      "@admin:matrix.org",
+			msg:         fmt.Sprintf("%s room set colour blue", DefaultPrefix),
+			roomService: &RoomServiceMock{UpdateRoomSettingFunc: updateRoomSetting},
+			matches:     true,
+			response: &chat.Response{
+				Msg: "could not update room settings: could not update room settings: unknown room setting: colour",
+			},
+			setting: "colour",
+			value:   "blue",
+		},
+		{
+			name:        "should not set setting as other user",
+			sender:      "@test:matrix.org",
+			msg:         fmt.Sprintf("%s room set prefix !food", DefaultPrefix),
+			roomService: &RoomServiceMock{},
+			matches:     true,
+			response:    &chat.Response{Msg: "only room admins can change the settings of this room"},
+		},
+		{
+			name:    "should not match set without value",
+			sender:  "@admin:matrix.org",
+			msg:     fmt.Sprintf("%s room set prefix", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := RoomHandler{
+				RoomService: tc.roomService,
+				Permissions: permissions,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				Room:   roomID,
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+
+				if calls := tc.roomService.UpdateRoomSettingCalls(); len(calls) > 0 {
+					assert.Equal(t, roomID, calls[0].RoomID)
+					assert.Equal(t, tc.setting, calls[0].Setting)
+					assert.Equal(t, tc.value, calls[0].Value)
+				}
+			}
+		})
+	}
+}
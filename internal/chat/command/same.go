@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+var sameCommand = newCommand("same <user> [menu]")
+
+type SameHandler struct {
+	OrderService OrderService
+	Accounts     Accounts
+}
+
+func (h *SameHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := sameCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *SameHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	args, ok := sameCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, sameCommand)
+	}
+
+	username, menuName := args.get("user"), args.get("menu")
+
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not copy order: %s", err)}
+	}
+
+	userUUID, err := h.Accounts.AccountUUID(ctx, username)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not copy order: %s", err)}
+	}
+
+	copied, err := h.OrderService.CopyOrderItems(ctx, currentUser.UUID, userUUID, req.Room, menuName)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not copy order: %s", err)}
+	}
+
+	items := make([]string, 0, len(copied.Copied))
+	for _, menuItem := range copied.Copied {
+		items = append(items, fmt.Sprintf("%s %s", menuItem.ShortName, menuItem.Name))
+	}
+
+	return &chat.Response{Msg: translatef(ctx,
+		"copied %s from %s to your order at %s, your subtotal is %s",
+		strings.Join(items, ", "),
+		username,
+		copied.MenuName,
+		formatPrice(ctx, copied.Subtotal),
+	)}
+}
@@ -0,0 +1,124 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestSame(t *testing.T) {
+	ctx := t.Context()
+
+	otherUUID := uuid.Must(uuid.NewV4())
+
+	accounts := &AccountsMock{
+		AccountUUIDFunc: func(ctx context.Context, username string) (*uuid.UUID, error) {
+			switch username {
+			case "@test:matrix.org":
+				return &userUUID, nil
+			case "@alice:matrix.org":
+				return &otherUUID, nil
+			default:
+				return nil, repository.ErrUserNotFound
+			}
+		},
+	}
+
+	type testCase struct {
+		name         string
+		msg          string
+		orderService *OrderServiceMock
+		matches      bool
+		response     *chat.Response
+		menuName     string
+	}
+
+	testCases := []testCase{
+		{
+			name: "should copy items of other user",
+			msg:  fmt.Sprintf("%s same @alice:matrix.org", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				CopyOrderItemsFunc: func(
+					ctx context.Context,
+					currentUser, user *uuid.UUID,
+					roomID, menuName string,
+				) (*service.CopiedOrderItems, error) {
+					assert.Equal(t, otherUUID, *user)
+
+					return &service.CopiedOrderItems{
+						MenuName: "sangam",
+						Copied:   []entity.MenuItem{{ShortName: "M7", Name: "Chicken Masala"}, {ShortName: "174", Name: "Naan"}},
+						Subtotal: 1580,
+					}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "copied M7 Chicken Masala, 174 Naan from @alice:matrix.org to your order at sangam, your subtotal is 15.80",
+			},
+		},
+		{
+			name: "should pass menu name",
+			msg:  fmt.Sprintf("%s same @alice:matrix.org sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				CopyOrderItemsFunc: func(
+					ctx context.Context,
+					currentUser, user *uuid.UUID,
+					roomID, menuName string,
+				) (*service.CopiedOrderItems, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrCopyingOrderItems, service.ErrNothingToCopy)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not copy order: could not copy order items: the user has no items in an open order"},
+			menuName: "sangam",
+		},
+		{
+			name:         "should report unknown user",
+			msg:          fmt.Sprintf("%s same @bob:matrix.org", DefaultPrefix),
+			orderService: &OrderServiceMock{},
+			matches:      true,
+			response:     &chat.Response{Msg: "could not copy order: user not found"},
+		},
+		{
+			name:    "should not match without user",
+			msg:     fmt.Sprintf("%s same", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := SameHandler{
+				OrderService: tc.orderService,
+				Accounts:     accounts,
+			}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				User:   &entity.User{UUID: &userUUID},
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+
+				if calls := tc.orderService.CopyOrderItemsCalls(); len(calls) > 0 {
+					assert.Equal(t, tc.menuName, calls[0].MenuName)
+				}
+			}
+		})
+	}
+}
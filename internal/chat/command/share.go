@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+var (
+	shareCommand   = newCommand("share <menu>")
+	unshareCommand = newCommand("unshare <menu>")
+)
+
+// ShareHandler makes the active order of a menu in the room visible in all
+// rooms and back.
+type ShareHandler struct {
+	OrderService OrderService
+}
+
+func (h *ShareHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, shareCommand, unshareCommand)
+}
+
+func (h *ShareHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not share order: %s", err)}
+	}
+
+	msg := req.Text
+
+	shared := true
+
+	args, ok := shareCommand.match(ctx, msg)
+	if !ok {
+		shared = false
+
+		if args, ok = unshareCommand.match(ctx, msg); !ok {
+			return usageResponse(ctx, shareCommand)
+		}
+	}
+
+	menuName := args.get("menu")
+
+	if _, err = h.OrderService.ShareOrder(ctx, currentUser.UUID, req.Room, menuName, shared); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not share order: %s", err)}
+	}
+
+	if shared {
+		return &chat.Response{Msg: translatef(ctx, "order %s is now shared with all rooms", menuName)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "order %s is now only visible in this room", menuName)}
+}
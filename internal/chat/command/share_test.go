@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestShare(t *testing.T) {
+	ctx := t.Context()
+
+	roomID := "!lunch:matrix.org"
+
+	shareOrder := func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string, shared bool) (*entity.Order, error) {
+		return &entity.Order{RoomID: roomID, Shared: shared}, nil
+	}
+
+	type testCase struct {
+		name         string
+		msg          string
+		orderService *OrderServiceMock
+		matches      bool
+		response     *chat.Response
+		shared       bool
+	}
+
+	testCases := []testCase{
+		{
+			name:         "should share order",
+			msg:          fmt.Sprintf("%s share sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{ShareOrderFunc: shareOrder},
+			matches:      true,
+			response:     &chat.Response{Msg: "order sangam is now shared with all rooms"},
+			shared:       true,
+		},
+		{
+			name:         "should stop sharing order",
+			msg:          fmt.Sprintf("%s unshare sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{ShareOrderFunc: shareOrder},
+			matches:      true,
+			response:     &chat.Response{Msg: "order sangam is now only visible in this room"},
+		},
+		{
+			name: "should report order of other room",
+			msg:  fmt.Sprintf("%s share sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				ShareOrderFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string, shared bool) (*entity.Order, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrSharingOrder, service.ErrOrderOfOtherRoom)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not share order: could not share order: the order was started in another room"},
+			shared:   true,
+		},
+		{
+			name:    "should not match share without menu",
+			msg:     fmt.Sprintf("%s share", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := ShareHandler{
+				OrderService: tc.orderService,
+			}
+
+			req := &chat.Request{
+				Sender: "@test:matrix.org",
+				User:   &entity.User{UUID: &userUUID},
+				Room:   roomID,
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+				assert.Equal(t, tc.response, resp)
+
+				if calls := tc.orderService.ShareOrderCalls(); assert.Len(t, calls, 1) {
+					assert.Equal(t, roomID, calls[0].RoomID)
+					assert.Equal(t, "sangam", calls[0].MenuName)
+					assert.Equal(t, tc.shared, calls[0].Shared)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+)
+
+var (
+	sshKeyAddCommand    = newCommand("ssh-key add <key...>")
+	sshKeyListCommand   = newCommand("ssh-key list")
+	sshKeyRemoveCommand = newCommand("ssh-key remove <fingerprint>")
+)
+
+type SSHKeyHandler struct {
+	UserService UserService
+}
+
+func (h *SSHKeyHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return matchesAny(ctx, msg, sshKeyAddCommand, sshKeyListCommand, sshKeyRemoveCommand)
+}
+
+func (h *SSHKeyHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not manage ssh keys: %s", err)}
+	}
+
+	msg := req.Text
+
+	if args, ok := sshKeyAddCommand.match(ctx, msg); ok {
+		return h.add(ctx, currentUser.UUID, args.get("key"))
+	}
+
+	if args, ok := sshKeyRemoveCommand.match(ctx, msg); ok {
+		return h.remove(ctx, currentUser.UUID, args.get("fingerprint"))
+	}
+
+	return h.list(ctx, currentUser.UUID)
+}
+
+func (h *SSHKeyHandler) add(ctx context.Context, currentUser *uuid.UUID, authorizedKey string) *chat.Response {
+	sshUser, err := h.UserService.AddPublicKey(ctx, currentUser, authorizedKey)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not add ssh key: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "added ssh key %s", crypto.PublicKeyFingerprint(sshUser.PublicKey))}
+}
+
+func (h *SSHKeyHandler) remove(ctx context.Context, currentUser *uuid.UUID, fingerprint string) *chat.Response {
+	sshUser, err := h.UserService.RemovePublicKey(ctx, currentUser, fingerprint)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not remove ssh key: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "removed ssh key %s", crypto.PublicKeyFingerprint(sshUser.PublicKey))}
+}
+
+func (h *SSHKeyHandler) list(ctx context.Context, currentUser *uuid.UUID) *chat.Response {
+	sshUsers, err := h.UserService.GetPublicKeys(ctx, currentUser)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not list ssh keys: %s", err)}
+	}
+
+	if len(sshUsers) == 0 {
+		return &chat.Response{Msg: translatef(ctx, "you have no ssh keys")}
+	}
+
+	var keys strings.Builder
+
+	keys.WriteString(translatef(ctx, "your ssh keys:"))
+
+	for _, sshUser := range sshUsers {
+		keyType, _, _ := strings.Cut(sshUser.PublicKey, " ")
+		fmt.Fprintf(&keys, "\n%s %s", crypto.PublicKeyFingerprint(sshUser.PublicKey), keyType)
+	}
+
+	return &chat.Response{Msg: keys.String()}
+}
@@ -0,0 +1,169 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+func TestSSHKey(t *testing.T) {
+	ctx := t.Context()
+
+	const (
+		publicKey   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAci+R8O9iOaqz1SJBhzxIwr0Y2EWAkS+YLRu4pMbZbY"
+		fingerprint = "SHA256:IxhKW/ndKFoB3PQkRVnHi74rF+z0VTI3P7CTZQZzgGY"
+	)
+
+	// only @test:matrix.org is registered
+	accountOf := func(sender string) *entity.User {
+		if sender != "@test:matrix.org" {
+			return nil
+		}
+
+		return &entity.User{UUID: &userUUID}
+	}
+
+	type testCase struct {
+		name        string
+		sender      string
+		msg         string
+		userService UserService
+		matches     bool
+		response    *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle ssh-key add command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ssh-key add %s jgero@nixps", DefaultPrefix, publicKey),
+			userService: &UserServiceMock{
+				AddPublicKeyFunc: func(ctx context.Context, user *uuid.UUID, authorizedKey string) (*entity.SSHUser, error) {
+					normalized, _, err := crypto.NormalizePublicKey(authorizedKey)
+					if err != nil {
+						return nil, err
+					}
+
+					return &entity.SSHUser{UserUUID: user, PublicKey: normalized}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "added ssh key " + fingerprint},
+		},
+		{
+			name:   "should handle ssh-key add command with key of another user",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ssh-key add %s", DefaultPrefix, publicKey),
+			userService: &UserServiceMock{
+				AddPublicKeyFunc: func(ctx context.Context, user *uuid.UUID, authorizedKey string) (*entity.SSHUser, error) {
+					return nil, fmt.Errorf("%w: %w", repository.ErrSettingPublicKey, repository.ErrPublicKeyInUse)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not add ssh key: setting public key for user: public key belongs to another user"},
+		},
+		{
+			name:   "should handle ssh-key add command with invalid key",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ssh-key add ssh-rsa nope", DefaultPrefix),
+			userService: &UserServiceMock{
+				AddPublicKeyFunc: func(ctx context.Context, user *uuid.UUID, authorizedKey string) (*entity.SSHUser, error) {
+					return nil, fmt.Errorf("%w: %w", service.ErrAddingPublicKey, crypto.ErrInvalidPublicKey)
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not add ssh key: could not add public key: invalid public key"},
+		},
+		{
+			name:   "should handle ssh-key list command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ssh-key list", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetPublicKeysFunc: func(ctx context.Context, user *uuid.UUID) ([]entity.SSHUser, error) {
+					return []entity.SSHUser{{UserUUID: user, PublicKey: publicKey}}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "your ssh keys:\n" + fingerprint + " ssh-ed25519"},
+		},
+		{
+			name:   "should handle ssh-key list command without keys",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ssh-key list", DefaultPrefix),
+			userService: &UserServiceMock{
+				GetPublicKeysFunc: func(ctx context.Context, user *uuid.UUID) ([]entity.SSHUser, error) {
+					return []entity.SSHUser{}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "you have no ssh keys"},
+		},
+		{
+			name:   "should handle ssh-key remove command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ssh-key remove %s", DefaultPrefix, fingerprint),
+			userService: &UserServiceMock{
+				RemovePublicKeyFunc: func(ctx context.Context, user *uuid.UUID, fingerprint string) (*entity.SSHUser, error) {
+					return &entity.SSHUser{UserUUID: user, PublicKey: publicKey}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "removed ssh key " + fingerprint},
+		},
+		{
+			name:        "should handle ssh-key commands from unregistered users",
+			sender:      "@unknown:matrix.org",
+			msg:         fmt.Sprintf("%s ssh-key list", DefaultPrefix),
+			userService: &UserServiceMock{},
+			matches:     true,
+			response:    &chat.Response{Msg: "could not manage ssh keys: user not found"},
+		},
+		{
+			name:    "should not match ssh-key add command without key",
+			msg:     fmt.Sprintf("%s ssh-key add", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match ssh-key remove command without fingerprint",
+			msg:     fmt.Sprintf("%s ssh-key remove", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := SSHKeyHandler{
+				UserService: tc.userService,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   accountOf(tc.sender),
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+var startCommand = newCommand("start <menu>")
+
+//go:generate go tool moq -rm -out order_service_mock.go . OrderService
+
+type OrderService interface {
+	GetAllOrders(ctx context.Context) ([]entity.Order, error)
+	GetOrder(ctx context.Context, uuid *uuid.UUID) (*entity.Order, error)
+	GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error)
+	GetActiveOrderByMenuName(ctx context.Context, roomID, name string) (*entity.Order, error)
+	CreateOrder(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error)
+	UpdateOrder(ctx context.Context, currentUser *uuid.UUID, uuid *uuid.UUID, order *entity.Order) (*entity.Order, error)
+	CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*entity.Order, error)
+	AddOrderItemsToOrderByName(
+		ctx context.Context,
+		currentUser *uuid.UUID,
+		roomID, menuName string,
+		items []string,
+	) ([]entity.OrderItem, error)
+	GetOrderHistory(ctx context.Context, roomID, menuName string, limit int) ([]entity.OrderSummary, error)
+	ReorderLast(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*service.Reorder, error)
+	SaveFavourite(ctx context.Context, currentUser *uuid.UUID, menuName, name string, items []string) (*entity.Favourite, error)
+	GetFavourites(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error)
+	DeleteFavourite(ctx context.Context, currentUser *uuid.UUID, menuName, name string) error
+	AddFavouriteToOrder(ctx context.Context, currentUser *uuid.UUID, roomID, menuName, name string) (*service.FavouriteOrder, error)
+	CopyOrderItems(ctx context.Context, currentUser, userUUID *uuid.UUID, roomID, menuName string) (*service.CopiedOrderItems, error)
+	ShareOrder(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string, shared bool) (*entity.Order, error)
+	GetOrderOverview(ctx context.Context, roomID, menuName string) (*service.OrderOverview, error)
+}
+
+type StartHandler struct {
+	OrderService OrderService
+}
+
+func (h *StartHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := startCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *StartHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not start order: %s", err)}
+	}
+
+	msg := req.Text
+
+	args, ok := startCommand.match(ctx, msg)
+	if !ok {
+		return usageResponse(ctx, startCommand)
+	}
+
+	menuName := args.get("menu")
+
+	order, err := h.OrderService.CreateOrderForMenuName(ctx, currentUser.UUID, req.Room, menuName)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not start order: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "started new order for %s (id: %s)", menuName, order.UUID.String())}
+}
@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+func TestStart(t *testing.T) {
+	ctx := t.Context()
+
+	userUUID := uuid.Must(uuid.NewV4())
+	orderUUID := uuid.Must(uuid.NewV4())
+
+	type testCase struct {
+		name         string
+		sender       string
+		user         *entity.User
+		msg          string
+		orderService OrderService
+		matches      bool
+		response     *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle start command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s start sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				CreateOrderForMenuNameFunc: func(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*entity.Order, error) {
+					if menuName != "sangam" {
+						return nil, repository.ErrMenuNotFound
+					}
+
+					return &entity.Order{UUID: &orderUUID}, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: fmt.Sprintf("started new order for sangam (id: %s)", orderUUID.String())},
+		},
+		{
+			name:     "should handle start command user not found error",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s start sangam", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not start order: user not found"},
+		},
+		{
+			name:    "should not match start command without prefix",
+			msg:     "start",
+			matches: false,
+		},
+		{
+			name:    "should not match start command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match start command without menu name",
+			msg:     fmt.Sprintf("%s start ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match start command without valid menu name",
+			msg:     fmt.Sprintf("%s start sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match start command with unquoted menu name containing spaces",
+			msg:     fmt.Sprintf("%s start Pizza Mühle", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match start command with arguments after quoted menu name",
+			msg:     fmt.Sprintf("%s start \"Pizza Mühle\" 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := StartHandler{
+				OrderService: tc.orderService,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				User:   tc.user,
+				Text:   tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+// stateTransitionCommands maps the commands to the state they set
+var stateTransitionCommands = map[*command]entity.OrderState{
+	newCommand("finalize <menu>"):  entity.Finalized,
+	newCommand("re-open <menu>"):   entity.Open,
+	newCommand("ordered <menu>"):   entity.Ordered,
+	newCommand("delivered <menu>"): entity.Delivered,
+}
+
+type StateTransitionHandler struct {
+	OrderService OrderService
+}
+
+func (h *StateTransitionHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, _, ok := matchStateTransition(ctx, msg)
+
+	return ok
+}
+
+func (h *StateTransitionHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	currentUser, err := senderAccount(req)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update order: %s", err)}
+	}
+
+	msg := req.Text
+
+	state, menuName, ok := matchStateTransition(ctx, msg)
+	if !ok {
+		return &chat.Response{Msg: translatef(ctx, "could not update order: no menu name provided")}
+	}
+
+	order, err := h.OrderService.GetActiveOrderByMenuName(ctx, req.Room, menuName)
+	if err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update order: %s", err)}
+	}
+
+	order.State = state
+
+	if _, err = h.OrderService.UpdateOrder(ctx, currentUser.UUID, order.UUID, order); err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not update order: %s", err)}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "successfully set state of order %s to %s", menuName, formatState(ctx, order.State))}
+}
+
+func matchStateTransition(ctx context.Context, msg string) (entity.OrderState, string, bool) {
+	for c, state := range stateTransitionCommands {
+		if args, ok := c.match(ctx, msg); ok {
+			return state, args.get("menu"), true
+		}
+	}
+
+	return "", "", false
+}
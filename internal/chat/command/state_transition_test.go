@@ -0,0 +1,404 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+var (
+	userUUID  = uuid.Must(uuid.NewV4())
+	orderUUID = uuid.Must(uuid.NewV4())
+)
+
+type testCase struct {
+	name         string
+	sender       string
+	user         *entity.User
+	msg          string
+	orderService OrderService
+	matches      bool
+	response     *chat.Response
+}
+
+func testStateTransition(tc *testCase, t *testing.T) {
+	ctx := t.Context()
+
+	t.Run(tc.name, func(t *testing.T) {
+		h := StateTransitionHandler{
+			OrderService: tc.orderService,
+		}
+
+		req := &chat.Request{
+			Sender: tc.sender,
+			User:   tc.user,
+			Text:   tc.msg,
+		}
+
+		matches := h.Matches(ctx, req)
+		assert.Equal(t, tc.matches, matches)
+
+		if matches {
+			resp := h.Handle(ctx, req)
+
+			if tc.response != nil {
+				assert.NotNil(t, resp)
+				assert.Equal(t, tc.response, resp)
+			} else {
+				assert.Nil(t, resp)
+			}
+		}
+	})
+}
+
+func TestFinalizeStateTransition(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:   "should handle finalize command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s finalize sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					if *uuidMoqParam != orderUUID {
+						return nil, repository.ErrOrderNotFound
+					}
+
+					if order.State != entity.Finalized {
+						return nil, service.ErrOrderStateTransitionInvalid
+					}
+
+					return order, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "successfully set state of order sangam to finalized"},
+		},
+		{
+			name:     "should handle finalize command user not found error",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s finalize sangam", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: user not found"},
+		},
+		{
+			name:   "should handle finalize command invalid state transition error",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s finalize sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					return nil, service.ErrOrderStateTransitionInvalid
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: invalid order state transition"},
+		},
+		{
+			name:    "should not match finalize command without prefix",
+			msg:     "finalize",
+			matches: false,
+		},
+		{
+			name:    "should not match finalize command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match finalize command without menu name",
+			msg:     fmt.Sprintf("%s finalize ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match finalize command without valid menu name",
+			msg:     fmt.Sprintf("%s finalize sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match finalize command with unquoted menu name containing spaces",
+			msg:     fmt.Sprintf("%s finalize Pizza Mühle", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match finalize command with arguments after quoted menu name",
+			msg:     fmt.Sprintf("%s finalize \"Pizza Mühle\" 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		testStateTransition(&tc, t)
+	}
+}
+
+func TestReOpenStateTransition(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:   "should handle re-open command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s re-open sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					if *uuidMoqParam != orderUUID {
+						return nil, repository.ErrUserNotFound
+					}
+
+					if order.State != entity.Open {
+						return nil, service.ErrOrderStateTransitionInvalid
+					}
+
+					return order, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "successfully set state of order sangam to open"},
+		},
+		{
+			name:     "should handle re-open command user not found error",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s re-open sangam", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: user not found"},
+		},
+		{
+			name:   "should handle re-open command invalid state transition error",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s re-open sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					return nil, service.ErrOrderStateTransitionInvalid
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: invalid order state transition"},
+		},
+		{
+			name:    "should not match re-open command without prefix",
+			msg:     "re-open",
+			matches: false,
+		},
+		{
+			name:    "should not match re-open command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match re-open command without menu name",
+			msg:     fmt.Sprintf("%s re-open ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match re-open command without valid menu name",
+			msg:     fmt.Sprintf("%s re-open sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match re-open command with unquoted menu name containing spaces",
+			msg:     fmt.Sprintf("%s re-open Pizza Mühle", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match re-open command with arguments after quoted menu name",
+			msg:     fmt.Sprintf("%s re-open \"Pizza Mühle\" 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		testStateTransition(&tc, t)
+	}
+}
+
+func TestOrderedStateTransition(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:   "should handle ordered command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ordered sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					if *uuidMoqParam != orderUUID {
+						return nil, repository.ErrOrderNotFound
+					}
+
+					if order.State != entity.Ordered {
+						return nil, service.ErrOrderStateTransitionInvalid
+					}
+
+					return order, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "successfully set state of order sangam to ordered"},
+		},
+		{
+			name:     "should handle ordered command user not found error",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s ordered sangam", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: user not found"},
+		},
+		{
+			name:   "should handle ordered command invalid state transition error",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s ordered sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					return nil, service.ErrOrderStateTransitionInvalid
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: invalid order state transition"},
+		},
+		{
+			name:    "should not match ordered command without prefix",
+			msg:     "ordered",
+			matches: false,
+		},
+		{
+			name:    "should not match ordered command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match ordered command without menu name",
+			msg:     fmt.Sprintf("%s ordered ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match ordered command without valid menu name",
+			msg:     fmt.Sprintf("%s ordered sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match ordered command with unquoted menu name containing spaces",
+			msg:     fmt.Sprintf("%s ordered Pizza Mühle", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match ordered command with arguments after quoted menu name",
+			msg:     fmt.Sprintf("%s ordered \"Pizza Mühle\" 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		testStateTransition(&tc, t)
+	}
+}
+
+func TestDeliveredStateTransition(t *testing.T) {
+	testCases := []testCase{
+		{
+			name:   "should handle delivered command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s delivered sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					if *uuidMoqParam != orderUUID {
+						return nil, repository.ErrOrderNotFound
+					}
+
+					if order.State != entity.Delivered {
+						return nil, service.ErrOrderStateTransitionInvalid
+					}
+
+					return order, nil
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "successfully set state of order sangam to delivered"},
+		},
+		{
+			name:     "should handle delivered command user not found error",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s delivered sangam", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: user not found"},
+		},
+		{
+			name:   "should handle delivered command invalid state transition error",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s delivered sangam", DefaultPrefix),
+			user:   &entity.User{UUID: &userUUID},
+			orderService: &OrderServiceMock{
+				GetActiveOrderByMenuNameFunc: func(ctx context.Context, roomID, name string) (*entity.Order, error) {
+					return &entity.Order{UUID: &orderUUID, State: entity.Open}, nil
+				},
+				UpdateOrderFunc: func(ctx context.Context, currentUser, uuidMoqParam *uuid.UUID, order *entity.Order) (*entity.Order, error) {
+					return nil, service.ErrOrderStateTransitionInvalid
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not update order: invalid order state transition"},
+		},
+		{
+			name:    "should not match delivered command without prefix",
+			msg:     "delivered",
+			matches: false,
+		},
+		{
+			name:    "should not match delivered command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match delivered command without menu name",
+			msg:     fmt.Sprintf("%s delivered ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match delivered command without valid menu name",
+			msg:     fmt.Sprintf("%s delivered sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match delivered command with unquoted menu name containing spaces",
+			msg:     fmt.Sprintf("%s delivered Pizza Mühle", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match delivered command with arguments after quoted menu name",
+			msg:     fmt.Sprintf("%s delivered \"Pizza Mühle\" 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		testStateTransition(&tc, t)
+	}
+}
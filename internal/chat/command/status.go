@@ -0,0 +1,38 @@
+package command
+
+import (
+	"context"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/templates"
+)
+
+var statusCommand = newCommand("status <menu>")
+
+type StatusHandler struct {
+	OrderService OrderService
+	Templates    *templates.Templates
+}
+
+func (h *StatusHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	_, ok := statusCommand.match(ctx, msg)
+
+	return ok
+}
+
+func (h *StatusHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	args, _ := statusCommand.match(ctx, msg)
+
+	return renderOverview(ctx, h.OrderService, h.Templates, templates.Status, req.Room, args.get("menu"))
+}
+
+// formatState names the state in the reply language, the states themselves
+// are the English names.
+func formatState(ctx context.Context, state entity.OrderState) string {
+	return translatef(ctx, state)
+}
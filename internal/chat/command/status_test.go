@@ -0,0 +1,220 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+	"github.com/Markus-Schwer/ordaa/internal/templates"
+)
+
+func TestStatus(t *testing.T) {
+	ctx := t.Context()
+
+	tmpl, err := templates.Load("")
+	require.NoError(t, err)
+
+	type testCase struct {
+		name         string
+		sender       string
+		msg          string
+		language     string
+		orderService OrderService
+		matches      bool
+		response     *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should handle status command",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s status sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					return overview(entity.Open, false), nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "sangam is open, started by Alice, paid by Bob\n\nAlice: 2x Butter Chicken (25.00)\nBob: 1x Naan (3.50)\n\ntotal: 28.50",
+				HTML: "<b>sangam</b> is open, started by Alice, paid by Bob\n" +
+					"<ul>\n<li>Alice: 2x Butter Chicken (25.00)</li>\n<li>Bob: 1x Naan (3.50)</li>\n</ul>\ntotal: 28.50",
+			},
+		},
+		{
+			name:   "should handle status command order not found error",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s status sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					return nil, repository.ErrOrderNotFound
+				},
+			},
+			matches:  true,
+			response: &chat.Response{Msg: "could not get status of order: order not found"},
+		},
+		{
+			name:   "should handle abbreviated status command with quoted menu name",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s stat 'Pizza Mühle'", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					assert.Equal(t, "Pizza Mühle", name)
+
+					return &service.OrderOverview{Order: entity.OrderSummary{
+						Order:    entity.Order{State: entity.Ordered},
+						MenuName: "Pizza Mühle",
+					}}, nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg:  "Pizza Mühle is ordered, started by a deleted user\n\nnothing ordered yet",
+				HTML: "<b>Pizza Mühle</b> is ordered, started by a deleted user<br>nothing ordered yet",
+			},
+		},
+		{
+			name:   "should look up order of room",
+			sender: "@test:matrix.org",
+			msg:    fmt.Sprintf("%s status sangam", DefaultPrefix),
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					assert.Equal(t, "!lunch:matrix.org", roomID)
+
+					return overview(entity.Finalized, true), nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "sangam is finalized, shared with all rooms, started by Alice, paid by Bob\n\n" +
+					"Alice: 2x Butter Chicken (25.00)\nBob: 1x Naan (3.50)\n\ntotal: 28.50",
+				HTML: "<b>sangam</b> is finalized, shared with all rooms, started by Alice, paid by Bob\n" +
+					"<ul>\n<li>Alice: 2x Butter Chicken (25.00)</li>\n<li>Bob: 1x Naan (3.50)</li>\n</ul>\ntotal: 28.50",
+			},
+		},
+		{
+			name:     "should reply in the language of the room",
+			sender:   "@test:matrix.org",
+			msg:      fmt.Sprintf("%s status sangam", DefaultPrefix),
+			language: "de",
+			orderService: &OrderServiceMock{
+				GetOrderOverviewFunc: func(ctx context.Context, roomID, name string) (*service.OrderOverview, error) {
+					return overview(entity.Open, false), nil
+				},
+			},
+			matches: true,
+			response: &chat.Response{
+				Msg: "sangam ist offen, gestartet von Alice, bezahlt von Bob\n\n" +
+					"Alice: 2x Butter Chicken (25,00)\nBob: 1x Naan (3,50)\n\ninsgesamt: 28,50",
+				HTML: "<b>sangam</b> ist offen, gestartet von Alice, bezahlt von Bob\n" +
+					"<ul>\n<li>Alice: 2x Butter Chicken (25,00)</li>\n<li>Bob: 1x Naan (3,50)</li>\n</ul>\ninsgesamt: 28,50",
+			},
+		},
+		{
+			name:    "should not match status command without prefix",
+			msg:     "status",
+			matches: false,
+		},
+		{
+			name:    "should not match status command from empty message",
+			msg:     DefaultPrefix,
+			matches: false,
+		},
+		{
+			name:    "should not match status command without menu name",
+			msg:     fmt.Sprintf("%s status ", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match status command without valid menu name",
+			msg:     fmt.Sprintf("%s status sangam 12345", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match status command with unquoted menu name containing spaces",
+			msg:     fmt.Sprintf("%s status Pizza Mühle", DefaultPrefix),
+			matches: false,
+		},
+		{
+			name:    "should not match status command with arguments after quoted menu name",
+			msg:     fmt.Sprintf("%s status \"Pizza Mühle\" 12345", DefaultPrefix),
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := StatusHandler{
+				OrderService: tc.orderService,
+				Templates:    tmpl,
+			}
+
+			req := &chat.Request{
+				Sender: tc.sender,
+				Room:   "!lunch:matrix.org",
+				Text:   tc.msg,
+			}
+
+			roomCtx := WithRoomSettings(ctx, &entity.RoomSettings{Language: tc.language})
+
+			matches := h.Matches(roomCtx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(roomCtx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
+
+// overview is an order of Alice and Bob, Bob is the sugar person and Alice
+// has paid one of her two dishes.
+func overview(state entity.OrderState, shared bool) *service.OrderOverview {
+	alice := uuid.Must(uuid.NewV4())
+	bob := uuid.Must(uuid.NewV4())
+
+	chicken := func(paid bool) entity.OrderItemSummary {
+		return entity.OrderItemSummary{
+			OrderItem: entity.OrderItem{Price: 1250, Paid: paid, User: &alice},
+			ShortName: "12",
+			Name:      "Butter Chicken",
+			UserName:  "Alice",
+		}
+	}
+
+	return &service.OrderOverview{
+		Order: entity.OrderSummary{
+			Order:           entity.Order{State: state, Shared: shared, SugarPerson: &bob},
+			MenuName:        "sangam",
+			InitiatorName:   "Alice",
+			SugarPersonName: "Bob",
+			Total:           2850,
+		},
+		MenuURL: "https://sangam.example.com",
+		Items: []entity.OrderItemSummary{
+			chicken(true),
+			chicken(false),
+			{
+				OrderItem: entity.OrderItem{Price: 350, User: &bob},
+				ShortName: "3",
+				Name:      "Naan",
+				UserName:  "Bob",
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+// UnrecognizedCommandHandler answers all commands no other handler took. If
+// the message names a command but its arguments do not fit, it replies with
+// the usage of that command.
+type UnrecognizedCommandHandler struct{}
+
+func (h *UnrecognizedCommandHandler) Matches(ctx context.Context, req *chat.Request) bool {
+	msg := req.Text
+
+	return IsCommand(ctx, msg)
+}
+
+func (h *UnrecognizedCommandHandler) Handle(ctx context.Context, req *chat.Request) *chat.Response {
+	msg := req.Text
+
+	line, _ := parseCommandLine(ctx, msg)
+
+	if line.err != nil {
+		return &chat.Response{Msg: translatef(ctx, "could not read command: %s", line.err)}
+	}
+
+	if c := findCommand(line.words); c != nil {
+		if _, err := c.bind(line.args); err != nil {
+			return &chat.Response{Msg: translatef(ctx, "%s, usage: %s", err, c.usageLine(ctx))}
+		}
+	}
+
+	if len(line.words) > 0 {
+		usages := []string{translatef(ctx, "usage:")}
+		for _, c := range commandsStartingWith(line.words) {
+			usages = append(usages, c.usageLine(ctx))
+		}
+
+		return &chat.Response{Msg: strings.Join(usages, "\n")}
+	}
+
+	return &chat.Response{Msg: translatef(ctx, "command not recognized: %s", msg)}
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/chat"
+)
+
+func TestUnrecognized(t *testing.T) {
+	ctx := t.Context()
+	h := UnrecognizedCommandHandler{}
+
+	type testCase struct {
+		name     string
+		msg      string
+		matches  bool
+		response *chat.Response
+	}
+
+	testCases := []testCase{
+		{
+			name:     "should handle empty command with prefix",
+			msg:      DefaultPrefix,
+			matches:  true,
+			response: &chat.Response{Msg: fmt.Sprintf("command not recognized: %s", DefaultPrefix)},
+		},
+		{
+			name:     "should handle any command with prefix",
+			msg:      fmt.Sprintf("%s asdf sadfk;", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: fmt.Sprintf("command not recognized: %s asdf sadfk;", DefaultPrefix)},
+		},
+		{
+			name:     "should reply with usage if arguments are missing",
+			msg:      fmt.Sprintf("%s start", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "missing argument: <menu>, usage: .ordaa start <menu>"},
+		},
+		{
+			name:     "should reply with usage if there are too many arguments",
+			msg:      fmt.Sprintf("%s fav list sangam pizza", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "unexpected argument: pizza, usage: .ordaa fav list <menu>"},
+		},
+		{
+			name:    "should list sub commands",
+			msg:     fmt.Sprintf("%s fav", DefaultPrefix),
+			matches: true,
+			response: &chat.Response{
+				Msg: "usage:\n.ordaa fav save <menu> <name> <items...>\n.ordaa fav list <menu>\n.ordaa fav delete <menu> <name>",
+			},
+		},
+		{
+			name:     "should report ambiguous abbreviation",
+			msg:      fmt.Sprintf("%s st sangam", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not read command: ambiguous command: 'st' could be start, status"},
+		},
+		{
+			name:     "should report unterminated quote",
+			msg:      fmt.Sprintf("%s start \"Pizza Mühle", DefaultPrefix),
+			matches:  true,
+			response: &chat.Response{Msg: "could not read command: missing closing quote: \"Pizza Mühle"},
+		},
+		{
+			name:    "should not match without prefix",
+			msg:     "asdf",
+			matches: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &chat.Request{
+				Text: tc.msg,
+			}
+
+			matches := h.Matches(ctx, req)
+			assert.Equal(t, tc.matches, matches)
+
+			if matches {
+				resp := h.Handle(ctx, req)
+
+				if tc.response != nil {
+					assert.NotNil(t, resp)
+					assert.Equal(t, tc.response, resp)
+				} else {
+					assert.Nil(t, resp)
+				}
+			}
+		})
+	}
+}
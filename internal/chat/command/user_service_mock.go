@@ -0,0 +1,1086 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package command
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+	"time"
+)
+
+// Ensure, that UserServiceMock does implement UserService.
+// If this is not the case, regenerate this file with moq.
+var _ UserService = &UserServiceMock{}
+
+// UserServiceMock is a mock implementation of UserService.
+//
+//	func TestSomethingThatUsesUserService(t *testing.T) {
+//
+//		// make and configure a mocked UserService
+//		mockedUserService := &UserServiceMock{
+//			AddPublicKeyFunc: func(ctx context.Context, userUUID *uuid.UUID, authorizedKey string) (*entity.SSHUser, error) {
+//				panic("mock out the AddPublicKey method")
+//			},
+//			CreateAPITokenFunc: func(ctx context.Context, userUUID *uuid.UUID, name string, scope entity.TokenScope, ttl time.Duration) (string, *entity.APIToken, error) {
+//				panic("mock out the CreateAPIToken method")
+//			},
+//			CreateLinkCodeFunc: func(ctx context.Context, userUUID *uuid.UUID) (*entity.LinkCode, error) {
+//				panic("mock out the CreateLinkCode method")
+//			},
+//			CreateUserFunc: func(ctx context.Context, user *entity.User) (*entity.User, error) {
+//				panic("mock out the CreateUser method")
+//			},
+//			DeleteUserFunc: func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID) error {
+//				panic("mock out the DeleteUser method")
+//			},
+//			ExportUserDataFunc: func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID) ([]byte, error) {
+//				panic("mock out the ExportUserData method")
+//			},
+//			GetAPITokensFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error) {
+//				panic("mock out the GetAPITokens method")
+//			},
+//			GetAllUsersFunc: func(ctx context.Context) ([]entity.User, error) {
+//				panic("mock out the GetAllUsers method")
+//			},
+//			GetPublicKeysFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error) {
+//				panic("mock out the GetPublicKeys method")
+//			},
+//			GetRolesFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+//				panic("mock out the GetRoles method")
+//			},
+//			GetUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//			GrantRoleFunc: func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, role entity.Role) error {
+//				panic("mock out the GrantRole method")
+//			},
+//			LinkUserFunc: func(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error) {
+//				panic("mock out the LinkUser method")
+//			},
+//			RemovePublicKeyFunc: func(ctx context.Context, userUUID *uuid.UUID, fingerprint string) (*entity.SSHUser, error) {
+//				panic("mock out the RemovePublicKey method")
+//			},
+//			RevokeAPITokenFunc: func(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error) {
+//				panic("mock out the RevokeAPIToken method")
+//			},
+//			RevokeRoleFunc: func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, role entity.Role) error {
+//				panic("mock out the RevokeRole method")
+//			},
+//			SetLanguageFunc: func(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error) {
+//				panic("mock out the SetLanguage method")
+//			},
+//			SetPasswordFunc: func(ctx context.Context, userUUID *uuid.UUID, username string, password string) (*entity.PasswordUser, error) {
+//				panic("mock out the SetPassword method")
+//			},
+//			UpdateUserFunc: func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, user *entity.User) (*entity.User, error) {
+//				panic("mock out the UpdateUser method")
+//			},
+//		}
+//
+//		// use mockedUserService in code that requires UserService
+//		// and then make assertions.
+//
+//	}
+type UserServiceMock struct {
+	// AddPublicKeyFunc mocks the AddPublicKey method.
+	AddPublicKeyFunc func(ctx context.Context, userUUID *uuid.UUID, authorizedKey string) (*entity.SSHUser, error)
+
+	// CreateAPITokenFunc mocks the CreateAPIToken method.
+	CreateAPITokenFunc func(ctx context.Context, userUUID *uuid.UUID, name string, scope entity.TokenScope, ttl time.Duration) (string, *entity.APIToken, error)
+
+	// CreateLinkCodeFunc mocks the CreateLinkCode method.
+	CreateLinkCodeFunc func(ctx context.Context, userUUID *uuid.UUID) (*entity.LinkCode, error)
+
+	// CreateUserFunc mocks the CreateUser method.
+	CreateUserFunc func(ctx context.Context, user *entity.User) (*entity.User, error)
+
+	// DeleteUserFunc mocks the DeleteUser method.
+	DeleteUserFunc func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID) error
+
+	// ExportUserDataFunc mocks the ExportUserData method.
+	ExportUserDataFunc func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID) ([]byte, error)
+
+	// GetAPITokensFunc mocks the GetAPITokens method.
+	GetAPITokensFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error)
+
+	// GetAllUsersFunc mocks the GetAllUsers method.
+	GetAllUsersFunc func(ctx context.Context) ([]entity.User, error)
+
+	// GetPublicKeysFunc mocks the GetPublicKeys method.
+	GetPublicKeysFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error)
+
+	// GetRolesFunc mocks the GetRoles method.
+	GetRolesFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error)
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error)
+
+	// GrantRoleFunc mocks the GrantRole method.
+	GrantRoleFunc func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, role entity.Role) error
+
+	// LinkUserFunc mocks the LinkUser method.
+	LinkUserFunc func(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error)
+
+	// RemovePublicKeyFunc mocks the RemovePublicKey method.
+	RemovePublicKeyFunc func(ctx context.Context, userUUID *uuid.UUID, fingerprint string) (*entity.SSHUser, error)
+
+	// RevokeAPITokenFunc mocks the RevokeAPIToken method.
+	RevokeAPITokenFunc func(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error)
+
+	// RevokeRoleFunc mocks the RevokeRole method.
+	RevokeRoleFunc func(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, role entity.Role) error
+
+	// SetLanguageFunc mocks the SetLanguage method.
+	SetLanguageFunc func(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error)
+
+	// SetPasswordFunc mocks the SetPassword method.
+	SetPasswordFunc func(ctx context.Context, userUUID *uuid.UUID, username string, password string) (*entity.PasswordUser, error)
+
+	// UpdateUserFunc mocks the UpdateUser method.
+	UpdateUserFunc func(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, user *entity.User) (*entity.User, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddPublicKey holds details about calls to the AddPublicKey method.
+		AddPublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// AuthorizedKey is the authorizedKey argument value.
+			AuthorizedKey string
+		}
+		// CreateAPIToken holds details about calls to the CreateAPIToken method.
+		CreateAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Name is the name argument value.
+			Name string
+			// Scope is the scope argument value.
+			Scope entity.TokenScope
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// CreateLinkCode holds details about calls to the CreateLinkCode method.
+		CreateLinkCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// CreateUser holds details about calls to the CreateUser method.
+		CreateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User *entity.User
+		}
+		// DeleteUser holds details about calls to the DeleteUser method.
+		DeleteUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// ExportUserData holds details about calls to the ExportUserData method.
+		ExportUserData []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetAPITokens holds details about calls to the GetAPITokens method.
+		GetAPITokens []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetAllUsers holds details about calls to the GetAllUsers method.
+		GetAllUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetPublicKeys holds details about calls to the GetPublicKeys method.
+		GetPublicKeys []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetRoles holds details about calls to the GetRoles method.
+		GetRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GrantRole holds details about calls to the GrantRole method.
+		GrantRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Role is the role argument value.
+			Role entity.Role
+		}
+		// LinkUser holds details about calls to the LinkUser method.
+		LinkUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// Code is the code argument value.
+			Code string
+		}
+		// RemovePublicKey holds details about calls to the RemovePublicKey method.
+		RemovePublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Fingerprint is the fingerprint argument value.
+			Fingerprint string
+		}
+		// RevokeAPIToken holds details about calls to the RevokeAPIToken method.
+		RevokeAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Name is the name argument value.
+			Name string
+		}
+		// RevokeRole holds details about calls to the RevokeRole method.
+		RevokeRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Role is the role argument value.
+			Role entity.Role
+		}
+		// SetLanguage holds details about calls to the SetLanguage method.
+		SetLanguage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Language is the language argument value.
+			Language string
+		}
+		// SetPassword holds details about calls to the SetPassword method.
+		SetPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Username is the username argument value.
+			Username string
+			// Password is the password argument value.
+			Password string
+		}
+		// UpdateUser holds details about calls to the UpdateUser method.
+		UpdateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CurrentUser is the currentUser argument value.
+			CurrentUser *uuid.UUID
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// User is the user argument value.
+			User *entity.User
+		}
+	}
+	lockAddPublicKey    sync.RWMutex
+	lockCreateAPIToken  sync.RWMutex
+	lockCreateLinkCode  sync.RWMutex
+	lockCreateUser      sync.RWMutex
+	lockDeleteUser      sync.RWMutex
+	lockExportUserData  sync.RWMutex
+	lockGetAPITokens    sync.RWMutex
+	lockGetAllUsers     sync.RWMutex
+	lockGetPublicKeys   sync.RWMutex
+	lockGetRoles        sync.RWMutex
+	lockGetUser         sync.RWMutex
+	lockGrantRole       sync.RWMutex
+	lockLinkUser        sync.RWMutex
+	lockRemovePublicKey sync.RWMutex
+	lockRevokeAPIToken  sync.RWMutex
+	lockRevokeRole      sync.RWMutex
+	lockSetLanguage     sync.RWMutex
+	lockSetPassword     sync.RWMutex
+	lockUpdateUser      sync.RWMutex
+}
+
+// AddPublicKey calls AddPublicKeyFunc.
+func (mock *UserServiceMock) AddPublicKey(ctx context.Context, userUUID *uuid.UUID, authorizedKey string) (*entity.SSHUser, error) {
+	if mock.AddPublicKeyFunc == nil {
+		panic("UserServiceMock.AddPublicKeyFunc: method is nil but UserService.AddPublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		UserUUID      *uuid.UUID
+		AuthorizedKey string
+	}{
+		Ctx:           ctx,
+		UserUUID:      userUUID,
+		AuthorizedKey: authorizedKey,
+	}
+	mock.lockAddPublicKey.Lock()
+	mock.calls.AddPublicKey = append(mock.calls.AddPublicKey, callInfo)
+	mock.lockAddPublicKey.Unlock()
+	return mock.AddPublicKeyFunc(ctx, userUUID, authorizedKey)
+}
+
+// AddPublicKeyCalls gets all the calls that were made to AddPublicKey.
+// Check the length with:
+//
+//	len(mockedUserService.AddPublicKeyCalls())
+func (mock *UserServiceMock) AddPublicKeyCalls() []struct {
+	Ctx           context.Context
+	UserUUID      *uuid.UUID
+	AuthorizedKey string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		UserUUID      *uuid.UUID
+		AuthorizedKey string
+	}
+	mock.lockAddPublicKey.RLock()
+	calls = mock.calls.AddPublicKey
+	mock.lockAddPublicKey.RUnlock()
+	return calls
+}
+
+// CreateAPIToken calls CreateAPITokenFunc.
+func (mock *UserServiceMock) CreateAPIToken(ctx context.Context, userUUID *uuid.UUID, name string, scope entity.TokenScope, ttl time.Duration) (string, *entity.APIToken, error) {
+	if mock.CreateAPITokenFunc == nil {
+		panic("UserServiceMock.CreateAPITokenFunc: method is nil but UserService.CreateAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Name     string
+		Scope    entity.TokenScope
+		TTL      time.Duration
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Name:     name,
+		Scope:    scope,
+		TTL:      ttl,
+	}
+	mock.lockCreateAPIToken.Lock()
+	mock.calls.CreateAPIToken = append(mock.calls.CreateAPIToken, callInfo)
+	mock.lockCreateAPIToken.Unlock()
+	return mock.CreateAPITokenFunc(ctx, userUUID, name, scope, ttl)
+}
+
+// CreateAPITokenCalls gets all the calls that were made to CreateAPIToken.
+// Check the length with:
+//
+//	len(mockedUserService.CreateAPITokenCalls())
+func (mock *UserServiceMock) CreateAPITokenCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Name     string
+	Scope    entity.TokenScope
+	TTL      time.Duration
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Name     string
+		Scope    entity.TokenScope
+		TTL      time.Duration
+	}
+	mock.lockCreateAPIToken.RLock()
+	calls = mock.calls.CreateAPIToken
+	mock.lockCreateAPIToken.RUnlock()
+	return calls
+}
+
+// CreateLinkCode calls CreateLinkCodeFunc.
+func (mock *UserServiceMock) CreateLinkCode(ctx context.Context, userUUID *uuid.UUID) (*entity.LinkCode, error) {
+	if mock.CreateLinkCodeFunc == nil {
+		panic("UserServiceMock.CreateLinkCodeFunc: method is nil but UserService.CreateLinkCode was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockCreateLinkCode.Lock()
+	mock.calls.CreateLinkCode = append(mock.calls.CreateLinkCode, callInfo)
+	mock.lockCreateLinkCode.Unlock()
+	return mock.CreateLinkCodeFunc(ctx, userUUID)
+}
+
+// CreateLinkCodeCalls gets all the calls that were made to CreateLinkCode.
+// Check the length with:
+//
+//	len(mockedUserService.CreateLinkCodeCalls())
+func (mock *UserServiceMock) CreateLinkCodeCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockCreateLinkCode.RLock()
+	calls = mock.calls.CreateLinkCode
+	mock.lockCreateLinkCode.RUnlock()
+	return calls
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *UserServiceMock) CreateUser(ctx context.Context, user *entity.User) (*entity.User, error) {
+	if mock.CreateUserFunc == nil {
+		panic("UserServiceMock.CreateUserFunc: method is nil but UserService.CreateUser was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		User *entity.User
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockCreateUser.Lock()
+	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
+	mock.lockCreateUser.Unlock()
+	return mock.CreateUserFunc(ctx, user)
+}
+
+// CreateUserCalls gets all the calls that were made to CreateUser.
+// Check the length with:
+//
+//	len(mockedUserService.CreateUserCalls())
+func (mock *UserServiceMock) CreateUserCalls() []struct {
+	Ctx  context.Context
+	User *entity.User
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User *entity.User
+	}
+	mock.lockCreateUser.RLock()
+	calls = mock.calls.CreateUser
+	mock.lockCreateUser.RUnlock()
+	return calls
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *UserServiceMock) DeleteUser(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID) error {
+	if mock.DeleteUserFunc == nil {
+		panic("UserServiceMock.DeleteUserFunc: method is nil but UserService.DeleteUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CurrentUser  *uuid.UUID
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		CurrentUser:  currentUser,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockDeleteUser.Lock()
+	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
+	mock.lockDeleteUser.Unlock()
+	return mock.DeleteUserFunc(ctx, currentUser, uuidMoqParam)
+}
+
+// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// Check the length with:
+//
+//	len(mockedUserService.DeleteUserCalls())
+func (mock *UserServiceMock) DeleteUserCalls() []struct {
+	Ctx          context.Context
+	CurrentUser  *uuid.UUID
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CurrentUser  *uuid.UUID
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockDeleteUser.RLock()
+	calls = mock.calls.DeleteUser
+	mock.lockDeleteUser.RUnlock()
+	return calls
+}
+
+// ExportUserData calls ExportUserDataFunc.
+func (mock *UserServiceMock) ExportUserData(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID) ([]byte, error) {
+	if mock.ExportUserDataFunc == nil {
+		panic("UserServiceMock.ExportUserDataFunc: method is nil but UserService.ExportUserData was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		UserUUID:    userUUID,
+	}
+	mock.lockExportUserData.Lock()
+	mock.calls.ExportUserData = append(mock.calls.ExportUserData, callInfo)
+	mock.lockExportUserData.Unlock()
+	return mock.ExportUserDataFunc(ctx, currentUser, userUUID)
+}
+
+// ExportUserDataCalls gets all the calls that were made to ExportUserData.
+// Check the length with:
+//
+//	len(mockedUserService.ExportUserDataCalls())
+func (mock *UserServiceMock) ExportUserDataCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	UserUUID    *uuid.UUID
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+	}
+	mock.lockExportUserData.RLock()
+	calls = mock.calls.ExportUserData
+	mock.lockExportUserData.RUnlock()
+	return calls
+}
+
+// GetAPITokens calls GetAPITokensFunc.
+func (mock *UserServiceMock) GetAPITokens(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error) {
+	if mock.GetAPITokensFunc == nil {
+		panic("UserServiceMock.GetAPITokensFunc: method is nil but UserService.GetAPITokens was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetAPITokens.Lock()
+	mock.calls.GetAPITokens = append(mock.calls.GetAPITokens, callInfo)
+	mock.lockGetAPITokens.Unlock()
+	return mock.GetAPITokensFunc(ctx, userUUID)
+}
+
+// GetAPITokensCalls gets all the calls that were made to GetAPITokens.
+// Check the length with:
+//
+//	len(mockedUserService.GetAPITokensCalls())
+func (mock *UserServiceMock) GetAPITokensCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetAPITokens.RLock()
+	calls = mock.calls.GetAPITokens
+	mock.lockGetAPITokens.RUnlock()
+	return calls
+}
+
+// GetAllUsers calls GetAllUsersFunc.
+func (mock *UserServiceMock) GetAllUsers(ctx context.Context) ([]entity.User, error) {
+	if mock.GetAllUsersFunc == nil {
+		panic("UserServiceMock.GetAllUsersFunc: method is nil but UserService.GetAllUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllUsers.Lock()
+	mock.calls.GetAllUsers = append(mock.calls.GetAllUsers, callInfo)
+	mock.lockGetAllUsers.Unlock()
+	return mock.GetAllUsersFunc(ctx)
+}
+
+// GetAllUsersCalls gets all the calls that were made to GetAllUsers.
+// Check the length with:
+//
+//	len(mockedUserService.GetAllUsersCalls())
+func (mock *UserServiceMock) GetAllUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllUsers.RLock()
+	calls = mock.calls.GetAllUsers
+	mock.lockGetAllUsers.RUnlock()
+	return calls
+}
+
+// GetPublicKeys calls GetPublicKeysFunc.
+func (mock *UserServiceMock) GetPublicKeys(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error) {
+	if mock.GetPublicKeysFunc == nil {
+		panic("UserServiceMock.GetPublicKeysFunc: method is nil but UserService.GetPublicKeys was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetPublicKeys.Lock()
+	mock.calls.GetPublicKeys = append(mock.calls.GetPublicKeys, callInfo)
+	mock.lockGetPublicKeys.Unlock()
+	return mock.GetPublicKeysFunc(ctx, userUUID)
+}
+
+// GetPublicKeysCalls gets all the calls that were made to GetPublicKeys.
+// Check the length with:
+//
+//	len(mockedUserService.GetPublicKeysCalls())
+func (mock *UserServiceMock) GetPublicKeysCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetPublicKeys.RLock()
+	calls = mock.calls.GetPublicKeys
+	mock.lockGetPublicKeys.RUnlock()
+	return calls
+}
+
+// GetRoles calls GetRolesFunc.
+func (mock *UserServiceMock) GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+	if mock.GetRolesFunc == nil {
+		panic("UserServiceMock.GetRolesFunc: method is nil but UserService.GetRoles was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetRoles.Lock()
+	mock.calls.GetRoles = append(mock.calls.GetRoles, callInfo)
+	mock.lockGetRoles.Unlock()
+	return mock.GetRolesFunc(ctx, userUUID)
+}
+
+// GetRolesCalls gets all the calls that were made to GetRoles.
+// Check the length with:
+//
+//	len(mockedUserService.GetRolesCalls())
+func (mock *UserServiceMock) GetRolesCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetRoles.RLock()
+	calls = mock.calls.GetRoles
+	mock.lockGetRoles.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *UserServiceMock) GetUser(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("UserServiceMock.GetUserFunc: method is nil but UserService.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, uuidMoqParam)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedUserService.GetUserCalls())
+func (mock *UserServiceMock) GetUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// GrantRole calls GrantRoleFunc.
+func (mock *UserServiceMock) GrantRole(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, role entity.Role) error {
+	if mock.GrantRoleFunc == nil {
+		panic("UserServiceMock.GrantRoleFunc: method is nil but UserService.GrantRole was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+		Role        entity.Role
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		UserUUID:    userUUID,
+		Role:        role,
+	}
+	mock.lockGrantRole.Lock()
+	mock.calls.GrantRole = append(mock.calls.GrantRole, callInfo)
+	mock.lockGrantRole.Unlock()
+	return mock.GrantRoleFunc(ctx, currentUser, userUUID, role)
+}
+
+// GrantRoleCalls gets all the calls that were made to GrantRole.
+// Check the length with:
+//
+//	len(mockedUserService.GrantRoleCalls())
+func (mock *UserServiceMock) GrantRoleCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	UserUUID    *uuid.UUID
+	Role        entity.Role
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+		Role        entity.Role
+	}
+	mock.lockGrantRole.RLock()
+	calls = mock.calls.GrantRole
+	mock.lockGrantRole.RUnlock()
+	return calls
+}
+
+// LinkUser calls LinkUserFunc.
+func (mock *UserServiceMock) LinkUser(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error) {
+	if mock.LinkUserFunc == nil {
+		panic("UserServiceMock.LinkUserFunc: method is nil but UserService.LinkUser was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Code        string
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		Code:        code,
+	}
+	mock.lockLinkUser.Lock()
+	mock.calls.LinkUser = append(mock.calls.LinkUser, callInfo)
+	mock.lockLinkUser.Unlock()
+	return mock.LinkUserFunc(ctx, currentUser, code)
+}
+
+// LinkUserCalls gets all the calls that were made to LinkUser.
+// Check the length with:
+//
+//	len(mockedUserService.LinkUserCalls())
+func (mock *UserServiceMock) LinkUserCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	Code        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		Code        string
+	}
+	mock.lockLinkUser.RLock()
+	calls = mock.calls.LinkUser
+	mock.lockLinkUser.RUnlock()
+	return calls
+}
+
+// RemovePublicKey calls RemovePublicKeyFunc.
+func (mock *UserServiceMock) RemovePublicKey(ctx context.Context, userUUID *uuid.UUID, fingerprint string) (*entity.SSHUser, error) {
+	if mock.RemovePublicKeyFunc == nil {
+		panic("UserServiceMock.RemovePublicKeyFunc: method is nil but UserService.RemovePublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		UserUUID    *uuid.UUID
+		Fingerprint string
+	}{
+		Ctx:         ctx,
+		UserUUID:    userUUID,
+		Fingerprint: fingerprint,
+	}
+	mock.lockRemovePublicKey.Lock()
+	mock.calls.RemovePublicKey = append(mock.calls.RemovePublicKey, callInfo)
+	mock.lockRemovePublicKey.Unlock()
+	return mock.RemovePublicKeyFunc(ctx, userUUID, fingerprint)
+}
+
+// RemovePublicKeyCalls gets all the calls that were made to RemovePublicKey.
+// Check the length with:
+//
+//	len(mockedUserService.RemovePublicKeyCalls())
+func (mock *UserServiceMock) RemovePublicKeyCalls() []struct {
+	Ctx         context.Context
+	UserUUID    *uuid.UUID
+	Fingerprint string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		UserUUID    *uuid.UUID
+		Fingerprint string
+	}
+	mock.lockRemovePublicKey.RLock()
+	calls = mock.calls.RemovePublicKey
+	mock.lockRemovePublicKey.RUnlock()
+	return calls
+}
+
+// RevokeAPIToken calls RevokeAPITokenFunc.
+func (mock *UserServiceMock) RevokeAPIToken(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error) {
+	if mock.RevokeAPITokenFunc == nil {
+		panic("UserServiceMock.RevokeAPITokenFunc: method is nil but UserService.RevokeAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Name     string
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Name:     name,
+	}
+	mock.lockRevokeAPIToken.Lock()
+	mock.calls.RevokeAPIToken = append(mock.calls.RevokeAPIToken, callInfo)
+	mock.lockRevokeAPIToken.Unlock()
+	return mock.RevokeAPITokenFunc(ctx, userUUID, name)
+}
+
+// RevokeAPITokenCalls gets all the calls that were made to RevokeAPIToken.
+// Check the length with:
+//
+//	len(mockedUserService.RevokeAPITokenCalls())
+func (mock *UserServiceMock) RevokeAPITokenCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Name     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Name     string
+	}
+	mock.lockRevokeAPIToken.RLock()
+	calls = mock.calls.RevokeAPIToken
+	mock.lockRevokeAPIToken.RUnlock()
+	return calls
+}
+
+// RevokeRole calls RevokeRoleFunc.
+func (mock *UserServiceMock) RevokeRole(ctx context.Context, currentUser *uuid.UUID, userUUID *uuid.UUID, role entity.Role) error {
+	if mock.RevokeRoleFunc == nil {
+		panic("UserServiceMock.RevokeRoleFunc: method is nil but UserService.RevokeRole was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+		Role        entity.Role
+	}{
+		Ctx:         ctx,
+		CurrentUser: currentUser,
+		UserUUID:    userUUID,
+		Role:        role,
+	}
+	mock.lockRevokeRole.Lock()
+	mock.calls.RevokeRole = append(mock.calls.RevokeRole, callInfo)
+	mock.lockRevokeRole.Unlock()
+	return mock.RevokeRoleFunc(ctx, currentUser, userUUID, role)
+}
+
+// RevokeRoleCalls gets all the calls that were made to RevokeRole.
+// Check the length with:
+//
+//	len(mockedUserService.RevokeRoleCalls())
+func (mock *UserServiceMock) RevokeRoleCalls() []struct {
+	Ctx         context.Context
+	CurrentUser *uuid.UUID
+	UserUUID    *uuid.UUID
+	Role        entity.Role
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CurrentUser *uuid.UUID
+		UserUUID    *uuid.UUID
+		Role        entity.Role
+	}
+	mock.lockRevokeRole.RLock()
+	calls = mock.calls.RevokeRole
+	mock.lockRevokeRole.RUnlock()
+	return calls
+}
+
+// SetLanguage calls SetLanguageFunc.
+func (mock *UserServiceMock) SetLanguage(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error) {
+	if mock.SetLanguageFunc == nil {
+		panic("UserServiceMock.SetLanguageFunc: method is nil but UserService.SetLanguage was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Language string
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Language: language,
+	}
+	mock.lockSetLanguage.Lock()
+	mock.calls.SetLanguage = append(mock.calls.SetLanguage, callInfo)
+	mock.lockSetLanguage.Unlock()
+	return mock.SetLanguageFunc(ctx, userUUID, language)
+}
+
+// SetLanguageCalls gets all the calls that were made to SetLanguage.
+// Check the length with:
+//
+//	len(mockedUserService.SetLanguageCalls())
+func (mock *UserServiceMock) SetLanguageCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Language string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Language string
+	}
+	mock.lockSetLanguage.RLock()
+	calls = mock.calls.SetLanguage
+	mock.lockSetLanguage.RUnlock()
+	return calls
+}
+
+// SetPassword calls SetPasswordFunc.
+func (mock *UserServiceMock) SetPassword(ctx context.Context, userUUID *uuid.UUID, username string, password string) (*entity.PasswordUser, error) {
+	if mock.SetPasswordFunc == nil {
+		panic("UserServiceMock.SetPasswordFunc: method is nil but UserService.SetPassword was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Username string
+		Password string
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Username: username,
+		Password: password,
+	}
+	mock.lockSetPassword.Lock()
+	mock.calls.SetPassword = append(mock.calls.SetPassword, callInfo)
+	mock.lockSetPassword.Unlock()
+	return mock.SetPasswordFunc(ctx, userUUID, username, password)
+}
+
+// SetPasswordCalls gets all the calls that were made to SetPassword.
+// Check the length with:
+//
+//	len(mockedUserService.SetPasswordCalls())
+func (mock *UserServiceMock) SetPasswordCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Username string
+	Password string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Username string
+		Password string
+	}
+	mock.lockSetPassword.RLock()
+	calls = mock.calls.SetPassword
+	mock.lockSetPassword.RUnlock()
+	return calls
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (mock *UserServiceMock) UpdateUser(ctx context.Context, currentUser *uuid.UUID, uuidMoqParam *uuid.UUID, user *entity.User) (*entity.User, error) {
+	if mock.UpdateUserFunc == nil {
+		panic("UserServiceMock.UpdateUserFunc: method is nil but UserService.UpdateUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CurrentUser  *uuid.UUID
+		UuidMoqParam *uuid.UUID
+		User         *entity.User
+	}{
+		Ctx:          ctx,
+		CurrentUser:  currentUser,
+		UuidMoqParam: uuidMoqParam,
+		User:         user,
+	}
+	mock.lockUpdateUser.Lock()
+	mock.calls.UpdateUser = append(mock.calls.UpdateUser, callInfo)
+	mock.lockUpdateUser.Unlock()
+	return mock.UpdateUserFunc(ctx, currentUser, uuidMoqParam, user)
+}
+
+// UpdateUserCalls gets all the calls that were made to UpdateUser.
+// Check the length with:
+//
+//	len(mockedUserService.UpdateUserCalls())
+func (mock *UserServiceMock) UpdateUserCalls() []struct {
+	Ctx          context.Context
+	CurrentUser  *uuid.UUID
+	UuidMoqParam *uuid.UUID
+	User         *entity.User
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CurrentUser  *uuid.UUID
+		UuidMoqParam *uuid.UUID
+		User         *entity.User
+	}
+	mock.lockUpdateUser.RLock()
+	calls = mock.calls.UpdateUser
+	mock.lockUpdateUser.RUnlock()
+	return calls
+}
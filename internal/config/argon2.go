@@ -0,0 +1,68 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+)
+
+const (
+	// minArgon2Length is the minimum key and salt length in bytes
+	minArgon2Length = 16
+	// minArgon2MemoryPerThread is the minimum memory in KiB argon2 needs per
+	// thread
+	minArgon2MemoryPerThread = 8
+)
+
+var (
+	ErrInvalidArgon2Time       = errors.New("ARGON2_TIME must be at least 1")
+	ErrInvalidArgon2Threads    = errors.New("ARGON2_THREADS must be at least 1")
+	ErrInvalidArgon2Memory     = errors.New("ARGON2_MEMORY must be at least 8 KiB per thread")
+	ErrInvalidArgon2KeyLength  = errors.New("ARGON2_KEY_LENGTH must be at least 16")
+	ErrInvalidArgon2SaltLength = errors.New("ARGON2_SALT_LENGTH must be at least 16")
+)
+
+// Argon2Config holds the argon2id parameters for new password hashes.
+// Existing hashes keep working and are rehashed on the next login.
+type Argon2Config struct {
+	Time uint32 `env:"TIME" envDefault:"1"`
+	// Memory in KiB
+	Memory     uint32 `env:"MEMORY" envDefault:"65536"`
+	Threads    uint8  `env:"THREADS" envDefault:"2"`
+	KeyLength  uint32 `env:"KEY_LENGTH" envDefault:"32"`
+	SaltLength uint32 `env:"SALT_LENGTH" envDefault:"16"`
+}
+
+func LoadArgon2Config() (*Argon2Config, error) {
+	var cfg Argon2Config
+	if err := env.ParseWithOptions(&cfg, env.Options{
+		Prefix: "ARGON2_",
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid argon2 config: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// validate rejects parameters argon2 panics on or that make hashes useless.
+func (cfg *Argon2Config) validate() error {
+	switch {
+	case cfg.Time < 1:
+		return ErrInvalidArgon2Time
+	case cfg.Threads < 1:
+		return ErrInvalidArgon2Threads
+	case cfg.Memory < minArgon2MemoryPerThread*uint32(cfg.Threads):
+		return ErrInvalidArgon2Memory
+	case cfg.KeyLength < minArgon2Length:
+		return ErrInvalidArgon2KeyLength
+	case cfg.SaltLength < minArgon2Length:
+		return ErrInvalidArgon2SaltLength
+	}
+
+	return nil
+}
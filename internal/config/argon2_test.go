@@ -0,0 +1,84 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadArgon2Config(t *testing.T) {
+	type testCase struct {
+		name string
+		env  map[string]string
+		err  error
+	}
+
+	testCases := []testCase{
+		{
+			name: "defaults",
+		},
+		{
+			name: "minimal values",
+			env: map[string]string{
+				"ARGON2_TIME":        "1",
+				"ARGON2_MEMORY":      "32",
+				"ARGON2_THREADS":     "4",
+				"ARGON2_KEY_LENGTH":  "16",
+				"ARGON2_SALT_LENGTH": "16",
+			},
+		},
+		{
+			name: "zero time",
+			env:  map[string]string{"ARGON2_TIME": "0"},
+			err:  ErrInvalidArgon2Time,
+		},
+		{
+			name: "zero threads",
+			env:  map[string]string{"ARGON2_THREADS": "0"},
+			err:  ErrInvalidArgon2Threads,
+		},
+		{
+			name: "less than 8 KiB memory per thread",
+			env:  map[string]string{"ARGON2_MEMORY": "31", "ARGON2_THREADS": "4"},
+			err:  ErrInvalidArgon2Memory,
+		},
+		{
+			name: "zero key length",
+			env:  map[string]string{"ARGON2_KEY_LENGTH": "0"},
+			err:  ErrInvalidArgon2KeyLength,
+		},
+		{
+			name: "short key",
+			env:  map[string]string{"ARGON2_KEY_LENGTH": "15"},
+			err:  ErrInvalidArgon2KeyLength,
+		},
+		{
+			name: "zero salt length",
+			env:  map[string]string{"ARGON2_SALT_LENGTH": "0"},
+			err:  ErrInvalidArgon2SaltLength,
+		},
+		{
+			name: "short salt",
+			env:  map[string]string{"ARGON2_SALT_LENGTH": "8"},
+			err:  ErrInvalidArgon2SaltLength,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			cfg, err := LoadArgon2Config()
+
+			assert.ErrorIs(t, err, tc.err)
+
+			if tc.err == nil {
+				assert.NotNil(t, cfg)
+			} else {
+				assert.Nil(t, cfg)
+			}
+		})
+	}
+}
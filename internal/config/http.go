@@ -0,0 +1,23 @@
+package config
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type HTTPConfig struct {
+	// Address the http api listens on, the api is disabled when it is empty.
+	Address   string        `env:"ADDRESS"`
+	JWTSecret string        `env:"JWT_SECRET"`
+	JWTTTL    time.Duration `env:"JWT_TTL" envDefault:"24h"`
+}
+
+func LoadHTTPConfig() (*HTTPConfig, error) {
+	var cfg HTTPConfig
+	if err := env.Parse(&cfg); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
@@ -7,7 +7,12 @@ type MatrixConfig struct {
 	Username      string   `env:"USERNAME"`
 	Password      string   `env:"PASSWORD"`
 	Rooms         []string `env:"ROOMS"`
-	DisplayName   string   `env:"DISPLAY_NAME" envDefault:"Chicken Masalla legende Wollmilchsau [BOT]"`
+	Admins        []string `env:"ADMINS"`
+	// AutoRegister creates accounts for unknown senders on their first command.
+	AutoRegister bool   `env:"AUTO_REGISTER"`
+	DisplayName  string `env:"DISPLAY_NAME" envDefault:"Chicken Masalla legende Wollmilchsau [BOT]"`
+	// TemplateDir holds templates replacing the built in ones, see package templates.
+	TemplateDir string `env:"TEMPLATE_DIR"`
 }
 
 func LoadMatrixConfig() (*MatrixConfig, error) {
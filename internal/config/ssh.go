@@ -0,0 +1,20 @@
+package config
+
+import "github.com/caarlos0/env/v11"
+
+type SSHConfig struct {
+	Enabled     bool   `env:"ENABLED"`
+	Address     string `env:"ADDRESS" envDefault:"localhost:23234"`
+	HostKeyPath string `env:"HOST_KEY_PATH" envDefault:".ssh/id_ed25519"`
+}
+
+func LoadSSHConfig() (*SSHConfig, error) {
+	var cfg SSHConfig
+	if err := env.ParseWithOptions(&cfg, env.Options{
+		Prefix: "SSH_",
+	}); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
@@ -50,13 +50,15 @@ func NewArgon2idHash(time, saltLen, memory uint32, threads uint8, keyLen uint32)
 	}
 }
 
+// NewDefaultArgon2idHash returns the parameters used when none are
+// configured. They match the defaults of config.Argon2Config.
 func NewDefaultArgon2idHash() *Argon2idHash {
 	const (
 		defaultTime    = 1
-		defaultSaltLen = 32
+		defaultSaltLen = 16
 		defaultMemory  = 64 * 1024
-		defaultThreads = 32
-		defaultKeyLen  = 256
+		defaultThreads = 2
+		defaultKeyLen  = 32
 	)
 
 	return &Argon2idHash{
@@ -68,6 +70,20 @@ func NewDefaultArgon2idHash() *Argon2idHash {
 	}
 }
 
+// Time returns the number of passes over the memory.
+func (a *Argon2idHash) Time() uint32 {
+	return a.time
+}
+
+// Memory returns the memory in KiB.
+func (a *Argon2idHash) Memory() uint32 {
+	return a.memory
+}
+
+func (a *Argon2idHash) Threads() uint8 {
+	return a.threads
+}
+
 func randomSecret(length uint32) ([]byte, error) {
 	secret := make([]byte, length)
 
@@ -100,14 +116,17 @@ func (a *Argon2idHash) generateHash(password, salt []byte) (*HashSalt, error) {
 }
 
 func GeneratePasswordHash(password string) (string, error) {
-	argon2IDHash := NewDefaultArgon2idHash()
+	return NewDefaultArgon2idHash().GeneratePasswordHash(password)
+}
 
-	salt, err := randomSecret(argon2IDHash.saltLen)
+// GeneratePasswordHash hashes the password with the parameters of a.
+func (a *Argon2idHash) GeneratePasswordHash(password string) (string, error) {
+	salt, err := randomSecret(a.saltLen)
 	if err != nil {
 		return "", err
 	}
 
-	hashSalt, err := argon2IDHash.generateHash([]byte(password), salt)
+	hashSalt, err := a.generateHash([]byte(password), salt)
 	if err != nil {
 		return "", err
 	}
@@ -120,9 +139,9 @@ func GeneratePasswordHash(password string) (string, error) {
 	encodedHash := fmt.Sprintf(
 		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
 		argon2.Version,
-		argon2IDHash.memory,
-		argon2IDHash.time,
-		argon2IDHash.threads,
+		a.memory,
+		a.time,
+		a.threads,
 		b64Salt,
 		b64Hash,
 	)
@@ -130,6 +149,17 @@ func GeneratePasswordHash(password string) (string, error) {
 	return encodedHash, nil
 }
 
+// NeedsRehash reports whether the encoded hash was created with parameters
+// other than the ones of a, e.g. before they were changed in the config.
+func (a *Argon2idHash) NeedsRehash(encodedHash string) bool {
+	stored, _, err := decodeHash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return *stored != *a
+}
+
 func ComparePasswordAndHash(password, encodedHash string) (bool, error) {
 	// Extract the parameters, salt and derived key from the encoded password
 	// hash.
@@ -0,0 +1,44 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// NormalizePublicKey parses a line of an authorized_keys file and returns the
+// key without options and comment, which is the form keys are stored in, and
+// its SHA256 fingerprint.
+func NormalizePublicKey(authorizedKey string) (string, string, error) {
+	key, _, _, rest, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(authorizedKey)))
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", ErrInvalidPublicKey, err)
+	}
+
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return "", "", fmt.Errorf("%w: only one key is allowed", ErrInvalidPublicKey)
+	}
+
+	return FormatPublicKey(key), ssh.FingerprintSHA256(key), nil
+}
+
+// FormatPublicKey formats the key like a line of authorized_keys without the
+// comment.
+func FormatPublicKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
+
+// PublicKeyFingerprint returns the SHA256 fingerprint of a key stored by
+// NormalizePublicKey, or an empty string if the key cannot be parsed.
+func PublicKeyFingerprint(publicKey string) string {
+	_, fingerprint, err := NormalizePublicKey(publicKey)
+	if err != nil {
+		return ""
+	}
+
+	return fingerprint
+}
@@ -0,0 +1,63 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePublicKey(t *testing.T) {
+	const (
+		ed25519Key  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAci+R8O9iOaqz1SJBhzxIwr0Y2EWAkS+YLRu4pMbZbY"
+		fingerprint = "SHA256:IxhKW/ndKFoB3PQkRVnHi74rF+z0VTI3P7CTZQZzgGY"
+	)
+
+	type testCase struct {
+		name        string
+		key         string
+		normalized  string
+		fingerprint string
+		err         error
+	}
+
+	testCases := []testCase{
+		{
+			name:        "should keep normalized key",
+			key:         ed25519Key,
+			normalized:  ed25519Key,
+			fingerprint: fingerprint,
+		},
+		{
+			name:        "should strip comment and whitespace",
+			key:         "  " + ed25519Key + " jgero@nixps\n",
+			normalized:  ed25519Key,
+			fingerprint: fingerprint,
+		},
+		{
+			name:        "should strip options",
+			key:         `no-agent-forwarding,command="echo hi" ` + ed25519Key + " jgero@nixps",
+			normalized:  ed25519Key,
+			fingerprint: fingerprint,
+		},
+		{
+			name: "should reject invalid key",
+			key:  "ssh-ed25519 not-base64",
+			err:  ErrInvalidPublicKey,
+		},
+		{
+			name: "should reject several keys",
+			key:  ed25519Key + "\n" + ed25519Key,
+			err:  ErrInvalidPublicKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			normalized, fingerprint, err := NormalizePublicKey(tc.key)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.normalized, normalized)
+			assert.Equal(t, tc.fingerprint, fingerprint)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package crypto
+
+import (
+	"time"
+)
+
+const (
+	// minSuggestedMemory is the lower bound recommended by OWASP for argon2id.
+	minSuggestedMemory = 19 * 1024
+	maxSuggestedTime   = 10
+	benchmarkPassword  = "correct horse battery staple"
+)
+
+// SuggestArgon2idHash benchmarks this machine and returns the strongest
+// parameters whose hash still takes at most target, together with the
+// measured duration. Memory is preferred over passes: it starts at maxMemory
+// (KiB) and is only halved when a single pass is already too slow.
+func SuggestArgon2idHash(target time.Duration, maxMemory uint32, threads uint8) (*Argon2idHash, time.Duration) {
+	return suggestArgon2idHash(target, maxMemory, threads, measureArgon2idHash)
+}
+
+func suggestArgon2idHash(
+	target time.Duration,
+	maxMemory uint32,
+	threads uint8,
+	measure func(*Argon2idHash) time.Duration,
+) (*Argon2idHash, time.Duration) {
+	defaults := NewDefaultArgon2idHash()
+	params := NewArgon2idHash(1, defaults.saltLen, maxMemory, threads, defaults.keyLen)
+
+	duration := measure(params)
+	for duration > target && params.memory/2 >= minSuggestedMemory {
+		params.memory /= 2
+		duration = measure(params)
+	}
+
+	for params.time < maxSuggestedTime {
+		next := *params
+		next.time++
+
+		nextDuration := measure(&next)
+		if nextDuration > target {
+			break
+		}
+
+		params, duration = &next, nextDuration
+	}
+
+	return params, duration
+}
+
+func measureArgon2idHash(params *Argon2idHash) time.Duration {
+	start := time.Now()
+	_, _ = params.generateHash([]byte(benchmarkPassword), nil)
+
+	return time.Since(start)
+}
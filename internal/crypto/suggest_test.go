@@ -0,0 +1,78 @@
+package crypto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestArgon2idHash(t *testing.T) {
+	// pretend every pass over 1 MiB takes 1ms
+	measure := func(params *Argon2idHash) time.Duration {
+		return time.Duration(params.time*params.memory/1024) * time.Millisecond
+	}
+
+	type testCase struct {
+		name      string
+		target    time.Duration
+		maxMemory uint32
+		time      uint32
+		memory    uint32
+		duration  time.Duration
+	}
+
+	testCases := []testCase{
+		{
+			name:      "should add passes while below target",
+			target:    250 * time.Millisecond,
+			maxMemory: 64 * 1024,
+			time:      3,
+			memory:    64 * 1024,
+			duration:  192 * time.Millisecond,
+		},
+		{
+			name:      "should reduce memory when a single pass is too slow",
+			target:    40 * time.Millisecond,
+			maxMemory: 64 * 1024,
+			time:      1,
+			memory:    32 * 1024,
+			duration:  32 * time.Millisecond,
+		},
+		{
+			name:      "should not go below minimum memory",
+			target:    time.Millisecond,
+			maxMemory: 64 * 1024,
+			time:      1,
+			memory:    32 * 1024,
+			duration:  32 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params, duration := suggestArgon2idHash(tc.target, tc.maxMemory, 2, measure)
+			assert.Equal(t, tc.time, params.Time())
+			assert.Equal(t, tc.memory, params.Memory())
+			assert.Equal(t, uint8(2), params.Threads())
+			assert.Equal(t, tc.duration, duration)
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	params := NewArgon2idHash(1, 16, 1024, 1, 32)
+
+	hash, err := params.GeneratePasswordHash("test")
+	assert.NoError(t, err)
+
+	assert.False(t, params.NeedsRehash(hash))
+	assert.True(t, NewArgon2idHash(2, 16, 1024, 1, 32).NeedsRehash(hash))
+	assert.True(t, NewArgon2idHash(1, 16, 2048, 1, 32).NeedsRehash(hash))
+	assert.True(t, NewArgon2idHash(1, 16, 1024, 2, 32).NeedsRehash(hash))
+	assert.True(t, params.NeedsRehash("not a hash"))
+
+	ok, err := ComparePasswordAndHash("test", hash)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
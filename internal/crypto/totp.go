@@ -0,0 +1,131 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // TOTP authenticator apps use HMAC-SHA1 as defined by RFC 6238
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	TOTPDigits = 6
+	TOTPPeriod = 30 * time.Second
+
+	// totpSkew is the number of periods a code may be off to cope with clock
+	// drift between server and phone.
+	totpSkew          = 1
+	totpSecretLength  = 20
+	recoveryCodeBytes = 10
+)
+
+var ErrInvalidTOTPSecret = errors.New("invalid totp secret")
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a random base32 encoded secret for authenticator apps.
+func GenerateTOTPSecret() (string, error) {
+	secret, err := randomSecret(totpSecretLength)
+	if err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPStep returns the time step of RFC 6238 the time falls into.
+func TOTPStep(at time.Time) int64 {
+	return at.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// GenerateTOTPCode computes the code for the time step as defined in RFC 6238.
+func GenerateTOTPCode(secret []byte, step int64, digits int, h func() hash.Hash) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step)) //nolint:gosec // steps are never negative
+
+	mac := hmac.New(h, secret)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation of RFC 4226
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// ValidateTOTP checks the code against the base32 encoded secret. Only steps
+// after lastUsedStep are accepted, so a code cannot be used twice. The
+// matching step is returned to be stored as the new lastUsedStep.
+func ValidateTOTP(secret, code string, at time.Time, lastUsedStep int64) (int64, bool, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.ReplaceAll(secret, " ", "")))
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %w", ErrInvalidTOTPSecret, err)
+	}
+
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	current := TOTPStep(at)
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+
+		expected := GenerateTOTPCode(key, step, TOTPDigits, sha1.New)
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// TOTPProvisioningURI returns the otpauth uri that authenticator apps read
+// from a qr code.
+func TOTPProvisioningURI(issuer, account, secret string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(TOTPDigits))
+	params.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// GenerateRecoveryCodes returns n random single use codes in the form
+// xxxxxxxx-xxxxxxxx.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+
+	for range n {
+		random, err := randomSecret(recoveryCodeBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(totpEncoding.EncodeToString(random))
+		codes = append(codes, code[:len(code)/2]+"-"+code[len(code)/2:])
+	}
+
+	return codes, nil
+}
+
+// NormalizeRecoveryCode strips what users tend to add or change when typing
+// a recovery code, so it can be compared to the stored hash.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
@@ -0,0 +1,138 @@
+package crypto
+
+import (
+	"crypto/sha1" //nolint:gosec // RFC 6238 test vectors
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTOTPCode(t *testing.T) {
+	// test vectors from RFC 6238 appendix B
+	seeds := map[string]struct {
+		secret []byte
+		hash   func() hash.Hash
+	}{
+		"SHA1":   {[]byte("12345678901234567890"), sha1.New},
+		"SHA256": {[]byte("12345678901234567890123456789012"), sha256.New},
+		"SHA512": {[]byte("1234567890123456789012345678901234567890123456789012345678901234"), sha512.New},
+	}
+
+	type testCase struct {
+		time int64
+		algo string
+		code string
+	}
+
+	testCases := []testCase{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1111111109, "SHA256", "68084774"},
+		{1111111109, "SHA512", "25091201"},
+		{1111111111, "SHA1", "14050471"},
+		{1111111111, "SHA256", "67062674"},
+		{1111111111, "SHA512", "99943326"},
+		{1234567890, "SHA1", "89005924"},
+		{1234567890, "SHA256", "91819424"},
+		{1234567890, "SHA512", "93441116"},
+		{2000000000, "SHA1", "69279037"},
+		{2000000000, "SHA256", "90698825"},
+		{2000000000, "SHA512", "38618901"},
+		{20000000000, "SHA1", "65353130"},
+		{20000000000, "SHA256", "77737706"},
+		{20000000000, "SHA512", "47863826"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.algo+"/"+time.Unix(tc.time, 0).UTC().Format(time.RFC3339), func(t *testing.T) {
+			seed := seeds[tc.algo]
+
+			code := GenerateTOTPCode(seed.secret, TOTPStep(time.Unix(tc.time, 0)), 8, seed.hash)
+			assert.Equal(t, tc.code, code)
+		})
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	// base32 of the RFC 6238 SHA1 seed "12345678901234567890"
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	at := time.Unix(1111111111, 0)
+	step := TOTPStep(at)
+
+	type testCase struct {
+		name         string
+		code         string
+		at           time.Time
+		lastUsedStep int64
+		step         int64
+		ok           bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "should accept current code",
+			code: "050471",
+			at:   at,
+			step: step,
+			ok:   true,
+		},
+		{
+			name: "should accept code of previous period",
+			code: "050471",
+			at:   at.Add(TOTPPeriod),
+			step: step,
+			ok:   true,
+		},
+		{
+			name: "should reject code older than the allowed skew",
+			code: "050471",
+			at:   at.Add(2 * TOTPPeriod),
+		},
+		{
+			name:         "should reject code that was already used",
+			code:         "050471",
+			at:           at,
+			lastUsedStep: step,
+		},
+		{
+			name: "should reject wrong code",
+			code: "123456",
+			at:   at,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			step, ok, err := ValidateTOTP(secret, tc.code, tc.at, tc.lastUsedStep)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.step, step)
+		})
+	}
+
+	_, _, err := ValidateTOTP("not base32!", "050471", at, 0)
+	assert.ErrorIs(t, err, ErrInvalidTOTPSecret)
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	uri := TOTPProvisioningURI("ordaa", "@test:matrix.org", "GEZDGNBV")
+	assert.Equal(t, "otpauth://totp/ordaa:@test:matrix.org?algorithm=SHA1&digits=6&issuer=ordaa&period=30&secret=GEZDGNBV", uri)
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	assert.NoError(t, err)
+	assert.Len(t, codes, 10)
+
+	for _, code := range codes {
+		assert.Regexp(t, "^[a-z2-7]{8}-[a-z2-7]{8}$", code)
+		assert.Len(t, NormalizeRecoveryCode(" "+code+" "), 16)
+	}
+}
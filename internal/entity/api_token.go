@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+type TokenScope = string
+
+const (
+	TokenScopeReadOnly   TokenScope = "read-only"
+	TokenScopeOrderWrite TokenScope = "order-write"
+)
+
+var TokenScopes = []TokenScope{TokenScopeReadOnly, TokenScopeOrderWrite}
+
+// APIToken is a personal access token for the http api. Only the argon2 hash
+// of the secret is stored, TokenID is the public part used for the lookup.
+type APIToken struct {
+	UUID       *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
+	UserUUID   *uuid.UUID `gorm:"column:user_uuid" json:"user_uuid"`
+	Name       string     `gorm:"column:name" json:"name"`
+	Scope      TokenScope `gorm:"column:scope" json:"scope"`
+	TokenID    string     `gorm:"column:token_id" json:"token_id"`
+	TokenHash  string     `gorm:"column:token_hash" json:"-"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at" json:"expires_at"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at" json:"last_used_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+func (apiToken *APIToken) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	apiToken.UUID = &newUUID
+
+	return nil
+}
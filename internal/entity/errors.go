@@ -46,4 +46,6 @@ var (
 	ErrMigrationPreparationFailed      = errors.New("database migration preparation failed")
 	ErrMigrationFailed                 = errors.New("database migration execution failed")
 	ErrSettingPublicKey                = errors.New("setting public key for user")
+	ErrInvalidStoredPublicKey          = errors.New("stored ssh key cannot be parsed, fix or delete it and migrate again")
+	ErrDuplicateStoredPublicKey        = errors.New("stored ssh keys are the same key, delete one of them and migrate again")
 )
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// Favourite is a named set of menu items a user orders regularly. Items are
+// stored by menu item, so they survive price changes and renames.
+type Favourite struct {
+	UUID      *uuid.UUID      `gorm:"column:uuid;primaryKey" json:"uuid"`
+	UserUUID  *uuid.UUID      `gorm:"column:user_uuid" json:"user_uuid"`
+	MenuUUID  *uuid.UUID      `gorm:"column:menu_uuid" json:"menu_uuid"`
+	Name      string          `gorm:"column:name" json:"name"`
+	Items     []FavouriteItem `gorm:"foreignKey:FavouriteUUID" json:"items"`
+	CreatedAt time.Time       `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+type FavouriteItem struct {
+	UUID          *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
+	FavouriteUUID *uuid.UUID `gorm:"column:favourite_uuid" json:"favourite_uuid"`
+	MenuItemUUID  *uuid.UUID `gorm:"column:menu_item_uuid" json:"menu_item_uuid"`
+	Quantity      int        `gorm:"column:quantity" json:"quantity"`
+	// MenuItem is loaded including removed items, see DeletedAt.
+	MenuItem MenuItem `gorm:"foreignKey:MenuItemUUID" json:"-"`
+}
+
+func (favourite *Favourite) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	favourite.UUID = &newUUID
+
+	return nil
+}
+
+func (favouriteItem *FavouriteItem) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	favouriteItem.UUID = &newUUID
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// LinkCode is a short-lived code that lets another identity of the same
+// person join the account of UserUUID.
+type LinkCode struct {
+	UUID      *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
+	UserUUID  *uuid.UUID `gorm:"column:user_uuid" json:"user_uuid"`
+	Code      string     `gorm:"column:code" json:"code"`
+	ExpiresAt time.Time  `gorm:"column:expires_at" json:"expires_at"`
+}
+
+func (linkCode *LinkCode) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	linkCode.UUID = &newUUID
+
+	return nil
+}
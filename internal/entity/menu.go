@@ -15,11 +15,50 @@ type Menu struct {
 }
 
 type MenuItem struct {
-	UUID      *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
-	ShortName string     `gorm:"column:short_name" json:"short_name" validate:"required"`
-	Name      string     `gorm:"column:name" json:"name" validate:"required"`
-	Price     int        `gorm:"column:price" json:"price" validate:"required"`
-	MenuUUID  *uuid.UUID `gorm:"column:menu_uuid" json:"menu_uuid" validate:"required"`
+	UUID      *uuid.UUID     `gorm:"column:uuid;primaryKey" json:"uuid"`
+	ShortName string         `gorm:"column:short_name" json:"short_name" validate:"required"`
+	Name      string         `gorm:"column:name" json:"name" validate:"required"`
+	Price     int            `gorm:"column:price" json:"price" validate:"required"`
+	MenuUUID  *uuid.UUID     `gorm:"column:menu_uuid" json:"menu_uuid" validate:"required"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at" json:"-"`
+}
+
+// MenuAlias is another name of a menu, e.g. a short one for a menu with a
+// long name. Aliases are stored in lower case.
+type MenuAlias struct {
+	Alias    string     `gorm:"column:alias;primaryKey" json:"alias"`
+	MenuUUID *uuid.UUID `gorm:"column:menu_uuid" json:"menu_uuid"`
+}
+
+// MenuItemMatch is a result of a fuzzy search, Score is between 0 and 1.
+type MenuItemMatch struct {
+	MenuItem `gorm:"embedded"`
+	Score    float64 `gorm:"column:score" json:"score"`
+}
+
+// MenuDiff describes the changes an import applies to an existing menu.
+// Items are matched by their short name.
+type MenuDiff struct {
+	MenuUUID *uuid.UUID
+	Name     string
+	URL      string
+	OldURL   string
+	NewMenu  bool
+	Added    []MenuItem
+	Changed  []MenuItemChange
+	Removed  []MenuItem
+	// Revived holds, by short name, the previously removed items that added
+	// items bring back instead of creating new rows.
+	Revived map[string]MenuItem
+}
+
+type MenuItemChange struct {
+	Old MenuItem
+	New MenuItem
+}
+
+func (diff *MenuDiff) Empty() bool {
+	return !diff.NewMenu && diff.URL == diff.OldURL && len(diff.Added) == 0 && len(diff.Changed) == 0 && len(diff.Removed) == 0
 }
 
 func (menu *Menu) BeforeCreate(tx *gorm.DB) (err error) {
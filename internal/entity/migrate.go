@@ -2,15 +2,26 @@ package entity
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres" // add postgres support for migrations
 	_ "github.com/golang-migrate/migrate/v4/source/file"       // add files as a source for migrations
 	"github.com/rs/zerolog/log"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
 )
 
+// goMigrations run right before the sql migration of the same version, for
+// data changes that cannot be done in sql.
+var goMigrations = map[uint]func(ctx context.Context, tx *sql.Tx) error{
+	12: normalizeSSHKeys,
+}
+
 func Migrate(ctx context.Context, databaseURL string) error {
 	log.Ctx(ctx).Info().Msg("running database migrations")
 
@@ -19,6 +30,12 @@ func Migrate(ctx context.Context, databaseURL string) error {
 		return fmt.Errorf("%w: %w", ErrMigrationPreparationFailed, err)
 	}
 
+	for _, version := range slices.Sorted(maps.Keys(goMigrations)) {
+		if err = runGoMigration(ctx, m, databaseURL, version); err != nil {
+			return fmt.Errorf("%w: %w", ErrMigrationFailed, err)
+		}
+	}
+
 	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		return fmt.Errorf("%w: %w", ErrMigrationFailed, err)
 	}
@@ -28,3 +45,113 @@ func Migrate(ctx context.Context, databaseURL string) error {
 
 	return nil
 }
+
+// runGoMigration migrates up to the version before the go migration and runs
+// it in a transaction, unless the database is already past it.
+func runGoMigration(ctx context.Context, m *migrate.Migrate, databaseURL string, version uint) error {
+	current, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		current = 0
+	} else if err != nil {
+		return err
+	}
+
+	// a dirty database is reported by the following migration
+	if dirty || current >= version {
+		return nil
+	}
+
+	if current < version-1 {
+		if err = m.Migrate(version - 1); err != nil {
+			return err
+		}
+	}
+
+	log.Ctx(ctx).Info().Msgf("running go migration %d", version)
+
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = goMigrations[version](ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("go migration %d: %w", version, err)
+	}
+
+	return tx.Commit()
+}
+
+// normalizeSSHKeys stores all ssh keys without options and comment, before
+// 000012 makes them unique.
+func normalizeSSHKeys(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "SELECT uuid, public_key FROM ssh_users")
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	keys := map[string]string{}
+
+	for rows.Next() {
+		var sshUserUUID, publicKey string
+		if err = rows.Scan(&sshUserUUID, &publicKey); err != nil {
+			return err
+		}
+
+		keys[sshUserUUID] = publicKey
+	}
+
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	normalized, err := normalizePublicKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	for sshUserUUID, publicKey := range normalized {
+		if _, err = tx.ExecContext(ctx, "UPDATE ssh_users SET public_key = $1 WHERE uuid = $2", publicKey, sshUserUUID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// normalizePublicKeys returns the normalized form of the keys that are not
+// stored normalized yet, by ssh user uuid. It fails for keys that cannot be
+// parsed and for different rows holding the same key, as deciding which one
+// to keep is up to the admin.
+func normalizePublicKeys(keys map[string]string) (map[string]string, error) {
+	normalized := map[string]string{}
+	owners := map[string]string{}
+
+	for _, sshUserUUID := range slices.Sorted(maps.Keys(keys)) {
+		publicKey, _, err := crypto.NormalizePublicKey(keys[sshUserUUID])
+		if err != nil {
+			return nil, fmt.Errorf("%w: ssh user %s: %w", ErrInvalidStoredPublicKey, sshUserUUID, err)
+		}
+
+		if owner, ok := owners[publicKey]; ok {
+			return nil, fmt.Errorf("%w: ssh users %s and %s", ErrDuplicateStoredPublicKey, owner, sshUserUUID)
+		}
+
+		owners[publicKey] = sshUserUUID
+
+		if publicKey != keys[sshUserUUID] {
+			normalized[sshUserUUID] = publicKey
+		}
+	}
+
+	return normalized, nil
+}
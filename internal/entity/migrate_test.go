@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePublicKeys(t *testing.T) {
+	const (
+		ed25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIAci+R8O9iOaqz1SJBhzxIwr0Y2EWAkS+YLRu4pMbZbY"
+		otherKey   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEj1rxqPM8u0sxz3QcDg0Tu9O0HyEc2RrT7nnDqC0qkn"
+	)
+
+	type testCase struct {
+		name       string
+		keys       map[string]string
+		normalized map[string]string
+		err        error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "should keep normalized keys",
+			keys:       map[string]string{"a": ed25519Key, "b": otherKey},
+			normalized: map[string]string{},
+		},
+		{
+			name: "should strip options and comments",
+			keys: map[string]string{
+				"a": `no-agent-forwarding,command="echo hi" ` + ed25519Key + " jgero@nixps",
+				"b": otherKey + " luca@laptop",
+			},
+			normalized: map[string]string{"a": ed25519Key, "b": otherKey},
+		},
+		{
+			name: "should fail for the same key with options",
+			keys: map[string]string{"a": ed25519Key + " jgero@nixps", "b": `no-pty ` + ed25519Key},
+			err:  ErrDuplicateStoredPublicKey,
+		},
+		{
+			name: "should fail for keys that cannot be parsed",
+			keys: map[string]string{"a": ed25519Key, "b": "ssh-ed25519 broken"},
+			err:  ErrInvalidStoredPublicKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			normalized, err := normalizePublicKeys(tc.keys)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.normalized, normalized)
+		})
+	}
+}
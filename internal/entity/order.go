@@ -17,6 +17,12 @@ const (
 	Delivered = OrderState("delivered")
 )
 
+// NoRoom is the room of orders started outside of matrix, e.g. via the api or
+// ssh. It only sees its own and shared orders, like any other room.
+const NoRoom = ""
+
+// Order is visible in the room it was started in. Shared orders are visible in
+// all rooms.
 type Order struct {
 	UUID          *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
 	Initiator     *uuid.UUID `gorm:"column:initiator" json:"initiator"`
@@ -25,6 +31,19 @@ type Order struct {
 	OrderDeadline *time.Time `gorm:"column:order_deadline" json:"order_deadline"`
 	Eta           *time.Time `gorm:"column:eta" json:"eta"`
 	MenuUUID      *uuid.UUID `gorm:"column:menu_uuid" json:"menu_uuid"`
+	CreatedAt     *time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	RoomID        string     `gorm:"column:room_id" json:"room_id"`
+	Shared        bool       `gorm:"column:shared" json:"shared"`
+}
+
+// OrderSummary is an order together with what is needed to list it without
+// looking up its menu, initiator and items.
+type OrderSummary struct {
+	Order           `gorm:"embedded"`
+	MenuName        string `gorm:"column:menu_name" json:"menu_name"`
+	InitiatorName   string `gorm:"column:initiator_name" json:"initiator_name"`
+	SugarPersonName string `gorm:"column:sugar_person_name" json:"sugar_person_name"`
+	Total           int    `gorm:"column:total" json:"total"`
 }
 
 type OrderItem struct {
@@ -36,6 +55,15 @@ type OrderItem struct {
 	MenuItemUUID *uuid.UUID `gorm:"column:menu_item_uuid" json:"menu_item_uuid" validate:"required"`
 }
 
+// OrderItemSummary is an order item together with its dish and the name of the
+// user it belongs to.
+type OrderItemSummary struct {
+	OrderItem `gorm:"embedded"`
+	ShortName string `gorm:"column:short_name" json:"short_name"`
+	Name      string `gorm:"column:name" json:"name"`
+	UserName  string `gorm:"column:user_name" json:"user_name"`
+}
+
 func (order *Order) BeforeCreate(tx *gorm.DB) (err error) {
 	newUUID, err := uuid.NewV4()
 	if err != nil {
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+type Role = string
+
+const (
+	RoleAdmin  = Role("admin")
+	RoleMember = Role("member")
+)
+
+var Roles = []Role{RoleAdmin, RoleMember}
+
+// PrivilegedRoles are never carried over when accounts are linked.
+var PrivilegedRoles = []Role{RoleAdmin}
+
+type UserRole struct {
+	UUID     *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
+	UserUUID *uuid.UUID `gorm:"column:user_uuid" json:"user_uuid"`
+	Role     Role       `gorm:"column:role" json:"role" validate:"oneof=admin member"`
+}
+
+func (userRole *UserRole) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	userRole.UUID = &newUUID
+
+	return nil
+}
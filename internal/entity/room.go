@@ -0,0 +1,14 @@
+package entity
+
+import "github.com/gofrs/uuid"
+
+// RoomSettings configure the bot for a matrix room. Empty settings fall back
+// to the defaults of the bot.
+type RoomSettings struct {
+	RoomID          string     `gorm:"column:room_id;primaryKey" json:"room_id"`
+	Prefix          string     `gorm:"column:prefix" json:"prefix"`
+	Language        string     `gorm:"column:language" json:"language"`
+	DefaultMenuUUID *uuid.UUID `gorm:"column:default_menu_uuid" json:"default_menu_uuid"`
+	DefaultMenu     *Menu      `gorm:"foreignKey:DefaultMenuUUID" json:"-"`
+	AutoRegister    *bool      `gorm:"column:auto_register" json:"auto_register"`
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// TOTPCredential is the second factor of a password login. It is only
+// enforced once ConfirmedAt is set, i.e. the user proved the app works.
+type TOTPCredential struct {
+	UUID         *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
+	UserUUID     *uuid.UUID `gorm:"column:user_uuid" json:"user_uuid"`
+	Secret       string     `gorm:"column:secret" json:"-"`
+	ConfirmedAt  *time.Time `gorm:"column:confirmed_at" json:"confirmed_at"`
+	LastUsedStep int64      `gorm:"column:last_used_step" json:"-"`
+}
+
+// RecoveryCode replaces a totp code once, when the phone is lost.
+type RecoveryCode struct {
+	UUID     *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
+	UserUUID *uuid.UUID `gorm:"column:user_uuid" json:"user_uuid"`
+	CodeHash string     `gorm:"column:code_hash" json:"-"`
+	UsedAt   *time.Time `gorm:"column:used_at" json:"used_at"`
+}
+
+func (totpCredential *TOTPCredential) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	totpCredential.UUID = &newUUID
+
+	return nil
+}
+
+func (recoveryCode *RecoveryCode) BeforeCreate(tx *gorm.DB) (err error) {
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotCreatUUID, err)
+	}
+
+	recoveryCode.UUID = &newUUID
+
+	return nil
+}
@@ -10,6 +10,9 @@ import (
 type User struct {
 	UUID *uuid.UUID `gorm:"column:uuid;primaryKey" json:"uuid"`
 	Name string     `gorm:"column:name" json:"name"`
+	// Language is the language of replies to the user, the language of the
+	// room is used if it is empty.
+	Language string `gorm:"column:language" json:"language"`
 }
 
 type MatrixUser struct {
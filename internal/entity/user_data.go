@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// UserData is everything stored about a user, as handed out on a data export.
+// Secrets like password hashes and link codes are left out.
+type UserData struct {
+	ExportedAt        time.Time       `json:"exported_at"`
+	User              User            `json:"user"`
+	Roles             []Role          `json:"roles"`
+	MatrixUsers       []MatrixUser    `json:"matrix_users"`
+	PasswordUsernames []string        `json:"password_usernames"`
+	SSHUsers          []SSHUser       `json:"ssh_users"`
+	APITokens         []APIToken      `json:"api_tokens"`
+	TOTPCredential    *TOTPCredential `json:"totp_credential"`
+	RecoveryCodes     []RecoveryCode  `json:"recovery_codes"`
+	Orders            []Order         `json:"orders"`
+	OrderItems        []OrderItem     `json:"order_items"`
+	Favourites        []Favourite     `json:"favourites"`
+}
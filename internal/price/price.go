@@ -0,0 +1,46 @@
+package price
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPrice = errors.New("invalid price")
+
+// Format formats a price in cents as euros, e.g. 790 as "7.90".
+func Format(cents int) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+// Parse parses a price in euros like "7.90", "7,90", "7 €" or "7" into cents.
+func Parse(price string) (int, error) {
+	price = strings.TrimSpace(price)
+	price = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(price, "€"), "EUR"))
+	price = strings.ReplaceAll(price, ",", ".")
+
+	euros, cents, hasCents := strings.Cut(price, ".")
+
+	value, err := strconv.Atoi(euros)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidPrice, price)
+	}
+
+	value *= 100
+
+	if hasCents {
+		if len(cents) == 1 {
+			cents += "0"
+		}
+
+		centValue, err := strconv.Atoi(cents)
+		if err != nil || len(cents) != 2 || centValue < 0 {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidPrice, price)
+		}
+
+		value += centValue
+	}
+
+	return value, nil
+}
@@ -0,0 +1,48 @@
+package price
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	type testCase struct {
+		name  string
+		price string
+		cents int
+		err   bool
+	}
+
+	testCases := []testCase{
+		{name: "should parse price with dot", price: "7.90", cents: 790},
+		{name: "should parse price with comma", price: "7,90", cents: 790},
+		{name: "should parse price without cents", price: "12", cents: 1200},
+		{name: "should parse price with single cent digit", price: "3.5", cents: 350},
+		{name: "should parse price with currency", price: "16,90 €", cents: 1690},
+		{name: "should not parse empty price", price: "", err: true},
+		{name: "should not parse negative price", price: "-1.00", err: true},
+		{name: "should not parse price with too many cent digits", price: "1.999", err: true},
+		{name: "should not parse text", price: "free", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cents, err := Parse(tc.price)
+			if tc.err {
+				assert.ErrorIs(t, err, ErrInvalidPrice)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.cents, cents)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	assert.Equal(t, "7.90", Format(790))
+	assert.Equal(t, "0.05", Format(5))
+	assert.Equal(t, "12.00", Format(1200))
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrCreatingAPIToken = errors.New("could not create api token")
+	ErrAPITokenExists   = errors.New("you already have a token with this name")
+	ErrAPITokenNotFound = errors.New("api token not found")
+	ErrGettingAPIToken  = errors.New("could not get api token")
+	ErrDeletingAPIToken = errors.New("could not delete api token")
+	ErrUpdatingAPIToken = errors.New("could not update api token")
+)
+
+func (r *UserRepository) CreateAPIToken(ctx context.Context, apiToken *entity.APIToken) (*entity.APIToken, error) {
+	tx := r.DB.Begin()
+
+	var count int64
+
+	err := tx.Model(&entity.APIToken{}).Where(&entity.APIToken{UserUUID: apiToken.UserUUID, Name: apiToken.Name}).Count(&count).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, err)
+	}
+
+	if count > 0 {
+		_ = tx.Rollback()
+		return nil, ErrAPITokenExists
+	}
+
+	if err = tx.Create(apiToken).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, err)
+	}
+
+	_ = tx.Commit()
+
+	return apiToken, nil
+}
+
+func (r *UserRepository) GetAPITokensForUser(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error) {
+	apiTokens := []entity.APIToken{}
+
+	err := r.DB.Where(&entity.APIToken{UserUUID: userUUID}).Order("created_at").Find(&apiTokens).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingAPIToken, err)
+	}
+
+	return apiTokens, nil
+}
+
+func (r *UserRepository) GetAPITokenByTokenID(ctx context.Context, tokenID string) (*entity.APIToken, error) {
+	var apiToken entity.APIToken
+
+	err := r.DB.Where(&entity.APIToken{TokenID: tokenID}).First(&apiToken).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAPITokenNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingAPIToken, err)
+	}
+
+	return &apiToken, nil
+}
+
+// DeleteAPIToken deletes the token of the user with the given name and returns it.
+func (r *UserRepository) DeleteAPIToken(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error) {
+	tx := r.DB.Begin()
+
+	var apiToken entity.APIToken
+
+	err := tx.Where(&entity.APIToken{UserUUID: userUUID, Name: name}).First(&apiToken).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = tx.Rollback()
+		return nil, ErrAPITokenNotFound
+	} else if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrDeletingAPIToken, err)
+	}
+
+	if err = tx.Delete(&apiToken).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrDeletingAPIToken, err)
+	}
+
+	_ = tx.Commit()
+
+	return &apiToken, nil
+}
+
+func (r *UserRepository) TouchAPIToken(ctx context.Context, apiTokenUUID *uuid.UUID, lastUsedAt time.Time) error {
+	err := r.DB.Model(&entity.APIToken{}).Where(&entity.APIToken{UUID: apiTokenUUID}).Update("last_used_at", lastUsedAt).Error
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUpdatingAPIToken, err)
+	}
+
+	return nil
+}
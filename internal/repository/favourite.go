@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrFavouriteNotFound = errors.New("favourite not found")
+	ErrGettingFavourite  = errors.New("could not get favourite")
+	ErrSavingFavourite   = errors.New("could not save favourite")
+	ErrDeletingFavourite = errors.New("could not delete favourite")
+)
+
+// preloadFavouriteItems loads the menu items of a favourite including the
+// removed ones, so they can be reported instead of vanishing.
+func preloadFavouriteItems(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items.MenuItem", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	})
+}
+
+func (r *OrderRepository) GetFavourite(ctx context.Context, userUUID, menuUUID *uuid.UUID, name string) (*entity.Favourite, error) {
+	var favourite entity.Favourite
+
+	err := preloadFavouriteItems(r.DB).
+		Where(&entity.Favourite{UserUUID: userUUID, MenuUUID: menuUUID, Name: name}).
+		First(&favourite).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrFavouriteNotFound, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingFavourite, err)
+	}
+
+	return &favourite, nil
+}
+
+// GetFavourites returns the favourites of the user, only those of one menu if
+// menuUUID is set.
+func (r *OrderRepository) GetFavourites(ctx context.Context, userUUID, menuUUID *uuid.UUID) ([]entity.Favourite, error) {
+	favourites := []entity.Favourite{}
+
+	err := preloadFavouriteItems(r.DB).
+		Where(&entity.Favourite{UserUUID: userUUID, MenuUUID: menuUUID}).
+		Order("name").
+		Find(&favourites).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingFavourite, err)
+	}
+
+	return favourites, nil
+}
+
+// SaveFavourite stores the favourite, replacing the one with the same name.
+func (r *OrderRepository) SaveFavourite(ctx context.Context, favourite *entity.Favourite) (*entity.Favourite, error) {
+	tx := r.DB.Begin()
+
+	err := tx.Where(&entity.Favourite{UserUUID: favourite.UserUUID, MenuUUID: favourite.MenuUUID, Name: favourite.Name}).
+		Delete(&entity.Favourite{}).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrSavingFavourite, err)
+	}
+
+	if err = tx.Omit(clause.Associations).Create(favourite).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrSavingFavourite, err)
+	}
+
+	for i := range favourite.Items {
+		favourite.Items[i].FavouriteUUID = favourite.UUID
+
+		if err = tx.Omit(clause.Associations).Create(&favourite.Items[i]).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrSavingFavourite, err)
+		}
+	}
+
+	_ = tx.Commit()
+
+	return favourite, nil
+}
+
+func (r *OrderRepository) DeleteFavourite(ctx context.Context, userUUID, menuUUID *uuid.UUID, name string) error {
+	result := r.DB.Where(&entity.Favourite{UserUUID: userUUID, MenuUUID: menuUUID, Name: name}).Delete(&entity.Favourite{})
+	if result.Error != nil {
+		return fmt.Errorf("%w: %w", ErrDeletingFavourite, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %s", ErrFavouriteNotFound, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrCreatingLinkCode = errors.New("could not create link code")
+	ErrLinkCodeNotFound = errors.New("link code not found or expired")
+	ErrGettingLinkCode  = errors.New("could not get link code")
+	ErrMergingUsers     = errors.New("could not merge users")
+
+	ErrConflictingAPIToken  = errors.New("both accounts have api tokens with these names, revoke them on one account first")
+	ErrConflictingFavourite = errors.New("both accounts have favourites with these names, remove them on one account first")
+	ErrConflictingTOTP      = errors.New("both accounts use totp, disable it on one account first")
+)
+
+func (r *UserRepository) CreateLinkCode(ctx context.Context, linkCode *entity.LinkCode) (*entity.LinkCode, error) {
+	tx := r.DB.Begin()
+
+	if err := tx.Create(linkCode).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingLinkCode, err)
+	}
+
+	_ = tx.Commit()
+
+	return linkCode, nil
+}
+
+// GetLinkCode returns the link code without redeeming it. Expired codes are
+// treated as not found.
+func (r *UserRepository) GetLinkCode(ctx context.Context, code string) (*entity.LinkCode, error) {
+	var linkCode entity.LinkCode
+
+	err := r.DB.Where("code = ? AND expires_at > ?", code, time.Now()).First(&linkCode).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrLinkCodeNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingLinkCode, err)
+	}
+
+	return &linkCode, nil
+}
+
+// consumeLinkCode returns the link code and deletes it within tx, so every
+// code can only be redeemed once. It stays valid if tx is rolled back.
+// Expired codes are treated as not found.
+func consumeLinkCode(tx *gorm.DB, code string) (*entity.LinkCode, error) {
+	var linkCodes []entity.LinkCode
+
+	err := tx.Clauses(clause.Returning{}).
+		Where("code = ? AND expires_at > ?", code, time.Now()).
+		Delete(&linkCodes).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingLinkCode, err)
+	}
+
+	if len(linkCodes) == 0 {
+		return nil, ErrLinkCodeNotFound
+	}
+
+	return &linkCodes[0], nil
+}
+
+// CreateLinkedMatrixUser adds the matrix user to the account that issued the
+// link code. The code is only used up if the matrix user is created.
+func (r *UserRepository) CreateLinkedMatrixUser(
+	ctx context.Context,
+	code string,
+	matrixUser *entity.MatrixUser,
+) (*entity.MatrixUser, error) {
+	tx := r.DB.Begin()
+
+	linkCode, err := consumeLinkCode(tx, code)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	matrixUser.UserUUID = linkCode.UserUUID
+
+	if err = tx.Create(matrixUser).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
+	}
+
+	_ = tx.Commit()
+
+	return matrixUser, nil
+}
+
+// CreateLinkedSSHUser adds the public key to the account that issued the link
+// code. The code is only used up if the ssh user is created.
+func (r *UserRepository) CreateLinkedSSHUser(ctx context.Context, code string, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+	tx := r.DB.Begin()
+
+	linkCode, err := consumeLinkCode(tx, code)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	sshUser.UserUUID = linkCode.UserUUID
+
+	if err = tx.Create(sshUser).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
+	}
+
+	_ = tx.Commit()
+
+	return sshUser, nil
+}
+
+// MergeUsers moves everything that belongs to the source user over to the
+// user that issued the link code and deletes the source user afterwards. The
+// code is used up in the same transaction, so it stays valid if merging
+// fails. It refuses to merge when both users have an api token or favourite
+// of the same name or both confirmed totp, instead of dropping one of them.
+// Privileged roles are not carried over.
+func (r *UserRepository) MergeUsers(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error) {
+	tx := r.DB.Begin()
+
+	linkCode, err := consumeLinkCode(tx, code)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	targetUUID := linkCode.UserUUID
+
+	if err = checkMergeConflicts(tx, sourceUUID, targetUUID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	if err = mergeTOTP(tx, sourceUUID, targetUUID); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	updates := []struct {
+		model  any
+		column string
+	}{
+		{&entity.MatrixUser{}, "user_uuid"},
+		{&entity.PasswordUser{}, "user_uuid"},
+		{&entity.SSHUser{}, "user_uuid"},
+		{&entity.APIToken{}, "user_uuid"},
+		{&entity.Favourite{}, "user_uuid"},
+		{&entity.Order{}, "initiator"},
+		{&entity.Order{}, "sugar_person"},
+		{&entity.OrderItem{}, "order_user"},
+	}
+
+	for _, update := range updates {
+		err = tx.Model(update.model).Where(fmt.Sprintf("%s = ?", update.column), sourceUUID).Update(update.column, targetUUID).Error
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+		}
+	}
+
+	var roles []entity.UserRole
+
+	err = tx.Where("user_uuid = ? AND role NOT IN ?", sourceUUID, entity.PrivilegedRoles).Find(&roles).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	for _, role := range roles {
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entity.UserRole{UserUUID: targetUUID, Role: role.Role}).Error
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+		}
+	}
+
+	// the target keeps its own language if it chose one
+	err = tx.Model(&entity.User{}).Where("uuid = ? AND language = ''", targetUUID).
+		Update("language", gorm.Expr("(SELECT language FROM users WHERE uuid = ?)", sourceUUID)).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	// the remaining roles and link codes of the source user are removed by
+	// the cascade
+	if err = tx.Delete(&entity.User{UUID: sourceUUID}).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	var target entity.User
+	if err = tx.First(&target, targetUUID).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, ErrUserNotFound)
+	} else if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrMergingUsers, err)
+	}
+
+	_ = tx.Commit()
+
+	return &target, nil
+}
+
+// checkMergeConflicts looks for api tokens and favourites that exist with the
+// same name for both users and could not be moved.
+func checkMergeConflicts(tx *gorm.DB, sourceUUID, targetUUID *uuid.UUID) error {
+	var names []string
+
+	err := tx.Model(&entity.APIToken{}).
+		Where("user_uuid = ? AND name IN (SELECT name FROM api_tokens WHERE user_uuid = ?)", sourceUUID, targetUUID).
+		Pluck("name", &names).Error
+	if err != nil {
+		return err
+	}
+
+	if len(names) > 0 {
+		return fmt.Errorf("%w: %s", ErrConflictingAPIToken, strings.Join(names, ", "))
+	}
+
+	err = tx.Model(&entity.Favourite{}).
+		Where("user_uuid = ? AND (menu_uuid, name) IN (SELECT menu_uuid, name FROM favourites WHERE user_uuid = ?)", sourceUUID, targetUUID).
+		Pluck("name", &names).Error
+	if err != nil {
+		return err
+	}
+
+	if len(names) > 0 {
+		return fmt.Errorf("%w: %s", ErrConflictingFavourite, strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+// mergeTOTP moves a confirmed totp credential and its recovery codes to the
+// target user, so the logins moved along stay protected by it. It replaces an
+// enrollment the target did not confirm. Unconfirmed credentials of the source
+// are dropped with the source user.
+func mergeTOTP(tx *gorm.DB, sourceUUID, targetUUID *uuid.UUID) error {
+	var credentials []entity.TOTPCredential
+
+	err := tx.Where("user_uuid IN ?", []uuid.UUID{*sourceUUID, *targetUUID}).Find(&credentials).Error
+	if err != nil {
+		return err
+	}
+
+	var source, target *entity.TOTPCredential
+
+	for i := range credentials {
+		if *credentials[i].UserUUID == *sourceUUID {
+			source = &credentials[i]
+		} else {
+			target = &credentials[i]
+		}
+	}
+
+	if source == nil || source.ConfirmedAt == nil {
+		return nil
+	}
+
+	if target != nil && target.ConfirmedAt != nil {
+		return ErrConflictingTOTP
+	}
+
+	for _, model := range []any{&entity.TOTPCredential{}, &entity.RecoveryCode{}} {
+		if err = tx.Where("user_uuid = ?", targetUUID).Delete(model).Error; err != nil {
+			return err
+		}
+
+		if err = tx.Model(model).Where("user_uuid = ?", sourceUUID).Update("user_uuid", targetUUID).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -20,8 +20,11 @@ var (
 	ErrCreatingMenu      = errors.New("could not create menu")
 	ErrUpdatingMenu      = errors.New("could not update menu")
 	ErrCreatingMenuItem  = errors.New("could not create menu item")
+	ErrUpdatingMenuItem  = errors.New("could not update menu item")
 	ErrDeletingMenuItem  = errors.New("could not delete menu item")
 	ErrDeletingMenu      = errors.New("could not delete menu")
+	ErrApplyingMenuDiff  = errors.New("could not apply menu diff")
+	ErrSearchingMenu     = errors.New("could not search menu")
 )
 
 type MenuRepository struct {
@@ -44,7 +47,7 @@ func (r *MenuRepository) GetMenu(ctx context.Context, menuUUID *uuid.UUID) (*ent
 
 	err := r.DB.Model(&entity.Menu{}).Preload("Items").First(&menu, menuUUID).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, fmt.Errorf("%w: %w", ErrMenuItemNotFound, err)
+		return nil, fmt.Errorf("%w: %w", ErrMenuNotFound, err)
 	} else if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrGettingMenu, err)
 	}
@@ -52,10 +55,11 @@ func (r *MenuRepository) GetMenu(ctx context.Context, menuUUID *uuid.UUID) (*ent
 	return &menu, nil
 }
 
+// GetMenuByName finds a menu by its name or one of its aliases, ignoring case.
 func (r *MenuRepository) GetMenuByName(ctx context.Context, name string) (*entity.Menu, error) {
 	var menu entity.Menu
 
-	err := r.DB.Model(&entity.Menu{}).Preload("Items").Where(&entity.Menu{Name: name}).First(&menu).Error
+	err := r.DB.Model(&entity.Menu{}).Preload("Items").Where(menuNameCondition, name, name).First(&menu).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, fmt.Errorf("%w: %w", ErrMenuNotFound, err)
 	} else if err != nil {
@@ -91,6 +95,44 @@ func (r *MenuRepository) GetMenuItemByShortName(ctx context.Context, menuUUID *u
 	return &menuItem, nil
 }
 
+// GetRemovedMenuItems returns the soft deleted items of the menu, the most
+// recently removed first.
+func (r *MenuRepository) GetRemovedMenuItems(ctx context.Context, menuUUID *uuid.UUID) ([]entity.MenuItem, error) {
+	menuItems := []entity.MenuItem{}
+
+	err := r.DB.Unscoped().Where("menu_uuid = ? AND deleted_at IS NOT NULL", menuUUID).Order("deleted_at DESC").Find(&menuItems).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingMenuItem, err)
+	}
+
+	return menuItems, nil
+}
+
+// SearchMenuItems finds the items of the menu whose name contains something
+// similar to the query, ignoring case and accents. The best matches come
+// first.
+func (r *MenuRepository) SearchMenuItems(
+	ctx context.Context,
+	menuUUID *uuid.UUID,
+	query string,
+	limit int,
+) ([]entity.MenuItemMatch, error) {
+	matches := []entity.MenuItemMatch{}
+
+	err := r.DB.Model(&entity.MenuItem{}).
+		Select("menu_items.*, word_similarity(search_name(?), search_name(name)) AS score", query).
+		Where("menu_uuid = ? AND search_name(?) <% search_name(name)", menuUUID, query).
+		Order("score DESC").
+		Order("short_name").
+		Limit(limit).
+		Scan(&matches).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSearchingMenu, err)
+	}
+
+	return matches, nil
+}
+
 func (r *MenuRepository) CreateMenu(ctx context.Context, menu *entity.Menu) (*entity.Menu, error) {
 	tx := r.DB.Begin()
 
@@ -143,6 +185,34 @@ func (r *MenuRepository) CreateMenuItem(ctx context.Context, menuItem *entity.Me
 	return menuItem, nil
 }
 
+func (r *MenuRepository) UpdateMenuItem(
+	ctx context.Context,
+	menuItemUUID *uuid.UUID,
+	menuItem *entity.MenuItem,
+) (*entity.MenuItem, error) {
+	tx := r.DB.Begin()
+
+	existingMenuItem, err := r.GetMenuItem(ctx, menuItemUUID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingMenuItem, err)
+	}
+
+	existingMenuItem.ShortName = menuItem.ShortName
+	existingMenuItem.Name = menuItem.Name
+	existingMenuItem.Price = menuItem.Price
+
+	err = tx.Save(existingMenuItem).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingMenuItem, err)
+	}
+
+	_ = tx.Commit()
+
+	return existingMenuItem, nil
+}
+
 func (r *MenuRepository) DeleteMenuItem(ctx context.Context, menuItemUUID *uuid.UUID) error {
 	tx := r.DB.Begin()
 
@@ -157,6 +227,64 @@ func (r *MenuRepository) DeleteMenuItem(ctx context.Context, menuItemUUID *uuid.
 	return nil
 }
 
+// ApplyMenuDiff writes the given diff in a single transaction. Removed items
+// are soft deleted, so past orders can still reference them. Revived items are
+// restored instead of being created again.
+func (r *MenuRepository) ApplyMenuDiff(ctx context.Context, diff *entity.MenuDiff) error {
+	tx := r.DB.Begin()
+
+	if diff.URL != diff.OldURL {
+		if err := tx.Model(&entity.Menu{}).Where("uuid = ?", diff.MenuUUID).Update("url", diff.URL).Error; err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %w", ErrApplyingMenuDiff, err)
+		}
+	}
+
+	for _, item := range diff.Added {
+		var err error
+
+		if removedItem, ok := diff.Revived[item.ShortName]; ok {
+			err = tx.Unscoped().Model(&entity.MenuItem{}).Where("uuid = ?", removedItem.UUID).Updates(map[string]any{
+				"name":       item.Name,
+				"price":      item.Price,
+				"deleted_at": nil,
+			}).Error
+		} else {
+			item.MenuUUID = diff.MenuUUID
+			err = tx.Create(&item).Error
+		}
+
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %w", ErrApplyingMenuDiff, err)
+		}
+	}
+
+	for _, change := range diff.Changed {
+		err := tx.Model(&entity.MenuItem{}).Where("uuid = ?", change.Old.UUID).Updates(map[string]any{
+			"name":  change.New.Name,
+			"price": change.New.Price,
+		}).Error
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %w", ErrApplyingMenuDiff, err)
+		}
+	}
+
+	for _, item := range diff.Removed {
+		if err := tx.Delete(&entity.MenuItem{}, item.UUID).Error; err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %w", ErrApplyingMenuDiff, err)
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("%w: %w", ErrApplyingMenuDiff, err)
+	}
+
+	return nil
+}
+
 func (r *MenuRepository) DeleteMenu(ctx context.Context, menuUUID *uuid.UUID) error {
 	tx := r.DB.Begin()
 
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrMenuAliasNotFound = errors.New("menu alias not found")
+	ErrMenuAliasExists   = errors.New("menu name or alias already in use")
+	ErrGettingMenuAlias  = errors.New("could not get menu alias")
+	ErrCreatingMenuAlias = errors.New("could not create menu alias")
+	ErrDeletingMenuAlias = errors.New("could not delete menu alias")
+)
+
+// menuNameCondition matches the menus table by name or alias, ignoring case.
+// It takes the name twice.
+const menuNameCondition = "lower(menus.name) = lower(?) OR menus.uuid IN " +
+	"(SELECT menu_uuid FROM menu_aliases WHERE menu_aliases.alias = lower(?))"
+
+func (r *MenuRepository) GetMenuAliases(ctx context.Context, menuUUID *uuid.UUID) ([]entity.MenuAlias, error) {
+	aliases := []entity.MenuAlias{}
+
+	err := r.DB.Where(&entity.MenuAlias{MenuUUID: menuUUID}).Order("alias").Find(&aliases).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingMenuAlias, err)
+	}
+
+	return aliases, nil
+}
+
+// CreateMenuAlias adds an alias unless it already names a menu, either as
+// name or as alias.
+func (r *MenuRepository) CreateMenuAlias(ctx context.Context, alias *entity.MenuAlias) (*entity.MenuAlias, error) {
+	alias.Alias = strings.ToLower(alias.Alias)
+
+	tx := r.DB.Begin()
+
+	var count int64
+
+	err := tx.Model(&entity.Menu{}).Where(menuNameCondition, alias.Alias, alias.Alias).Count(&count).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingMenuAlias, err)
+	}
+
+	if count > 0 {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %s", ErrMenuAliasExists, alias.Alias)
+	}
+
+	if err = tx.Create(alias).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingMenuAlias, err)
+	}
+
+	_ = tx.Commit()
+
+	return alias, nil
+}
+
+func (r *MenuRepository) DeleteMenuAlias(ctx context.Context, alias string) (*entity.MenuAlias, error) {
+	var menuAlias entity.MenuAlias
+
+	err := r.DB.Where(&entity.MenuAlias{Alias: strings.ToLower(alias)}).First(&menuAlias).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrMenuAliasNotFound, alias)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingMenuAlias, err)
+	}
+
+	if err = r.DB.Delete(&menuAlias).Error; err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDeletingMenuAlias, err)
+	}
+
+	return &menuAlias, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"gorm.io/gorm"
@@ -34,8 +35,31 @@ var (
 	ErrMenuItemUUIDChangeForbidden     = errors.New("changing menu item uuid is forbidden")
 	ErrUserChangeForbidden             = errors.New("changing user is forbidden")
 	ErrSugarPersonNotSet               = errors.New("the sugar person has not been set")
+	ErrFindingOrders                   = errors.New("could not find orders")
+	ErrGettingOrderItemSummaries       = errors.New("could not get items of order")
 )
 
+// OrderFilter narrows down FindOrders. Zero values don't filter.
+type OrderFilter struct {
+	OrderUUID *uuid.UUID
+	MenuUUID  *uuid.UUID
+	// RoomID only keeps orders visible in the room, see inRoom
+	RoomID *string
+	// ParticipantUUID only keeps orders the user has items in
+	ParticipantUUID *uuid.UUID
+	States          []entity.OrderState
+	Since           *time.Time
+	Until           *time.Time
+	Limit           int
+	Offset          int
+}
+
+// inRoom narrows query to the orders visible in the room: the ones started in
+// it and shared ones. entity.NoRoom is a room of its own.
+func inRoom(query *gorm.DB, roomID string) *gorm.DB {
+	return query.Where("orders.room_id = ? OR orders.shared", roomID)
+}
+
 type OrderRepository struct {
 	DB             *gorm.DB
 	MenuRepository MenuRepository
@@ -52,6 +76,66 @@ func (r *OrderRepository) GetAllOrders(ctx context.Context) ([]entity.Order, err
 	return orders, nil
 }
 
+// FindOrders returns the orders matching the filter, newest first. Orders
+// older than the created_at column come last.
+func (r *OrderRepository) FindOrders(ctx context.Context, filter OrderFilter) ([]entity.OrderSummary, error) {
+	summaries := []entity.OrderSummary{}
+
+	query := r.DB.Model(&entity.Order{}).
+		Select("orders.*, menus.name AS menu_name, COALESCE(users.name, '') AS initiator_name, " +
+			"COALESCE(sugar_people.name, '') AS sugar_person_name, " +
+			"(SELECT COALESCE(SUM(order_items.price), 0) FROM order_items WHERE order_items.order_uuid = orders.uuid) AS total").
+		Joins("JOIN menus ON menus.uuid = orders.menu_uuid").
+		Joins("LEFT JOIN users ON users.uuid = orders.initiator").
+		Joins("LEFT JOIN users AS sugar_people ON sugar_people.uuid = orders.sugar_person")
+
+	if filter.OrderUUID != nil {
+		query = query.Where("orders.uuid = ?", filter.OrderUUID)
+	}
+
+	if filter.MenuUUID != nil {
+		query = query.Where("orders.menu_uuid = ?", filter.MenuUUID)
+	}
+
+	if filter.RoomID != nil {
+		query = inRoom(query, *filter.RoomID)
+	}
+
+	if filter.ParticipantUUID != nil {
+		query = query.Where(
+			"EXISTS (SELECT 1 FROM order_items WHERE order_items.order_uuid = orders.uuid AND order_items.order_user = ?)",
+			filter.ParticipantUUID,
+		)
+	}
+
+	if len(filter.States) > 0 {
+		query = query.Where("orders.state IN ?", filter.States)
+	}
+
+	if filter.Since != nil {
+		query = query.Where("orders.created_at >= ?", filter.Since)
+	}
+
+	if filter.Until != nil {
+		query = query.Where("orders.created_at < ?", filter.Until)
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+
+	err := query.Order("orders.created_at DESC NULLS LAST").Order("orders.uuid").Scan(&summaries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFindingOrders, err)
+	}
+
+	return summaries, nil
+}
+
 func (r *OrderRepository) GetOrder(ctx context.Context, uuid *uuid.UUID) (*entity.Order, error) {
 	var order entity.Order
 
@@ -65,10 +149,15 @@ func (r *OrderRepository) GetOrder(ctx context.Context, uuid *uuid.UUID) (*entit
 	return &order, nil
 }
 
-func (r *OrderRepository) GetActiveOrderByMenu(ctx context.Context, menuUUID *uuid.UUID) (*entity.Order, error) {
+// GetActiveOrderByMenu returns the active order of the menu visible in the
+// room. The oldest active order is returned if there are several.
+func (r *OrderRepository) GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
 	var order entity.Order
 
-	err := r.DB.Model(&entity.Order{}).Where("menu_uuid = ? AND state != ?", menuUUID, entity.Delivered).First(&order).Error
+	err := inRoom(r.DB.Model(&entity.Order{}), roomID).
+		Where("orders.menu_uuid = ? AND orders.state != ?", menuUUID, entity.Delivered).
+		Order("orders.created_at NULLS FIRST").
+		First(&order).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, fmt.Errorf("%w: %w", ErrOrderNotFound, err)
 	} else if err != nil {
@@ -78,12 +167,16 @@ func (r *OrderRepository) GetActiveOrderByMenu(ctx context.Context, menuUUID *uu
 	return &order, nil
 }
 
-func (r *OrderRepository) GetActiveOrderByMenuName(ctx context.Context, menuName string) (*entity.Order, error) {
+// GetActiveOrderByMenuName is GetActiveOrderByMenu with the name or an alias
+// of the menu.
+func (r *OrderRepository) GetActiveOrderByMenuName(ctx context.Context, roomID, menuName string) (*entity.Order, error) {
 	var order entity.Order
 
-	err := r.DB.Model(&entity.Order{}).
+	err := inRoom(r.DB.Model(&entity.Order{}), roomID).
 		Joins("JOIN menus ON menus.uuid = orders.menu_uuid").
-		Where("menus.name = ? AND state != ?", menuName, entity.Delivered).
+		Where(menuNameCondition, menuName, menuName).
+		Where("orders.state != ?", entity.Delivered).
+		Order("orders.created_at NULLS FIRST").
 		First(&order).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, fmt.Errorf("%w: %w", ErrOrderNotFound, err)
@@ -97,7 +190,7 @@ func (r *OrderRepository) GetActiveOrderByMenuName(ctx context.Context, menuName
 func (r *OrderRepository) GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
 	orderItems := []entity.OrderItem{}
 
-	err := r.DB.Find(&orderItems).Error
+	err := r.DB.Where(&entity.OrderItem{OrderUUID: orderUUID}).Find(&orderItems).Error
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrCannotGetAllOrderItems, err)
 	}
@@ -116,6 +209,28 @@ func (r *OrderRepository) GetAllOrderItemsForOrderAndUser(ctx context.Context, o
 	return orderItems, nil
 }
 
+// GetOrderItemSummaries returns the items of the order sorted by user and
+// dish. Dishes removed from the menu since are included, items of deleted
+// users have an empty user name.
+func (r *OrderRepository) GetOrderItemSummaries(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItemSummary, error) {
+	summaries := []entity.OrderItemSummary{}
+
+	err := r.DB.Model(&entity.OrderItem{}).
+		Select("order_items.*, menu_items.short_name, menu_items.name, COALESCE(users.name, '') AS user_name").
+		Joins("JOIN menu_items ON menu_items.uuid = order_items.menu_item_uuid").
+		Joins("LEFT JOIN users ON users.uuid = order_items.order_user").
+		Where("order_items.order_uuid = ?", orderUUID).
+		Order("user_name").
+		Order("order_items.order_user").
+		Order("menu_items.short_name").
+		Scan(&summaries).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderItemSummaries, err)
+	}
+
+	return summaries, nil
+}
+
 func (r *OrderRepository) GetOrderItem(ctx context.Context, uuid *uuid.UUID) (*entity.OrderItem, error) {
 	orderItem := entity.OrderItem{}
 
@@ -173,20 +288,81 @@ func (r *OrderRepository) CreateOrderItem(
 	return orderItem, nil
 }
 
+// CreateOrderItems adds all items to the order in one transaction, so either
+// all of them are added or none.
+func (r *OrderRepository) CreateOrderItems(
+	ctx context.Context,
+	orderUUID *uuid.UUID,
+	orderItems []entity.OrderItem,
+) ([]entity.OrderItem, error) {
+	tx := r.DB.Begin()
+
+	order, err := r.GetOrder(ctx, orderUUID)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingOrderItem, err)
+	}
+
+	if order.State != entity.Open {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingOrderItem, ErrOrderNotOpen)
+	}
+
+	for i := range orderItems {
+		if orderItems[i].MenuItemUUID == nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrCreatingOrderItem, ErrMenuItemUUIDMissing)
+		}
+
+		var menuItem *entity.MenuItem
+
+		menuItem, err = r.MenuRepository.GetMenuItem(ctx, orderItems[i].MenuItemUUID)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrCreatingOrderItem, err)
+		}
+
+		orderItems[i].OrderUUID = orderUUID
+		orderItems[i].Paid = false
+		orderItems[i].Price = menuItem.Price
+
+		if err = tx.Create(&orderItems[i]).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrCreatingOrderItem, err)
+		}
+	}
+
+	_ = tx.Commit()
+
+	return orderItems, nil
+}
+
+// CreateOrder starts the order unless another active order of the menu is
+// visible in its room. Shared orders are visible in all rooms, so they
+// conflict with the active orders of every room.
 func (r *OrderRepository) CreateOrder(ctx context.Context, order *entity.Order) (*entity.Order, error) {
 	tx := r.DB.Begin()
 
 	order.State = entity.Open
 
-	_, err := r.GetActiveOrderByMenu(ctx, order.MenuUUID)
-	if err == nil {
-		_ = tx.Rollback()
-		return nil, fmt.Errorf("%w: %w", ErrCreatingOrder, ErrActiveOrderForMenuAlreadyExists)
-	} else if !errors.Is(err, ErrOrderNotFound) {
+	query := tx.Model(&entity.Order{}).Where("orders.menu_uuid = ? AND orders.state != ?", order.MenuUUID, entity.Delivered)
+	if !order.Shared {
+		query = inRoom(query, order.RoomID)
+	}
+
+	var count int64
+
+	err := query.Count(&count).Error
+	if err != nil {
 		_ = tx.Rollback()
 		return nil, fmt.Errorf("%w: %w", ErrCreatingOrder, err)
 	}
 
+	if count > 0 {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingOrder, ErrActiveOrderForMenuAlreadyExists)
+	}
+
 	err = tx.Create(&order).Error
 	if err != nil {
 		_ = tx.Rollback()
@@ -204,9 +380,12 @@ func (r *OrderRepository) UpdateOrder(ctx context.Context, orderUUID, currentUse
 	order.UUID = orderUUID
 
 	if err := tx.Save(order).Error; err != nil {
+		_ = tx.Rollback()
 		return nil, fmt.Errorf("%w: %w", ErrUpdatingOrder, err)
 	}
 
+	_ = tx.Commit()
+
 	return order, nil
 }
 
@@ -234,7 +413,7 @@ func (r *OrderRepository) UpdateOrderItem(
 		return nil, fmt.Errorf("%w: %w", ErrUpdatingOrderItem, ErrMenuItemUUIDChangeForbidden)
 	}
 
-	if *existingOrderItem.User != *orderItem.User {
+	if existingOrderItem.User == nil || orderItem.User == nil || *existingOrderItem.User != *orderItem.User {
 		_ = tx.Rollback()
 		return nil, fmt.Errorf("%w: %w", ErrUpdatingOrderItem, ErrUserChangeForbidden)
 	}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var ErrGettingUserData = errors.New("could not get user data")
+
+// GetUserData collects everything stored about the user. Orders are included
+// when the user initiated them or is their sugar person.
+func (r *UserRepository) GetUserData(ctx context.Context, userUUID *uuid.UUID) (*entity.UserData, error) {
+	userData := &entity.UserData{}
+
+	err := r.DB.First(&userData.User, userUUID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrGettingUserData, ErrUserNotFound)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingUserData, err)
+	}
+
+	if userData.Roles, err = r.GetRoles(ctx, userUUID); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingUserData, err)
+	}
+
+	finds := []struct {
+		query *gorm.DB
+		dest  any
+	}{
+		{r.DB.Where("user_uuid = ?", userUUID), &userData.MatrixUsers},
+		{r.DB.Where("user_uuid = ?", userUUID), &userData.SSHUsers},
+		{r.DB.Where("user_uuid = ?", userUUID), &userData.APITokens},
+		{r.DB.Where("user_uuid = ?", userUUID), &userData.RecoveryCodes},
+		{r.DB.Where("initiator = ? OR sugar_person = ?", userUUID, userUUID), &userData.Orders},
+		{r.DB.Where("order_user = ?", userUUID), &userData.OrderItems},
+		{r.DB.Preload("Items").Where("user_uuid = ?", userUUID), &userData.Favourites},
+	}
+
+	for _, find := range finds {
+		if err = find.query.Find(find.dest).Error; err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrGettingUserData, err)
+		}
+	}
+
+	err = r.DB.Model(&entity.PasswordUser{}).Where("user_uuid = ?", userUUID).Pluck("username", &userData.PasswordUsernames).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingUserData, err)
+	}
+
+	totpCredential, err := r.GetTOTPCredential(ctx, userUUID)
+	if err != nil && !errors.Is(err, ErrTOTPNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrGettingUserData, err)
+	}
+
+	userData.TOTPCredential = totpCredential
+
+	return userData, nil
+}
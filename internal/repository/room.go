@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrRoomSettingsNotFound = errors.New("room settings not found")
+	ErrGettingRoomSettings  = errors.New("could not get room settings")
+	ErrSavingRoomSettings   = errors.New("could not save room settings")
+)
+
+type RoomRepository struct {
+	DB *gorm.DB
+}
+
+// GetRoomSettings returns the settings of the room including its default
+// menu.
+func (r *RoomRepository) GetRoomSettings(ctx context.Context, roomID string) (*entity.RoomSettings, error) {
+	var settings entity.RoomSettings
+
+	err := r.DB.Preload("DefaultMenu").Where(&entity.RoomSettings{RoomID: roomID}).First(&settings).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrRoomSettingsNotFound, roomID)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingRoomSettings, err)
+	}
+
+	return &settings, nil
+}
+
+// SaveRoomSettings creates or replaces the settings of the room, the default
+// menu is only referenced by its uuid.
+func (r *RoomRepository) SaveRoomSettings(ctx context.Context, settings *entity.RoomSettings) (*entity.RoomSettings, error) {
+	if err := r.DB.Omit(clause.Associations).Save(settings).Error; err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSavingRoomSettings, err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrTOTPNotFound         = errors.New("totp is not set up")
+	ErrGettingTOTP          = errors.New("could not get totp credential")
+	ErrSavingTOTP           = errors.New("could not save totp credential")
+	ErrDeletingTOTP         = errors.New("could not delete totp credential")
+	ErrTOTPCodeUsed         = errors.New("totp code was already used")
+	ErrGettingRecoveryCodes = errors.New("could not get recovery codes")
+	ErrRecoveryCodeUsed     = errors.New("recovery code was already used")
+)
+
+func (r *UserRepository) GetTOTPCredential(ctx context.Context, userUUID *uuid.UUID) (*entity.TOTPCredential, error) {
+	var totpCredential entity.TOTPCredential
+
+	err := r.DB.Where(&entity.TOTPCredential{UserUUID: userUUID}).First(&totpCredential).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTOTPNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingTOTP, err)
+	}
+
+	return &totpCredential, nil
+}
+
+// CreateTOTPCredential replaces an unconfirmed credential of the user, so an
+// enrollment can be restarted when the qr code was not scanned.
+func (r *UserRepository) CreateTOTPCredential(ctx context.Context, totpCredential *entity.TOTPCredential) (*entity.TOTPCredential, error) {
+	tx := r.DB.Begin()
+
+	err := tx.Where("user_uuid = ? AND confirmed_at IS NULL", totpCredential.UserUUID).Delete(&entity.TOTPCredential{}).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrSavingTOTP, err)
+	}
+
+	if err = tx.Create(totpCredential).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrSavingTOTP, err)
+	}
+
+	_ = tx.Commit()
+
+	return totpCredential, nil
+}
+
+// ConfirmTOTPCredential activates the credential and replaces all recovery
+// codes of the user.
+func (r *UserRepository) ConfirmTOTPCredential(ctx context.Context, userUUID *uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	tx := r.DB.Begin()
+
+	err := tx.Model(&entity.TOTPCredential{}).
+		Where(&entity.TOTPCredential{UserUUID: userUUID}).
+		Updates(map[string]any{"confirmed_at": time.Now(), "last_used_step": step}).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrSavingTOTP, err)
+	}
+
+	if err = tx.Where(&entity.RecoveryCode{UserUUID: userUUID}).Delete(&entity.RecoveryCode{}).Error; err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrSavingTOTP, err)
+	}
+
+	for _, codeHash := range recoveryCodeHashes {
+		if err = tx.Create(&entity.RecoveryCode{UserUUID: userUUID, CodeHash: codeHash}).Error; err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %w", ErrSavingTOTP, err)
+		}
+	}
+
+	_ = tx.Commit()
+
+	return nil
+}
+
+// UseTOTPStep records the step of a successfully checked code. It fails when
+// a concurrent login already used the same or a later step.
+func (r *UserRepository) UseTOTPStep(ctx context.Context, userUUID *uuid.UUID, step int64) error {
+	result := r.DB.Model(&entity.TOTPCredential{}).
+		Where("user_uuid = ? AND last_used_step < ?", userUUID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return fmt.Errorf("%w: %w", ErrSavingTOTP, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrTOTPCodeUsed
+	}
+
+	return nil
+}
+
+func (r *UserRepository) DeleteTOTPCredential(ctx context.Context, userUUID *uuid.UUID) error {
+	tx := r.DB.Begin()
+
+	if err := tx.Where(&entity.TOTPCredential{UserUUID: userUUID}).Delete(&entity.TOTPCredential{}).Error; err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrDeletingTOTP, err)
+	}
+
+	if err := tx.Where(&entity.RecoveryCode{UserUUID: userUUID}).Delete(&entity.RecoveryCode{}).Error; err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrDeletingTOTP, err)
+	}
+
+	_ = tx.Commit()
+
+	return nil
+}
+
+func (r *UserRepository) GetUnusedRecoveryCodes(ctx context.Context, userUUID *uuid.UUID) ([]entity.RecoveryCode, error) {
+	recoveryCodes := []entity.RecoveryCode{}
+
+	err := r.DB.Where("user_uuid = ? AND used_at IS NULL", userUUID).Find(&recoveryCodes).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingRecoveryCodes, err)
+	}
+
+	return recoveryCodes, nil
+}
+
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, recoveryCodeUUID *uuid.UUID) error {
+	result := r.DB.Model(&entity.RecoveryCode{}).
+		Where("uuid = ? AND used_at IS NULL", recoveryCodeUUID).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("%w: %w", ErrGettingRecoveryCodes, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrRecoveryCodeUsed
+	}
+
+	return nil
+}
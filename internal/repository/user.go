@@ -20,13 +20,19 @@ var (
 	ErrUpdatingUser      = errors.New("could not update users")
 	ErrDeletingUser      = errors.New("could not delete user")
 	ErrSettingPublicKey  = errors.New("setting public key for user")
+	ErrPublicKeyExists   = errors.New("public key is already added to your account")
+	ErrPublicKeyInUse    = errors.New("public key belongs to another user")
+	ErrPublicKeyNotFound = errors.New("public key not found")
+	ErrGettingRoles      = errors.New("could not get roles of user")
+	ErrGrantingRole      = errors.New("could not grant role")
+	ErrRevokingRole      = errors.New("could not revoke role")
 )
 
 type UserRepository struct {
 	DB *gorm.DB
 }
 
-func (r *UserRepository) RegisterMatrixUser(ctx context.Context, username string) (*entity.User, error) {
+func (r *UserRepository) RegisterMatrixUser(ctx context.Context, username, name string) (*entity.User, error) {
 	tx := r.DB.Begin()
 
 	matrixUser, err := r.GetMatrixUserByUsername(ctx, username)
@@ -40,7 +46,7 @@ func (r *UserRepository) RegisterMatrixUser(ctx context.Context, username string
 		return nil, ErrUserAlreadyExists
 	}
 
-	user := &entity.User{Name: username}
+	user := &entity.User{Name: name}
 	if err = tx.Create(user).Error; err != nil {
 		_ = tx.Rollback()
 		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
@@ -51,31 +57,72 @@ func (r *UserRepository) RegisterMatrixUser(ctx context.Context, username string
 		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
 	}
 
+	if err = tx.Create(&entity.UserRole{UserUUID: user.UUID, Role: entity.RoleMember}).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
+	}
+
 	_ = tx.Commit()
 
 	return user, nil
 }
 
-func (r *UserRepository) SetPublicKey(ctx context.Context, userUUID *uuid.UUID, publicKey string) error {
+// AddPublicKey binds the public key to the user. A key can only belong to one
+// user, this is also enforced by the unique_public_key constraint.
+func (r *UserRepository) AddPublicKey(ctx context.Context, userUUID *uuid.UUID, publicKey string) (*entity.SSHUser, error) {
 	tx := r.DB.Begin()
 
-	user, err := r.GetUser(ctx, userUUID)
-	if err != nil {
+	var existing entity.SSHUser
+
+	err := tx.Where(&entity.SSHUser{PublicKey: publicKey}).First(&existing).Error
+	if err == nil && *existing.UserUUID == *userUUID {
 		_ = tx.Rollback()
-		return err
+		return nil, fmt.Errorf("%w: %w", ErrSettingPublicKey, ErrPublicKeyExists)
+	} else if err == nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrSettingPublicKey, ErrPublicKeyInUse)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrSettingPublicKey, err)
 	}
 
-	sshUser, err := r.GetSSHUser(ctx, user.UUID)
-	if err != nil {
+	sshUser := &entity.SSHUser{UserUUID: userUUID, PublicKey: publicKey}
+
+	if err = tx.Create(sshUser).Error; err != nil {
 		_ = tx.Rollback()
-		return err
+		return nil, fmt.Errorf("%w: %w", ErrSettingPublicKey, err)
+	}
+
+	_ = tx.Commit()
+
+	return sshUser, nil
+}
+
+func (r *UserRepository) GetSSHUsersForUser(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error) {
+	sshUsers := []entity.SSHUser{}
+
+	err := r.DB.Where(&entity.SSHUser{UserUUID: userUUID}).Find(&sshUsers).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingUser, err)
 	}
 
-	sshUser.PublicKey = publicKey
+	return sshUsers, nil
+}
+
+// RemovePublicKey deletes a single key of the user, DeleteSSHUser removes all
+// keys of a user.
+func (r *UserRepository) RemovePublicKey(ctx context.Context, userUUID, sshUserUUID *uuid.UUID) error {
+	tx := r.DB.Begin()
+
+	result := tx.Where(&entity.SSHUser{UUID: sshUserUUID, UserUUID: userUUID}).Delete(&entity.SSHUser{})
+	if result.Error != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrDeletingUser, result.Error)
+	}
 
-	if err = tx.Save(sshUser).Error; err != nil {
+	if result.RowsAffected == 0 {
 		_ = tx.Rollback()
-		return fmt.Errorf("%w: %w", ErrSettingPublicKey, err)
+		return ErrPublicKeyNotFound
 	}
 
 	_ = tx.Commit()
@@ -156,24 +203,53 @@ func (r *UserRepository) UpdateUser(ctx context.Context, userUUID *uuid.UUID, us
 	return foundUser, nil
 }
 
+func (r *UserRepository) SetLanguage(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error) {
+	user, err := r.GetUser(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingUser, err)
+	}
+
+	if err = r.DB.Model(user).Update("language", language).Error; err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingUser, err)
+	}
+
+	return user, nil
+}
+
+// DeleteUser deletes the user together with its logins, roles and tokens.
+// Orders and order items are kept for the totals, but no longer reference
+// the user.
 func (r *UserRepository) DeleteUser(ctx context.Context, userUUID *uuid.UUID) error {
 	tx := r.DB.Begin()
 
-	err := tx.Delete(&entity.User{UUID: userUUID}).Error
-	if err != nil {
-		_ = tx.Rollback()
-		return fmt.Errorf("%w: %w", ErrDeletingUser, err)
+	updates := []struct {
+		model  any
+		column string
+	}{
+		{&entity.Order{}, "initiator"},
+		{&entity.Order{}, "sugar_person"},
+		{&entity.OrderItem{}, "order_user"},
+	}
+
+	for _, update := range updates {
+		err := tx.Model(update.model).Where(fmt.Sprintf("%s = ?", update.column), userUUID).Update(update.column, nil).Error
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("%w: %w", ErrDeletingUser, err)
+		}
 	}
 
-	// TODO: check if the user is a password or matrix user
-	if err = tx.Where(&entity.MatrixUser{UserUUID: userUUID}).Delete(&entity.MatrixUser{}).Error; err != nil {
+	// matrix, password and ssh users as well as roles, tokens and the totp
+	// credential are removed by the cascade
+	result := tx.Delete(&entity.User{UUID: userUUID})
+	if result.Error != nil {
 		_ = tx.Rollback()
-		return fmt.Errorf("%w: %w", ErrDeletingUser, err)
+		return fmt.Errorf("%w: %w", ErrDeletingUser, result.Error)
 	}
 
-	if err = tx.Where(&entity.PasswordUser{UserUUID: userUUID}).Delete(&entity.PasswordUser{}).Error; err != nil {
+	if result.RowsAffected == 0 {
 		_ = tx.Rollback()
-		return fmt.Errorf("%w: %w", ErrDeletingUser, err)
+		return fmt.Errorf("%w: %w", ErrDeletingUser, ErrUserNotFound)
 	}
 
 	_ = tx.Commit()
@@ -287,7 +363,7 @@ func (r *UserRepository) GetAllPasswordUsers(ctx context.Context) ([]entity.Pass
 func (r *UserRepository) FindPasswordUser(ctx context.Context, username string) (*entity.PasswordUser, error) {
 	var passwordUser entity.PasswordUser
 
-	err := r.DB.Where(&entity.PasswordUser{Username: username}).Find(&passwordUser).Error
+	err := r.DB.Where(&entity.PasswordUser{Username: username}).First(&passwordUser).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, fmt.Errorf("%w: %w", ErrUserNotFound, err)
 	} else if err != nil {
@@ -451,3 +527,103 @@ func (r *UserRepository) DeleteSSHUser(ctx context.Context, userUUID *uuid.UUID)
 
 	return nil
 }
+
+func (r *UserRepository) GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+	roles := []entity.Role{}
+
+	err := r.DB.Model(&entity.UserRole{}).Where(&entity.UserRole{UserUUID: userUUID}).Order("role").Pluck("role", &roles).Error
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingRoles, err)
+	}
+
+	return roles, nil
+}
+
+func (r *UserRepository) CountUsersWithRole(ctx context.Context, role entity.Role) (int64, error) {
+	var count int64
+
+	err := r.DB.Model(&entity.UserRole{}).Where(&entity.UserRole{Role: role}).Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrGettingRoles, err)
+	}
+
+	return count, nil
+}
+
+func (r *UserRepository) GrantRole(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+	tx := r.DB.Begin()
+
+	err := tx.Where(&entity.UserRole{UserUUID: userUUID, Role: role}).FirstOrCreate(&entity.UserRole{UserUUID: userUUID, Role: role}).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrGrantingRole, err)
+	}
+
+	_ = tx.Commit()
+
+	return nil
+}
+
+func (r *UserRepository) RevokeRole(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+	tx := r.DB.Begin()
+
+	err := tx.Where(&entity.UserRole{UserUUID: userUUID, Role: role}).Delete(&entity.UserRole{}).Error
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%w: %w", ErrRevokingRole, err)
+	}
+
+	_ = tx.Commit()
+
+	return nil
+}
+
+// SetPassword updates the password of the user's password login, or creates
+// one with the given username if the user has none yet.
+func (r *UserRepository) SetPassword(
+	ctx context.Context,
+	userUUID *uuid.UUID,
+	username, passwordHash string,
+) (*entity.PasswordUser, error) {
+	tx := r.DB.Begin()
+
+	var passwordUser entity.PasswordUser
+
+	err := tx.Where(&entity.PasswordUser{UserUUID: userUUID}).First(&passwordUser).Error
+	if err == nil {
+		passwordUser.Password = passwordHash
+
+		if err = tx.Save(&passwordUser).Error; err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("%w: %w", ErrUpdatingUser, err)
+		}
+
+		_ = tx.Commit()
+
+		return &passwordUser, nil
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrGettingUser, err)
+	}
+
+	var count int64
+	if err = tx.Model(&entity.PasswordUser{}).Where(&entity.PasswordUser{Username: username}).Count(&count).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
+	}
+
+	if count > 0 {
+		_ = tx.Rollback()
+		return nil, ErrUserAlreadyExists
+	}
+
+	passwordUser = entity.PasswordUser{UserUUID: userUUID, Username: username, Password: passwordHash}
+	if err = tx.Create(&passwordUser).Error; err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("%w: %w", ErrCreatingUser, err)
+	}
+
+	_ = tx.Commit()
+
+	return &passwordUser, nil
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+// APITokenPrefix starts every api token, so they are easy to tell apart from
+// jwts and to find when they are leaked.
+const APITokenPrefix = "ordaa_"
+
+const (
+	apiTokenIDLength     = 6
+	apiTokenSecretLength = 32
+	maxAPITokenNameLen   = 64
+)
+
+var (
+	ErrCreatingAPIToken  = errors.New("could not create api token")
+	ErrRevokingAPIToken  = errors.New("could not revoke api token")
+	ErrUnknownTokenScope = errors.New("unknown token scope")
+	ErrInvalidTokenName  = errors.New("token name must not be empty or longer than 64 characters")
+	ErrInvalidAPIToken   = errors.New("invalid or expired api token")
+)
+
+// CreateAPIToken creates a token for the user and returns the secret token
+// string. It is only available now, only its hash is stored. A ttl of 0
+// creates a token that never expires.
+func (i *UserService) CreateAPIToken(
+	ctx context.Context,
+	userUUID *uuid.UUID,
+	name string,
+	scope entity.TokenScope,
+	ttl time.Duration,
+) (string, *entity.APIToken, error) {
+	if !slices.Contains(entity.TokenScopes, scope) {
+		return "", nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, ErrUnknownTokenScope)
+	}
+
+	if name == "" || len(name) > maxAPITokenNameLen {
+		return "", nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, ErrInvalidTokenName)
+	}
+
+	tokenID, secret, err := generateAPIToken()
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, err)
+	}
+
+	hash, err := i.passwordHasher().GeneratePasswordHash(secret)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, err)
+	}
+
+	apiToken := &entity.APIToken{
+		UserUUID:  userUUID,
+		Name:      name,
+		Scope:     scope,
+		TokenID:   tokenID,
+		TokenHash: hash,
+	}
+
+	if ttl > 0 {
+		expiresAt := time.Now().Add(ttl)
+		apiToken.ExpiresAt = &expiresAt
+	}
+
+	if apiToken, err = i.UserRepository.CreateAPIToken(ctx, apiToken); err != nil {
+		return "", nil, fmt.Errorf("%w: %w", ErrCreatingAPIToken, err)
+	}
+
+	return APITokenPrefix + tokenID + "_" + secret, apiToken, nil
+}
+
+func (i *UserService) GetAPITokens(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error) {
+	return i.UserRepository.GetAPITokensForUser(ctx, userUUID)
+}
+
+func (i *UserService) RevokeAPIToken(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error) {
+	apiToken, err := i.UserRepository.DeleteAPIToken(ctx, userUUID, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRevokingAPIToken, err)
+	}
+
+	return apiToken, nil
+}
+
+// AuthenticateAPIToken checks the token string against the stored hash and
+// records when the token was used.
+func (i *UserService) AuthenticateAPIToken(ctx context.Context, token string) (*entity.APIToken, error) {
+	tokenID, secret, ok := strings.Cut(strings.TrimPrefix(token, APITokenPrefix), "_")
+	if !ok || !strings.HasPrefix(token, APITokenPrefix) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	apiToken, err := i.UserRepository.GetAPITokenByTokenID(ctx, tokenID)
+	if errors.Is(err, repository.ErrAPITokenNotFound) {
+		return nil, ErrInvalidAPIToken
+	} else if err != nil {
+		return nil, err
+	}
+
+	match, err := crypto.ComparePasswordAndHash(secret, apiToken.TokenHash)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if !match || (apiToken.ExpiresAt != nil && apiToken.ExpiresAt.Before(now)) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	// a failed update should not lock the user out
+	if err = i.UserRepository.TouchAPIToken(ctx, apiToken.UUID, now); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("could not update last use of api token %s", apiToken.TokenID)
+	}
+
+	apiToken.LastUsedAt = &now
+
+	return apiToken, nil
+}
+
+func generateAPIToken() (tokenID, secret string, err error) {
+	random := make([]byte, apiTokenIDLength+apiTokenSecretLength)
+	if _, err = rand.Read(random); err != nil {
+		return "", "", err
+	}
+
+	return hex.EncodeToString(random[:apiTokenIDLength]), base64.RawURLEncoding.EncodeToString(random[apiTokenIDLength:]), nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrAuthorizing      = errors.New("could not check permissions")
+)
+
+type Permission string
+
+const (
+	PermissionManageMenus          = Permission("manage_menus")
+	PermissionManageUsers          = Permission("manage_users")
+	PermissionForceStateTransition = Permission("force_state_transition")
+)
+
+// rolePermissions lists what each role is allowed to do on top of what every
+// registered user can do anyway, i.e. starting orders and adding items.
+var rolePermissions = map[entity.Role][]Permission{
+	entity.RoleAdmin: {
+		PermissionManageMenus,
+		PermissionManageUsers,
+		PermissionForceStateTransition,
+	},
+	entity.RoleMember: {},
+}
+
+// Authorizer is the central permission check used by the services.
+type Authorizer interface {
+	Authorize(ctx context.Context, userUUID *uuid.UUID, permission Permission) error
+}
+
+//go:generate go tool moq -rm -out role_repository_mock.go . RoleRepository
+
+type RoleRepository interface {
+	GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error)
+}
+
+// AuthorizationService grants permissions based on the roles stored for a user.
+type AuthorizationService struct {
+	RoleRepository RoleRepository
+}
+
+func (s *AuthorizationService) Authorize(ctx context.Context, userUUID *uuid.UUID, permission Permission) error {
+	if userUUID == nil {
+		return ErrPermissionDenied
+	}
+
+	roles, err := s.RoleRepository.GetRoles(ctx, userUUID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrAuthorizing, err)
+	}
+
+	for _, role := range roles {
+		if slices.Contains(rolePermissions[role], permission) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s required", ErrPermissionDenied, permission)
+}
+
+// TrustedAuthorizer allows everything. It is meant for tools that run with
+// direct access to the database, where there is no user to check.
+type TrustedAuthorizer struct{}
+
+func (TrustedAuthorizer) Authorize(ctx context.Context, userUUID *uuid.UUID, permission Permission) error {
+	return nil
+}
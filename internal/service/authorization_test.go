@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	adminUUID  = uuid.Must(uuid.NewV4())
+	memberUUID = uuid.Must(uuid.NewV4())
+	brokenUUID = uuid.Must(uuid.NewV4())
+)
+
+// roleRepository knows the admin and the member, looking up the broken user
+// fails.
+var roleRepository = &RoleRepositoryMock{
+	GetRolesFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+		switch *userUUID {
+		case adminUUID:
+			return []entity.Role{entity.RoleAdmin, entity.RoleMember}, nil
+		case memberUUID:
+			return []entity.Role{entity.RoleMember}, nil
+		case brokenUUID:
+			return nil, errors.New("db down")
+		default:
+			return nil, nil
+		}
+	},
+}
+
+func TestAuthorize(t *testing.T) {
+	ctx := t.Context()
+
+	unknownUUID := uuid.Must(uuid.NewV4())
+	permissions := []Permission{PermissionManageMenus, PermissionManageUsers, PermissionForceStateTransition}
+
+	type testCase struct {
+		name     string
+		userUUID *uuid.UUID
+		err      error
+	}
+
+	testCases := []testCase{
+		{name: "admin", userUUID: &adminUUID},
+		{name: "member", userUUID: &memberUUID, err: ErrPermissionDenied},
+		{name: "user without roles", userUUID: &unknownUUID, err: ErrPermissionDenied},
+		{name: "anonymous", userUUID: nil, err: ErrPermissionDenied},
+		{name: "roles cannot be read", userUUID: &brokenUUID, err: ErrAuthorizing},
+	}
+
+	authorizer := &AuthorizationService{RoleRepository: roleRepository}
+
+	for _, tc := range testCases {
+		for _, permission := range permissions {
+			t.Run(tc.name+" "+string(permission), func(t *testing.T) {
+				err := authorizer.Authorize(ctx, tc.userUUID, permission)
+
+				if tc.err == nil {
+					assert.NoError(t, err)
+				} else {
+					assert.ErrorIs(t, err, tc.err)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+// maxUserNameLength is the size of the users.name column.
+const maxUserNameLength = 255
+
+// EnsureMatrixUser returns the account of the matrix user and registers it
+// first if there is none. The name of an existing account follows the
+// display name.
+func (i *UserService) EnsureMatrixUser(ctx context.Context, username, displayName string) (*entity.User, error) {
+	matrixUser, err := i.UserRepository.GetMatrixUserByUsername(ctx, username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return i.RegisterMatrixUser(ctx, username, displayName)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return i.renameUser(ctx, matrixUser, username, displayName)
+}
+
+// UpdateMatrixDisplayName renames the account of the matrix user after its
+// display name changed. Unlike EnsureMatrixUser, it fails with
+// repository.ErrUserNotFound for unregistered users.
+func (i *UserService) UpdateMatrixDisplayName(ctx context.Context, username, displayName string) (*entity.User, error) {
+	matrixUser, err := i.UserRepository.GetMatrixUserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.renameUser(ctx, matrixUser, username, displayName)
+}
+
+func (i *UserService) renameUser(ctx context.Context, matrixUser *entity.MatrixUser, username, displayName string) (*entity.User, error) {
+	user, err := i.UserRepository.GetUser(ctx, matrixUser.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := matrixUserName(username, displayName)
+	if user.Name == name {
+		return user, nil
+	}
+
+	return i.UserRepository.UpdateUser(ctx, user.UUID, &entity.User{Name: name})
+}
+
+func matrixUserName(username, displayName string) string {
+	name := strings.TrimSpace(displayName)
+	if name == "" {
+		// @alice:example.org becomes alice
+		name, _, _ = strings.Cut(strings.TrimPrefix(username, "@"), ":")
+	}
+
+	if runes := []rune(name); len(runes) > maxUserNameLength {
+		name = string(runes[:maxUserNameLength])
+	}
+
+	return name
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrSavingFavourite = errors.New("could not save favourite")
+	ErrAddingFavourite = errors.New("could not add favourite")
+	ErrEmptyFavourite  = errors.New("a favourite needs at least one item")
+)
+
+// FavouriteOrder is the outcome of AddFavouriteToOrder.
+type FavouriteOrder struct {
+	Added []entity.OrderItem
+	// Removed are the items of the favourite that are no longer on the menu.
+	Removed []entity.MenuItem
+}
+
+// SaveFavourite stores the items under the name for the user and menu. Items
+// are written like for AddOrderItemsToOrderByName, e.g. "M7" or "174x2".
+func (i *OrderService) SaveFavourite(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	menuName, name string,
+	items []string,
+) (*entity.Favourite, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrSavingFavourite, ErrEmptyFavourite)
+	}
+
+	menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSavingFavourite, err)
+	}
+
+	favourite := &entity.Favourite{UserUUID: currentUser, MenuUUID: menu.UUID, Name: name}
+
+	for _, item := range items {
+		var (
+			shortName string
+			quantity  int
+			menuItem  *entity.MenuItem
+		)
+
+		shortName, quantity, err = ParseItemQuantity(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSavingFavourite, err)
+		}
+
+		menuItem, err = i.resolveMenuItem(ctx, menu.UUID, shortName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrSavingFavourite, shortName, err)
+		}
+
+		favourite.Items = append(favourite.Items, entity.FavouriteItem{MenuItemUUID: menuItem.UUID, Quantity: quantity})
+	}
+
+	return i.OrderRepository.SaveFavourite(ctx, favourite)
+}
+
+// GetFavourites returns the favourites of the user, only those of one menu if
+// menuName is set.
+func (i *OrderService) GetFavourites(ctx context.Context, currentUser *uuid.UUID, menuName string) ([]entity.Favourite, error) {
+	var menuUUID *uuid.UUID
+
+	if menuName != "" {
+		menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+		if err != nil {
+			return nil, err
+		}
+
+		menuUUID = menu.UUID
+	}
+
+	return i.OrderRepository.GetFavourites(ctx, currentUser, menuUUID)
+}
+
+func (i *OrderService) DeleteFavourite(ctx context.Context, currentUser *uuid.UUID, menuName, name string) error {
+	menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return err
+	}
+
+	return i.OrderRepository.DeleteFavourite(ctx, currentUser, menu.UUID, name)
+}
+
+// AddFavouriteToOrder adds the items of the favourite to the active order of
+// the menu in the room. Items that were removed from the menu are skipped and returned,
+// so the user can be told about them.
+func (i *OrderService) AddFavouriteToOrder(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	roomID, menuName, name string,
+) (*FavouriteOrder, error) {
+	order, err := i.OrderRepository.GetActiveOrderByMenuName(ctx, roomID, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingFavourite, err)
+	}
+
+	favourite, err := i.OrderRepository.GetFavourite(ctx, currentUser, order.MenuUUID, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingFavourite, err)
+	}
+
+	favouriteOrder := &FavouriteOrder{}
+
+	var orderItems []entity.OrderItem
+
+	for _, item := range favourite.Items {
+		if item.MenuItem.DeletedAt.Valid {
+			favouriteOrder.Removed = append(favouriteOrder.Removed, item.MenuItem)
+			continue
+		}
+
+		for range item.Quantity {
+			orderItems = append(orderItems, entity.OrderItem{
+				User:         currentUser,
+				MenuItemUUID: item.MenuItemUUID,
+				OrderUUID:    order.UUID,
+			})
+		}
+	}
+
+	if len(orderItems) == 0 {
+		return favouriteOrder, nil
+	}
+
+	// all items are added in one go, so a failure does not leave half of the
+	// favourite in the order
+	if favouriteOrder.Added, err = i.OrderRepository.CreateOrderItems(ctx, order.UUID, orderItems); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingFavourite, err)
+	}
+
+	return favouriteOrder, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+const (
+	DefaultHistoryLength = 5
+	MaxHistoryLength     = 50
+)
+
+var (
+	ErrGettingOrderHistory = errors.New("could not get order history")
+	ErrReordering          = errors.New("could not order again")
+	ErrNoPreviousOrder     = errors.New("you have no delivered order of this menu")
+)
+
+// Reorder is the outcome of ReorderLast.
+type Reorder struct {
+	Previous entity.OrderSummary
+	Added    []entity.OrderItem
+	// Skipped counts items that are no longer on the menu.
+	Skipped int
+}
+
+// GetOrderHistory returns the latest delivered orders visible in the room,
+// optionally only those of one menu.
+func (i *OrderService) GetOrderHistory(ctx context.Context, roomID, menuName string, limit int) ([]entity.OrderSummary, error) {
+	if limit <= 0 {
+		limit = DefaultHistoryLength
+	}
+
+	filter := repository.OrderFilter{
+		RoomID: &roomID,
+		States: []entity.OrderState{entity.Delivered},
+		Limit:  min(limit, MaxHistoryLength),
+	}
+
+	if menuName != "" {
+		menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrGettingOrderHistory, err)
+		}
+
+		filter.MenuUUID = menu.UUID
+	}
+
+	orders, err := i.OrderRepository.FindOrders(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderHistory, err)
+	}
+
+	return orders, nil
+}
+
+// ReorderLast adds the items the user had in their latest delivered order of
+// the menu, no matter in which room, to the active order of that menu in the
+// room, at today's prices.
+func (i *OrderService) ReorderLast(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*Reorder, error) {
+	menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReordering, err)
+	}
+
+	activeOrder, err := i.OrderRepository.GetActiveOrderByMenu(ctx, roomID, menu.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReordering, err)
+	}
+
+	previous, err := i.OrderRepository.FindOrders(ctx, repository.OrderFilter{
+		MenuUUID:        menu.UUID,
+		ParticipantUUID: currentUser,
+		States:          []entity.OrderState{entity.Delivered},
+		Limit:           1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReordering, err)
+	}
+
+	if len(previous) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrReordering, ErrNoPreviousOrder)
+	}
+
+	orderItems, err := i.OrderRepository.GetAllOrderItemsForOrderAndUser(ctx, previous[0].UUID, currentUser)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReordering, err)
+	}
+
+	reorder := &Reorder{Previous: previous[0]}
+
+	var newItems []entity.OrderItem
+
+	for _, orderItem := range orderItems {
+		_, err = i.MenuRepository.GetMenuItem(ctx, orderItem.MenuItemUUID)
+		if errors.Is(err, repository.ErrMenuItemNotFound) {
+			reorder.Skipped++
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrReordering, err)
+		}
+
+		newItems = append(newItems, entity.OrderItem{
+			User:         currentUser,
+			MenuItemUUID: orderItem.MenuItemUUID,
+			OrderUUID:    activeOrder.UUID,
+		})
+	}
+
+	if len(newItems) == 0 {
+		return reorder, nil
+	}
+
+	// all items are added in one go, so a failure does not leave half of the
+	// previous order in the order
+	if reorder.Added, err = i.OrderRepository.CreateOrderItems(ctx, activeOrder.UUID, newItems); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReordering, err)
+	}
+
+	return reorder, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+const (
+	// itemCandidates is the number of candidates listed for ambiguous names.
+	itemCandidates = 3
+	// itemLead is how much better the best match must score than the second
+	// best to be taken without asking.
+	itemLead = 0.15
+)
+
+var ErrAmbiguousItem = errors.New("ambiguous item")
+
+// resolveMenuItem looks up an item of the menu by its short name, or by a
+// fuzzy search on the dish name if the item is quoted like "paneer makhni".
+func (i *OrderService) resolveMenuItem(ctx context.Context, menuUUID *uuid.UUID, item string) (*entity.MenuItem, error) {
+	name, quoted := unquoteItem(item)
+	if !quoted {
+		return i.MenuRepository.GetMenuItemByShortName(ctx, menuUUID, item)
+	}
+
+	matches, err := i.MenuRepository.SearchMenuItems(ctx, menuUUID, name, itemCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("%w: %s", repository.ErrMenuItemNotFound, name)
+	case len(matches) == 1 || matches[0].Score-matches[1].Score >= itemLead:
+		return &matches[0].MenuItem, nil
+	}
+
+	candidates := make([]string, 0, len(matches))
+	for _, match := range matches {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", match.ShortName, match.Name))
+	}
+
+	return nil, fmt.Errorf("%w: '%s' could be %s", ErrAmbiguousItem, name, strings.Join(candidates, ", "))
+}
+
+func unquoteItem(item string) (string, bool) {
+	if len(item) < 2 || !strings.HasPrefix(item, `"`) || !strings.HasSuffix(item, `"`) {
+		return item, false
+	}
+
+	return strings.TrimSpace(item[1 : len(item)-1]), true
+}
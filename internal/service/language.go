@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrSettingLanguage     = errors.New("could not set language")
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+)
+
+// Languages are the languages replies can be sent in, the first one is the
+// default.
+var Languages = []string{"en", "de"}
+
+// SetLanguage changes the language of replies to the user, an empty language
+// falls back to the language of the room again.
+func (i *UserService) SetLanguage(ctx context.Context, userUUID *uuid.UUID, language string) (*entity.User, error) {
+	if err := checkLanguage(language); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSettingLanguage, err)
+	}
+
+	user, err := i.UserRepository.SetLanguage(ctx, userUUID, language)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSettingLanguage, err)
+	}
+
+	return user, nil
+}
+
+func checkLanguage(language string) error {
+	if language != "" && !slices.Contains(Languages, language) {
+		return fmt.Errorf("%w: %s, the languages are %s", ErrUnsupportedLanguage, language, strings.Join(Languages, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+const (
+	LinkCodeTTL = 10 * time.Minute
+
+	linkCodeLength = 8
+	// linkCodeAlphabet leaves out characters that are easily confused
+	linkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+var (
+	ErrLinkingAccount = errors.New("could not link account")
+	ErrAlreadyLinked  = errors.New("identity already belongs to this account")
+	ErrPrivilegedLink = errors.New("an admin account cannot be merged into another account, create the link code with it instead")
+)
+
+// CreateLinkCode issues a code that links another identity to the account of
+// userUUID when it is redeemed within LinkCodeTTL.
+func (i *UserService) CreateLinkCode(ctx context.Context, userUUID *uuid.UUID) (*entity.LinkCode, error) {
+	code, err := generateLinkCode()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, err)
+	}
+
+	return i.UserRepository.CreateLinkCode(ctx, &entity.LinkCode{
+		UserUUID:  userUUID,
+		Code:      code,
+		ExpiresAt: time.Now().Add(LinkCodeTTL),
+	})
+}
+
+// LinkUser merges the account of currentUser into the account that issued
+// the code. Logins, orders, settings and non-privileged roles of currentUser
+// are kept. Accounts with a privileged role have to issue the code
+// themselves, so redeeming a code never hands out privileges.
+func (i *UserService) LinkUser(ctx context.Context, currentUser *uuid.UUID, code string) (*entity.User, error) {
+	roles, err := i.UserRepository.GetRoles(ctx, currentUser)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, err)
+	}
+
+	if slices.ContainsFunc(roles, func(role entity.Role) bool { return slices.Contains(entity.PrivilegedRoles, role) }) {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, ErrPrivilegedLink)
+	}
+
+	code = normalizeLinkCode(code)
+
+	linkCode, err := i.UserRepository.GetLinkCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, err)
+	}
+
+	if *linkCode.UserUUID == *currentUser {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, ErrAlreadyLinked)
+	}
+
+	// the code is only used up if the merge succeeds
+	user, err := i.UserRepository.MergeUsers(ctx, currentUser, code)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, err)
+	}
+
+	return user, nil
+}
+
+// LinkMatrixUser adds a matrix identity that has no account yet to the
+// account that issued the code.
+func (i *UserService) LinkMatrixUser(ctx context.Context, code, username string) (*entity.User, error) {
+	matrixUser, err := i.UserRepository.CreateLinkedMatrixUser(ctx, normalizeLinkCode(code), &entity.MatrixUser{Username: username})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, err)
+	}
+
+	return i.UserRepository.GetUser(ctx, matrixUser.UserUUID)
+}
+
+// LinkSSHUser adds a public key that has no account yet to the account that
+// issued the code.
+func (i *UserService) LinkSSHUser(ctx context.Context, code, publicKey string) (*entity.User, error) {
+	sshUser, err := i.UserRepository.CreateLinkedSSHUser(ctx, normalizeLinkCode(code), &entity.SSHUser{PublicKey: publicKey})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLinkingAccount, err)
+	}
+
+	return i.UserRepository.GetUser(ctx, sshUser.UserUUID)
+}
+
+func generateLinkCode() (string, error) {
+	random := make([]byte, linkCodeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, linkCodeLength)
+	for i, b := range random {
+		code[i] = linkCodeAlphabet[int(b)%len(linkCodeAlphabet)]
+	}
+
+	return string(code), nil
+}
+
+func normalizeLinkCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+func TestLinkUser(t *testing.T) {
+	ctx := t.Context()
+
+	issuerUUID := uuid.Must(uuid.NewV4())
+
+	type testCase struct {
+		name        string
+		currentUser *uuid.UUID
+		code        string
+		merged      bool
+		err         error
+	}
+
+	testCases := []testCase{
+		{name: "member links", currentUser: &memberUUID, code: "abcd-efgh", merged: true},
+		{name: "admin links", currentUser: &adminUUID, code: "ABCDEFGH", err: ErrPrivilegedLink},
+		{name: "own code", currentUser: &issuerUUID, code: "ABCDEFGH", err: ErrAlreadyLinked},
+		{name: "unknown code", currentUser: &memberUUID, code: "HGFEDCBA", err: repository.ErrLinkCodeNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			users := &UserRepositoryMock{
+				GetRolesFunc: roleRepository.GetRolesFunc,
+				GetLinkCodeFunc: func(ctx context.Context, code string) (*entity.LinkCode, error) {
+					if code != "ABCDEFGH" {
+						return nil, repository.ErrLinkCodeNotFound
+					}
+
+					return &entity.LinkCode{UserUUID: &issuerUUID, Code: code}, nil
+				},
+				MergeUsersFunc: func(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error) {
+					return &entity.User{UUID: &issuerUUID, Name: "luca"}, nil
+				},
+			}
+			userService := &UserService{UserRepository: users}
+
+			user, err := userService.LinkUser(ctx, tc.currentUser, tc.code)
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				// the code is only used up by merging
+				assert.Empty(t, users.MergeUsersCalls())
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, &issuerUUID, user.UUID)
+
+			if assert.Len(t, users.MergeUsersCalls(), 1) {
+				assert.Equal(t, tc.currentUser, users.MergeUsersCalls()[0].SourceUUID)
+				assert.Equal(t, "ABCDEFGH", users.MergeUsersCalls()[0].Code)
+			}
+		})
+	}
+}
+
+func TestLinkUserAfterFailedMerge(t *testing.T) {
+	ctx := t.Context()
+
+	issuerUUID := uuid.Must(uuid.NewV4())
+
+	// like the repository, merging uses up the code only if it succeeds
+	codes := map[string]*uuid.UUID{"ABCDEFGH": &issuerUUID}
+	conflict := true
+
+	users := &UserRepositoryMock{
+		GetRolesFunc: roleRepository.GetRolesFunc,
+		GetLinkCodeFunc: func(ctx context.Context, code string) (*entity.LinkCode, error) {
+			userUUID, ok := codes[code]
+			if !ok {
+				return nil, repository.ErrLinkCodeNotFound
+			}
+
+			return &entity.LinkCode{UserUUID: userUUID, Code: code}, nil
+		},
+		MergeUsersFunc: func(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error) {
+			userUUID, ok := codes[code]
+			if !ok {
+				return nil, repository.ErrLinkCodeNotFound
+			}
+
+			if conflict {
+				return nil, fmt.Errorf("%w: %w", repository.ErrMergingUsers, repository.ErrConflictingAPIToken)
+			}
+
+			delete(codes, code)
+
+			return &entity.User{UUID: userUUID}, nil
+		},
+	}
+	userService := &UserService{UserRepository: users}
+
+	_, err := userService.LinkUser(ctx, &memberUUID, "ABCD-EFGH")
+	assert.ErrorIs(t, err, repository.ErrConflictingAPIToken)
+
+	// the conflicting token was revoked, the same code works now
+	conflict = false
+
+	user, err := userService.LinkUser(ctx, &memberUUID, "ABCD-EFGH")
+	if assert.NoError(t, err) {
+		assert.Equal(t, &issuerUUID, user.UUID)
+	}
+
+	_, err = userService.LinkUser(ctx, &memberUUID, "ABCD-EFGH")
+	assert.ErrorIs(t, err, repository.ErrLinkCodeNotFound)
+}
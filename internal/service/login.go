@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	ErrLoggingIn          = errors.New("could not log in")
+)
+
+// Login checks the password of a password user and returns the user it
+// belongs to. The totp code, or a recovery code, is only checked for users
+// that set up totp. With a link code the account of the password user is
+// linked to the account that issued the code, which is returned instead.
+func (i *UserService) Login(ctx context.Context, username, password, totpCode, linkCode string) (*entity.User, error) {
+	passwordUser, err := i.UserRepository.FindPasswordUser(ctx, username)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		// hash the password anyway, so unknown usernames take as long as a
+		// wrong password and cannot be told apart by the response time
+		_, _ = crypto.ComparePasswordAndHash(password, i.dummyPasswordHash(ctx))
+		return nil, ErrInvalidCredentials
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoggingIn, err)
+	}
+
+	match, err := crypto.ComparePasswordAndHash(password, passwordUser.Password)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoggingIn, err)
+	}
+
+	if !match {
+		return nil, ErrInvalidCredentials
+	}
+
+	err = i.checkSecondFactor(ctx, passwordUser.UserUUID, totpCode)
+	if errors.Is(err, ErrTOTPRequired) || errors.Is(err, ErrInvalidTOTPCode) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoggingIn, err)
+	}
+
+	i.rehashPassword(ctx, passwordUser, password)
+
+	if linkCode != "" {
+		return i.LinkUser(ctx, passwordUser.UserUUID, linkCode)
+	}
+
+	return i.UserRepository.GetUser(ctx, passwordUser.UserUUID)
+}
+
+// dummyPasswordHash returns a hash of a random password with the configured
+// parameters. It is created on first use.
+func (i *UserService) dummyPasswordHash(ctx context.Context) string {
+	i.dummyHashOnce.Do(func() {
+		var err error
+
+		i.dummyHash, err = i.passwordHasher().GeneratePasswordHash(rand.Text())
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("could not create dummy password hash")
+		}
+	})
+
+	return i.dummyHash
+}
+
+// rehashPassword updates the stored hash when it was created with other
+// argon2 parameters than the configured ones. The login succeeds anyway.
+func (i *UserService) rehashPassword(ctx context.Context, passwordUser *entity.PasswordUser, password string) {
+	hasher := i.passwordHasher()
+	if !hasher.NeedsRehash(passwordUser.Password) {
+		return
+	}
+
+	hash, err := hasher.GeneratePasswordHash(password)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("could not rehash password of %s", passwordUser.Username)
+		return
+	}
+
+	passwordUser.Password = hash
+
+	if _, err = i.UserRepository.UpdatePasswordUser(ctx, passwordUser.UUID, passwordUser); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("could not update password hash of %s", passwordUser.Username)
+	}
+}
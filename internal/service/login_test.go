@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+func TestLogin(t *testing.T) {
+	ctx := t.Context()
+
+	hasher := crypto.NewArgon2idHash(1, 16, 64, 1, 32)
+
+	hash, err := hasher.GeneratePasswordHash("secret")
+	require.NoError(t, err)
+
+	type testCase struct {
+		name     string
+		username string
+		password string
+		err      error
+	}
+
+	testCases := []testCase{
+		{name: "valid password", username: "luca", password: "secret"},
+		{name: "wrong password", username: "luca", password: "wrong", err: ErrInvalidCredentials},
+		{name: "unknown username", username: "jana", password: "secret", err: ErrInvalidCredentials},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userService := &UserService{
+				PasswordHasher: hasher,
+				UserRepository: &UserRepositoryMock{
+					FindPasswordUserFunc: func(ctx context.Context, username string) (*entity.PasswordUser, error) {
+						if username != "luca" {
+							return nil, repository.ErrUserNotFound
+						}
+
+						return &entity.PasswordUser{UserUUID: &memberUUID, Username: username, Password: hash}, nil
+					},
+					GetTOTPCredentialFunc: func(ctx context.Context, userUUID *uuid.UUID) (*entity.TOTPCredential, error) {
+						return nil, repository.ErrTOTPNotFound
+					},
+					GetUserFunc: func(ctx context.Context, userUUID *uuid.UUID) (*entity.User, error) {
+						return &entity.User{UUID: userUUID, Name: "luca"}, nil
+					},
+				},
+			}
+
+			user, err := userService.Login(ctx, tc.username, tc.password, "", "")
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else if assert.NoError(t, err) {
+				assert.Equal(t, &memberUUID, user.UUID)
+			}
+
+			// unknown usernames are checked against a dummy hash
+			assert.Equal(t, tc.username != "luca", userService.dummyHash != "")
+		})
+	}
+}
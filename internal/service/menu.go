@@ -2,10 +2,22 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gofrs/uuid"
 
 	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var (
+	ErrImportingMenu      = errors.New("could not import menu")
+	ErrDuplicateShortName = errors.New("duplicate short name in menu")
+	ErrAddingMenuItem     = errors.New("could not add menu item")
+	ErrUpdatingMenuItem   = errors.New("could not update menu item")
+	ErrRemovingMenuItem   = errors.New("could not remove menu item")
+	ErrMenuItemExists     = errors.New("menu item with this short name already exists")
 )
 
 type MenuRepository interface {
@@ -14,15 +26,23 @@ type MenuRepository interface {
 	GetMenuByName(ctx context.Context, name string) (*entity.Menu, error)
 	GetMenuItem(ctx context.Context, menuItemUUID *uuid.UUID) (*entity.MenuItem, error)
 	GetMenuItemByShortName(ctx context.Context, menuUUID *uuid.UUID, shortName string) (*entity.MenuItem, error)
+	GetRemovedMenuItems(ctx context.Context, menuUUID *uuid.UUID) ([]entity.MenuItem, error)
+	SearchMenuItems(ctx context.Context, menuUUID *uuid.UUID, query string, limit int) ([]entity.MenuItemMatch, error)
 	CreateMenu(ctx context.Context, menu *entity.Menu) (*entity.Menu, error)
 	UpdateMenu(ctx context.Context, menuUUID *uuid.UUID, menu *entity.Menu) (*entity.Menu, error)
 	CreateMenuItem(ctx context.Context, menuItem *entity.MenuItem) (*entity.MenuItem, error)
+	UpdateMenuItem(ctx context.Context, menuItemUUID *uuid.UUID, menuItem *entity.MenuItem) (*entity.MenuItem, error)
 	DeleteMenuItem(ctx context.Context, menuItemUUID *uuid.UUID) error
 	DeleteMenu(ctx context.Context, menuUUID *uuid.UUID) error
+	ApplyMenuDiff(ctx context.Context, diff *entity.MenuDiff) error
+	GetMenuAliases(ctx context.Context, menuUUID *uuid.UUID) ([]entity.MenuAlias, error)
+	CreateMenuAlias(ctx context.Context, alias *entity.MenuAlias) (*entity.MenuAlias, error)
+	DeleteMenuAlias(ctx context.Context, alias string) (*entity.MenuAlias, error)
 }
 
 type MenuService struct {
 	MenuRepository MenuRepository
+	Authorizer     Authorizer
 }
 
 func (s *MenuService) GetAllMenus(ctx context.Context) ([]entity.Menu, error) {
@@ -33,22 +53,218 @@ func (s *MenuService) GetMenu(ctx context.Context, uuid *uuid.UUID) (*entity.Men
 	return s.MenuRepository.GetMenu(ctx, uuid)
 }
 
-func (s *MenuService) CreateMenu(ctx context.Context, user *entity.Menu) (*entity.Menu, error) {
-	return s.MenuRepository.CreateMenu(ctx, user)
+func (s *MenuService) GetMenuByName(ctx context.Context, name string) (*entity.Menu, error) {
+	return s.MenuRepository.GetMenuByName(ctx, name)
 }
 
-func (s *MenuService) UpdateMenu(ctx context.Context, uuid *uuid.UUID, user *entity.Menu) (*entity.Menu, error) {
-	return s.MenuRepository.UpdateMenu(ctx, uuid, user)
+func (s *MenuService) CreateMenu(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, err
+	}
+
+	return s.MenuRepository.CreateMenu(ctx, menu)
 }
 
-func (s *MenuService) DeleteMenu(ctx context.Context, uuid *uuid.UUID) error {
-	return s.MenuRepository.DeleteMenu(ctx, uuid)
+func (s *MenuService) UpdateMenu(ctx context.Context, currentUser, uuid *uuid.UUID, menu *entity.Menu) (*entity.Menu, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, err
+	}
+
+	return s.MenuRepository.UpdateMenu(ctx, uuid, menu)
 }
 
-func (s *MenuService) ImportMenu(ctx context.Context, menu *entity.Menu) error {
-	if _, err := s.MenuRepository.CreateMenu(ctx, menu); err != nil {
+func (s *MenuService) DeleteMenu(ctx context.Context, currentUser, uuid *uuid.UUID) error {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
 		return err
 	}
 
-	return nil
+	return s.MenuRepository.DeleteMenu(ctx, uuid)
+}
+
+func (s *MenuService) AddMenuItem(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	menuName string,
+	menuItem *entity.MenuItem,
+) (*entity.MenuItem, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingMenuItem, err)
+	}
+
+	menu, err := s.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingMenuItem, err)
+	}
+
+	_, err = s.MenuRepository.GetMenuItemByShortName(ctx, menu.UUID, menuItem.ShortName)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingMenuItem, ErrMenuItemExists)
+	} else if !errors.Is(err, repository.ErrMenuItemNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrAddingMenuItem, err)
+	}
+
+	menuItem.MenuUUID = menu.UUID
+
+	return s.MenuRepository.CreateMenuItem(ctx, menuItem)
+}
+
+func (s *MenuService) UpdateMenuItemPrice(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	menuName, shortName string,
+	price int,
+) (*entity.MenuItem, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingMenuItem, err)
+	}
+
+	menuItem, err := s.getMenuItemByName(ctx, menuName, shortName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingMenuItem, err)
+	}
+
+	menuItem.Price = price
+
+	return s.MenuRepository.UpdateMenuItem(ctx, menuItem.UUID, menuItem)
+}
+
+// RemoveMenuItem takes the item off the menu. The item is only soft deleted,
+// so orders that already contain it stay intact.
+func (s *MenuService) RemoveMenuItem(ctx context.Context, currentUser *uuid.UUID, menuName, shortName string) (*entity.MenuItem, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRemovingMenuItem, err)
+	}
+
+	menuItem, err := s.getMenuItemByName(ctx, menuName, shortName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRemovingMenuItem, err)
+	}
+
+	if err = s.MenuRepository.DeleteMenuItem(ctx, menuItem.UUID); err != nil {
+		return nil, err
+	}
+
+	return menuItem, nil
+}
+
+func (s *MenuService) getMenuItemByName(ctx context.Context, menuName, shortName string) (*entity.MenuItem, error) {
+	menu, err := s.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.MenuRepository.GetMenuItemByShortName(ctx, menu.UUID, shortName)
+}
+
+// ImportMenu creates the menu or, if a menu with the same name already exists,
+// updates it to match the imported one. The returned diff describes what was
+// (or, with dryRun set, what would have been) changed.
+func (s *MenuService) ImportMenu(ctx context.Context, currentUser *uuid.UUID, menu *entity.Menu, dryRun bool) (*entity.MenuDiff, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImportingMenu, err)
+	}
+
+	existingMenu, err := s.MenuRepository.GetMenuByName(ctx, menu.Name)
+	if err != nil && !errors.Is(err, repository.ErrMenuNotFound) {
+		return nil, fmt.Errorf("%w: %w", ErrImportingMenu, err)
+	}
+
+	var removedItems []entity.MenuItem
+
+	if existingMenu != nil {
+		removedItems, err = s.MenuRepository.GetRemovedMenuItems(ctx, existingMenu.UUID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrImportingMenu, err)
+		}
+	}
+
+	diff, err := diffMenu(existingMenu, removedItems, menu)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImportingMenu, err)
+	}
+
+	if dryRun || diff.Empty() {
+		return diff, nil
+	}
+
+	if diff.NewMenu {
+		if _, err = s.MenuRepository.CreateMenu(ctx, menu); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrImportingMenu, err)
+		}
+
+		return diff, nil
+	}
+
+	if err = s.MenuRepository.ApplyMenuDiff(ctx, diff); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImportingMenu, err)
+	}
+
+	return diff, nil
+}
+
+// diffMenu compares the imported menu with the existing one. Added items that
+// share the short name of one of the removed items revive that item.
+func diffMenu(existingMenu *entity.Menu, removedItems []entity.MenuItem, menu *entity.Menu) (*entity.MenuDiff, error) {
+	importedItems := make(map[string]entity.MenuItem, len(menu.Items))
+
+	for _, item := range menu.Items {
+		if _, ok := importedItems[item.ShortName]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateShortName, item.ShortName)
+		}
+
+		importedItems[item.ShortName] = item
+	}
+
+	if existingMenu == nil {
+		return &entity.MenuDiff{Name: menu.Name, URL: menu.URL, NewMenu: true, Added: menu.Items}, nil
+	}
+
+	diff := &entity.MenuDiff{
+		MenuUUID: existingMenu.UUID,
+		Name:     existingMenu.Name,
+		URL:      menu.URL,
+		OldURL:   existingMenu.URL,
+	}
+
+	existingItems := make(map[string]entity.MenuItem, len(existingMenu.Items))
+
+	for _, item := range existingMenu.Items {
+		existingItems[item.ShortName] = item
+
+		importedItem, ok := importedItems[item.ShortName]
+		if !ok {
+			diff.Removed = append(diff.Removed, item)
+			continue
+		}
+
+		if importedItem.Name != item.Name || importedItem.Price != item.Price {
+			diff.Changed = append(diff.Changed, entity.MenuItemChange{Old: item, New: importedItem})
+		}
+	}
+
+	removed := make(map[string]entity.MenuItem, len(removedItems))
+
+	for _, item := range removedItems {
+		if _, ok := removed[item.ShortName]; !ok {
+			removed[item.ShortName] = item
+		}
+	}
+
+	for _, item := range menu.Items {
+		if _, ok := existingItems[item.ShortName]; ok {
+			continue
+		}
+
+		diff.Added = append(diff.Added, item)
+
+		if removedItem, ok := removed[item.ShortName]; ok {
+			if diff.Revived == nil {
+				diff.Revived = map[string]entity.MenuItem{}
+			}
+
+			diff.Revived[item.ShortName] = removedItem
+		}
+	}
+
+	return diff, nil
 }
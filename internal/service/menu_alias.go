@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	ErrAddingMenuAlias   = errors.New("could not add menu alias")
+	ErrRemovingMenuAlias = errors.New("could not remove menu alias")
+)
+
+// AddMenuAlias lets the menu also be referred to by alias in all commands
+// that take a menu name. Like RemoveMenuAlias it returns the menu and all of
+// its aliases.
+func (s *MenuService) AddMenuAlias(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	menuName, alias string,
+) (*entity.Menu, []entity.MenuAlias, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrAddingMenuAlias, err)
+	}
+
+	menu, err := s.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrAddingMenuAlias, err)
+	}
+
+	if _, err = s.MenuRepository.CreateMenuAlias(ctx, &entity.MenuAlias{Alias: alias, MenuUUID: menu.UUID}); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrAddingMenuAlias, err)
+	}
+
+	aliases, err := s.MenuRepository.GetMenuAliases(ctx, menu.UUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrAddingMenuAlias, err)
+	}
+
+	return menu, aliases, nil
+}
+
+// RemoveMenuAlias deletes the alias.
+func (s *MenuService) RemoveMenuAlias(ctx context.Context, currentUser *uuid.UUID, alias string) (*entity.Menu, []entity.MenuAlias, error) {
+	if err := s.Authorizer.Authorize(ctx, currentUser, PermissionManageMenus); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrRemovingMenuAlias, err)
+	}
+
+	menuAlias, err := s.MenuRepository.DeleteMenuAlias(ctx, alias)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrRemovingMenuAlias, err)
+	}
+
+	menu, err := s.MenuRepository.GetMenu(ctx, menuAlias.MenuUUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrRemovingMenuAlias, err)
+	}
+
+	aliases, err := s.MenuRepository.GetMenuAliases(ctx, menu.UUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrRemovingMenuAlias, err)
+	}
+
+	return menu, aliases, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+func TestDiffMenu(t *testing.T) {
+	menuUUID := uuid.Must(uuid.NewV4())
+	pizzaUUID := uuid.Must(uuid.NewV4())
+	pastaUUID := uuid.Must(uuid.NewV4())
+	saladUUID := uuid.Must(uuid.NewV4())
+	oldSaladUUID := uuid.Must(uuid.NewV4())
+
+	pizza := entity.MenuItem{UUID: &pizzaUUID, ShortName: "1", Name: "Pizza", Price: 900, MenuUUID: &menuUUID}
+	pasta := entity.MenuItem{UUID: &pastaUUID, ShortName: "2", Name: "Pasta", Price: 1000, MenuUUID: &menuUUID}
+	salad := entity.MenuItem{UUID: &saladUUID, ShortName: "3", Name: "Salad", Price: 700, MenuUUID: &menuUUID}
+	oldSalad := entity.MenuItem{UUID: &oldSaladUUID, ShortName: "3", Name: "Salad", Price: 600, MenuUUID: &menuUUID}
+
+	existingMenu := &entity.Menu{UUID: &menuUUID, Name: "pizza", URL: "https://pizza.example", Items: []entity.MenuItem{pizza, pasta}}
+
+	imported := func(url string, items ...entity.MenuItem) *entity.Menu {
+		menu := &entity.Menu{Name: "pizza", URL: url}
+
+		for _, item := range items {
+			menu.Items = append(menu.Items, entity.MenuItem{ShortName: item.ShortName, Name: item.Name, Price: item.Price})
+		}
+
+		return menu
+	}
+
+	type testCase struct {
+		name         string
+		existingMenu *entity.Menu
+		removedItems []entity.MenuItem
+		menu         *entity.Menu
+		diff         *entity.MenuDiff
+		err          error
+	}
+
+	testCases := []testCase{
+		{
+			name: "new menu",
+			menu: imported("https://pizza.example", pizza, pasta),
+			diff: &entity.MenuDiff{
+				Name:    "pizza",
+				URL:     "https://pizza.example",
+				NewMenu: true,
+				Added:   imported("", pizza, pasta).Items,
+			},
+		},
+		{
+			name:         "unchanged",
+			existingMenu: existingMenu,
+			menu:         imported("https://pizza.example", pizza, pasta),
+			diff:         &entity.MenuDiff{MenuUUID: &menuUUID, Name: "pizza", URL: "https://pizza.example", OldURL: "https://pizza.example"},
+		},
+		{
+			name:         "url changed",
+			existingMenu: existingMenu,
+			menu:         imported("https://pizza.example/menu", pizza, pasta),
+			diff:         &entity.MenuDiff{MenuUUID: &menuUUID, Name: "pizza", URL: "https://pizza.example/menu", OldURL: "https://pizza.example"},
+		},
+		{
+			name:         "added",
+			existingMenu: existingMenu,
+			menu:         imported("https://pizza.example", pizza, pasta, salad),
+			diff: &entity.MenuDiff{
+				MenuUUID: &menuUUID,
+				Name:     "pizza",
+				URL:      "https://pizza.example",
+				OldURL:   "https://pizza.example",
+				Added:    imported("", salad).Items,
+			},
+		},
+		{
+			name:         "changed",
+			existingMenu: existingMenu,
+			menu:         imported("https://pizza.example", entity.MenuItem{ShortName: "1", Name: "Pizza Margherita", Price: 950}, pasta),
+			diff: &entity.MenuDiff{
+				MenuUUID: &menuUUID,
+				Name:     "pizza",
+				URL:      "https://pizza.example",
+				OldURL:   "https://pizza.example",
+				Changed: []entity.MenuItemChange{
+					{Old: pizza, New: entity.MenuItem{ShortName: "1", Name: "Pizza Margherita", Price: 950}},
+				},
+			},
+		},
+		{
+			name:         "removed",
+			existingMenu: existingMenu,
+			menu:         imported("https://pizza.example", pizza),
+			diff: &entity.MenuDiff{
+				MenuUUID: &menuUUID,
+				Name:     "pizza",
+				URL:      "https://pizza.example",
+				OldURL:   "https://pizza.example",
+				Removed:  []entity.MenuItem{pasta},
+			},
+		},
+		{
+			name:         "re-added",
+			existingMenu: existingMenu,
+			removedItems: []entity.MenuItem{salad, oldSalad},
+			menu:         imported("https://pizza.example", pizza, pasta, entity.MenuItem{ShortName: "3", Name: "Salad", Price: 750}),
+			diff: &entity.MenuDiff{
+				MenuUUID: &menuUUID,
+				Name:     "pizza",
+				URL:      "https://pizza.example",
+				OldURL:   "https://pizza.example",
+				Added:    []entity.MenuItem{{ShortName: "3", Name: "Salad", Price: 750}},
+				Revived:  map[string]entity.MenuItem{"3": salad},
+			},
+		},
+		{
+			name:         "removed item not re-added",
+			existingMenu: existingMenu,
+			removedItems: []entity.MenuItem{salad},
+			menu:         imported("https://pizza.example", pizza, pasta),
+			diff:         &entity.MenuDiff{MenuUUID: &menuUUID, Name: "pizza", URL: "https://pizza.example", OldURL: "https://pizza.example"},
+		},
+		{
+			name:         "duplicate short name",
+			existingMenu: existingMenu,
+			menu:         imported("https://pizza.example", pizza, entity.MenuItem{ShortName: "1", Name: "Pizza Hawaii", Price: 1100}),
+			err:          ErrDuplicateShortName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := diffMenu(tc.existingMenu, tc.removedItems, tc.menu)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.diff, diff)
+		})
+	}
+}
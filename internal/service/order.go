@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/gofrs/uuid"
 
@@ -19,27 +22,48 @@ var (
 	ErrActiveOrderForMenuAlreadyExists = errors.New("there is already an active order the specified menu")
 	ErrAddingOrderItem                 = errors.New("adding order item")
 	ErrCannotReopenOrder               = errors.New("only the initiator can reopen the order")
+	ErrRemovingOrderItem               = errors.New("could not remove order item")
+	ErrNotOrderItemOwner               = errors.New("order item belongs to another user")
+	ErrOrderNotOpen                    = errors.New("order is not open anymore")
+	ErrUnknownShortNames               = errors.New("unknown short names")
+	ErrInvalidQuantity                 = fmt.Errorf("quantity must be between 1 and %d", MaxItemQuantity)
 )
 
+// MaxItemQuantity limits how often an item can be added at once.
+const MaxItemQuantity = 20
+
+// itemQuantityRegex matches items with a quantity, e.g. 174x2 or a quoted
+// dish name followed by x2.
+var itemQuantityRegex = regexp.MustCompile(`^(\w+|"[^"]+")x(\d+)$`)
+
 type OrderRepository interface {
 	GetAllOrders(ctx context.Context) ([]entity.Order, error)
+	FindOrders(ctx context.Context, filter repository.OrderFilter) ([]entity.OrderSummary, error)
 	GetOrder(ctx context.Context, uuid *uuid.UUID) (*entity.Order, error)
-	GetActiveOrderByMenu(ctx context.Context, menuUUID *uuid.UUID) (*entity.Order, error)
-	GetActiveOrderByMenuName(ctx context.Context, menuName string) (*entity.Order, error)
+	GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error)
+	GetActiveOrderByMenuName(ctx context.Context, roomID, menuName string) (*entity.Order, error)
 	GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error)
 	GetAllOrderItemsForOrderAndUser(ctx context.Context, orderUUID *uuid.UUID, userUUID *uuid.UUID) ([]entity.OrderItem, error)
+	GetOrderItemSummaries(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItemSummary, error)
 	GetOrderItem(ctx context.Context, uuid *uuid.UUID) (*entity.OrderItem, error)
 	CreateOrderItem(ctx context.Context, orderUUID *uuid.UUID, orderItem *entity.OrderItem) (*entity.OrderItem, error)
+	CreateOrderItems(ctx context.Context, orderUUID *uuid.UUID, orderItems []entity.OrderItem) ([]entity.OrderItem, error)
 	CreateOrder(ctx context.Context, order *entity.Order) (*entity.Order, error)
-	UpdateOrder(ctx context.Context, currentUser *uuid.UUID, orderUUID *uuid.UUID, order *entity.Order) (*entity.Order, error)
+	UpdateOrder(ctx context.Context, orderUUID *uuid.UUID, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error)
 	UpdateOrderItem(ctx context.Context, orderItemUUID *uuid.UUID, userUUID *uuid.UUID, orderItem *entity.OrderItem) (*entity.OrderItem, error)
 	DeleteOrderItem(ctx context.Context, orderItemUUID *uuid.UUID) error
 	DeleteOrder(ctx context.Context, orderUUID *uuid.UUID) error
+
+	GetFavourite(ctx context.Context, userUUID, menuUUID *uuid.UUID, name string) (*entity.Favourite, error)
+	GetFavourites(ctx context.Context, userUUID, menuUUID *uuid.UUID) ([]entity.Favourite, error)
+	SaveFavourite(ctx context.Context, favourite *entity.Favourite) (*entity.Favourite, error)
+	DeleteFavourite(ctx context.Context, userUUID, menuUUID *uuid.UUID, name string) error
 }
 
 type OrderService struct {
 	OrderRepository OrderRepository
 	MenuRepository  MenuRepository
+	Authorizer      Authorizer
 }
 
 func (i *OrderService) GetAllOrders(ctx context.Context) ([]entity.Order, error) {
@@ -50,12 +74,22 @@ func (i *OrderService) GetOrder(ctx context.Context, uuid *uuid.UUID) (*entity.O
 	return i.OrderRepository.GetOrder(ctx, uuid)
 }
 
-func (i *OrderService) GetActiveOrderByMenu(ctx context.Context, menuUUID *uuid.UUID) (*entity.Order, error) {
-	return i.OrderRepository.GetActiveOrderByMenu(ctx, menuUUID)
+// GetActiveOrderByMenu returns the active order of the menu visible in the
+// room, see entity.Order.
+func (i *OrderService) GetActiveOrderByMenu(ctx context.Context, roomID string, menuUUID *uuid.UUID) (*entity.Order, error) {
+	return i.OrderRepository.GetActiveOrderByMenu(ctx, roomID, menuUUID)
+}
+
+func (i *OrderService) GetActiveOrderByMenuName(ctx context.Context, roomID, name string) (*entity.Order, error) {
+	return i.OrderRepository.GetActiveOrderByMenuName(ctx, roomID, name)
+}
+
+func (i *OrderService) GetAllOrderItems(ctx context.Context, orderUUID *uuid.UUID) ([]entity.OrderItem, error) {
+	return i.OrderRepository.GetAllOrderItems(ctx, orderUUID)
 }
 
-func (i *OrderService) GetActiveOrderByMenuName(ctx context.Context, name string) (*entity.Order, error) {
-	return i.OrderRepository.GetActiveOrderByMenuName(ctx, name)
+func (i *OrderService) GetAllOrderItemsForOrderAndUser(ctx context.Context, orderUUID, userUUID *uuid.UUID) ([]entity.OrderItem, error) {
+	return i.OrderRepository.GetAllOrderItemsForOrderAndUser(ctx, orderUUID, userUUID)
 }
 
 func (i *OrderService) CreateOrder(ctx context.Context, currentUser *uuid.UUID, order *entity.Order) (*entity.Order, error) {
@@ -68,6 +102,10 @@ func (i *OrderService) UpdateOrder(ctx context.Context, currentUser, uuid *uuid.
 		return nil, err
 	}
 
+	if err = i.authorizeStateTransition(ctx, currentUser, existingOrder, order.State); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingOrder, err)
+	}
+
 	switch existingOrder.State {
 	case entity.Open:
 		existingOrder.OrderDeadline = order.OrderDeadline
@@ -77,10 +115,6 @@ func (i *OrderService) UpdateOrder(ctx context.Context, currentUser, uuid *uuid.
 			return nil, fmt.Errorf("%w: %w: from %s to %s", ErrUpdatingOrder, ErrOrderStateTransitionInvalid, existingOrder.State, order.State)
 		}
 	case entity.Finalized:
-		if order.State == entity.Open && *currentUser != *existingOrder.Initiator {
-			return nil, fmt.Errorf("%w: %w", ErrUpdatingOrder, ErrCannotReopenOrder)
-		}
-
 		if order.State == entity.Ordered || order.State == entity.Open {
 			existingOrder.State = order.State
 		} else if order.State != existingOrder.State {
@@ -101,16 +135,37 @@ func (i *OrderService) UpdateOrder(ctx context.Context, currentUser, uuid *uuid.
 
 	existingOrder.SugarPerson = order.SugarPerson
 
-	return i.OrderRepository.UpdateOrder(ctx, currentUser, uuid, order)
+	return i.OrderRepository.UpdateOrder(ctx, uuid, currentUser, existingOrder)
 }
 
-func (i *OrderService) CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, menuName string) (*entity.Order, error) {
+// authorizeStateTransition allows the initiator to move their order through
+// its states. Everybody else needs the permission to force the transition.
+func (i *OrderService) authorizeStateTransition(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	order *entity.Order,
+	state entity.OrderState,
+) error {
+	if state == order.State || (currentUser != nil && order.Initiator != nil && *currentUser == *order.Initiator) {
+		return nil
+	}
+
+	err := i.Authorizer.Authorize(ctx, currentUser, PermissionForceStateTransition)
+	if err != nil && order.State == entity.Finalized && state == entity.Open {
+		return ErrCannotReopenOrder
+	}
+
+	return err
+}
+
+// CreateOrderForMenuName starts an order of the menu in the room.
+func (i *OrderService) CreateOrderForMenuName(ctx context.Context, currentUser *uuid.UUID, roomID, menuName string) (*entity.Order, error) {
 	menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrCreatingOrder, err)
 	}
 
-	activeOrder, err := i.OrderRepository.GetActiveOrderByMenu(ctx, menu.UUID)
+	activeOrder, err := i.OrderRepository.GetActiveOrderByMenu(ctx, roomID, menu.UUID)
 	if err != nil && !errors.Is(err, repository.ErrOrderNotFound) {
 		return nil, fmt.Errorf("%w: %w", ErrCreatingOrder, err)
 	}
@@ -119,7 +174,7 @@ func (i *OrderService) CreateOrderForMenuName(ctx context.Context, currentUser *
 		return nil, ErrActiveOrderForMenuAlreadyExists
 	}
 
-	order, err := i.OrderRepository.CreateOrder(ctx, &entity.Order{Initiator: currentUser, MenuUUID: menu.UUID})
+	order, err := i.OrderRepository.CreateOrder(ctx, &entity.Order{Initiator: currentUser, MenuUUID: menu.UUID, RoomID: roomID})
 	if err != nil {
 		return nil, err
 	}
@@ -127,8 +182,8 @@ func (i *OrderService) CreateOrderForMenuName(ctx context.Context, currentUser *
 	return order, nil
 }
 
-func (i *OrderService) AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, shortName, menuName string) error {
-	order, err := i.OrderRepository.GetActiveOrderByMenuName(ctx, menuName)
+func (i *OrderService) AddOrderItemToOrderByName(ctx context.Context, currentUser *uuid.UUID, roomID, shortName, menuName string) error {
+	order, err := i.OrderRepository.GetActiveOrderByMenuName(ctx, roomID, menuName)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrAddingOrderItem, err)
 	}
@@ -150,3 +205,98 @@ func (i *OrderService) AddOrderItemToOrderByName(ctx context.Context, currentUse
 
 	return nil
 }
+
+// AddOrderItemsToOrderByName adds all items to the active order of the menu
+// in the room.
+// Items are short names or quoted dish names with an optional quantity like
+// "174x2". Nothing is added if one of the items is unknown or ambiguous.
+func (i *OrderService) AddOrderItemsToOrderByName(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	roomID, menuName string,
+	items []string,
+) ([]entity.OrderItem, error) {
+	order, err := i.OrderRepository.GetActiveOrderByMenuName(ctx, roomID, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingOrderItem, err)
+	}
+
+	orderItems := []entity.OrderItem{}
+	unknown := []string{}
+
+	for _, item := range items {
+		var (
+			shortName string
+			quantity  int
+			menuItem  *entity.MenuItem
+		)
+
+		shortName, quantity, err = ParseItemQuantity(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAddingOrderItem, err)
+		}
+
+		menuItem, err = i.resolveMenuItem(ctx, order.MenuUUID, shortName)
+		if errors.Is(err, repository.ErrMenuItemNotFound) {
+			unknown = append(unknown, shortName)
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAddingOrderItem, err)
+		}
+
+		for range quantity {
+			orderItems = append(orderItems, entity.OrderItem{User: currentUser, MenuItemUUID: menuItem.UUID})
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%w: %w: %s", ErrAddingOrderItem, ErrUnknownShortNames, strings.Join(unknown, ", "))
+	}
+
+	orderItems, err = i.OrderRepository.CreateOrderItems(ctx, order.UUID, orderItems)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingOrderItem, err)
+	}
+
+	return orderItems, nil
+}
+
+// ParseItemQuantity splits an item like "174x2" into its short name and
+// quantity. Items without a quantity are added once.
+func ParseItemQuantity(item string) (string, int, error) {
+	match := itemQuantityRegex.FindStringSubmatch(item)
+	if match == nil {
+		return item, 1, nil
+	}
+
+	quantity, err := strconv.Atoi(match[2])
+	if err != nil || quantity < 1 || quantity > MaxItemQuantity {
+		return "", 0, ErrInvalidQuantity
+	}
+
+	return match[1], quantity, nil
+}
+
+// RemoveOrderItem removes one of the current user's items while the order is
+// still open.
+func (i *OrderService) RemoveOrderItem(ctx context.Context, currentUser, orderItemUUID *uuid.UUID) error {
+	orderItem, err := i.OrderRepository.GetOrderItem(ctx, orderItemUUID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRemovingOrderItem, err)
+	}
+
+	if currentUser == nil || orderItem.User == nil || *orderItem.User != *currentUser {
+		return fmt.Errorf("%w: %w", ErrRemovingOrderItem, ErrNotOrderItemOwner)
+	}
+
+	order, err := i.OrderRepository.GetOrder(ctx, orderItem.OrderUUID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRemovingOrderItem, err)
+	}
+
+	if order.State != entity.Open {
+		return fmt.Errorf("%w: %w", ErrRemovingOrderItem, ErrOrderNotOpen)
+	}
+
+	return i.OrderRepository.DeleteOrderItem(ctx, orderItemUUID)
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+func TestAuthorizeStateTransition(t *testing.T) {
+	ctx := t.Context()
+
+	initiatorUUID := uuid.Must(uuid.NewV4())
+
+	type testCase struct {
+		name        string
+		currentUser *uuid.UUID
+		from        entity.OrderState
+		to          entity.OrderState
+		err         error
+	}
+
+	testCases := []testCase{
+		{name: "initiator finalizes", currentUser: &initiatorUUID, from: entity.Open, to: entity.Finalized},
+		{name: "initiator reopens", currentUser: &initiatorUUID, from: entity.Finalized, to: entity.Open},
+		{name: "initiator marks delivered", currentUser: &initiatorUUID, from: entity.Ordered, to: entity.Delivered},
+		{name: "admin finalizes", currentUser: &adminUUID, from: entity.Open, to: entity.Finalized},
+		{name: "admin reopens", currentUser: &adminUUID, from: entity.Finalized, to: entity.Open},
+		{name: "member keeps state", currentUser: &memberUUID, from: entity.Open, to: entity.Open},
+		{name: "member finalizes", currentUser: &memberUUID, from: entity.Open, to: entity.Finalized, err: ErrPermissionDenied},
+		{name: "member orders", currentUser: &memberUUID, from: entity.Finalized, to: entity.Ordered, err: ErrPermissionDenied},
+		{name: "member reopens", currentUser: &memberUUID, from: entity.Finalized, to: entity.Open, err: ErrCannotReopenOrder},
+		{name: "anonymous finalizes", currentUser: nil, from: entity.Open, to: entity.Finalized, err: ErrPermissionDenied},
+	}
+
+	orderService := &OrderService{Authorizer: &AuthorizationService{RoleRepository: roleRepository}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order := &entity.Order{Initiator: &initiatorUUID, State: tc.from}
+
+			err := orderService.authorizeStateTransition(ctx, tc.currentUser, order, tc.to)
+
+			if tc.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var ErrGettingOrderOverview = errors.New("could not get overview of order")
+
+// OrderOverview is everything the status summary, the call sheet and the
+// payment reminder show about an active order.
+type OrderOverview struct {
+	Order   entity.OrderSummary
+	MenuURL string
+	// Items are sorted by user and dish.
+	Items []entity.OrderItemSummary
+}
+
+// GetOrderOverview returns the overview of the active order of the menu
+// visible in the room.
+func (i *OrderService) GetOrderOverview(ctx context.Context, roomID, menuName string) (*OrderOverview, error) {
+	menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderOverview, err)
+	}
+
+	order, err := i.OrderRepository.GetActiveOrderByMenu(ctx, roomID, menu.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderOverview, err)
+	}
+
+	orders, err := i.OrderRepository.FindOrders(ctx, repository.OrderFilter{OrderUUID: order.UUID, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderOverview, err)
+	}
+
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderOverview, repository.ErrOrderNotFound)
+	}
+
+	items, err := i.OrderRepository.GetOrderItemSummaries(ctx, order.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingOrderOverview, err)
+	}
+
+	return &OrderOverview{Order: orders[0], MenuURL: menu.URL, Items: items}, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+// MinPasswordLength is the number of characters a password needs at least.
+const MinPasswordLength = 12
+
+var (
+	ErrSettingPassword  = errors.New("could not set password")
+	ErrPasswordTooShort = fmt.Errorf("password must have at least %d characters", MinPasswordLength)
+)
+
+// SetPassword sets the password for the web and api login of the user. A
+// password login with the given username is created if the user has none,
+// otherwise only the password of the existing one changes.
+func (i *UserService) SetPassword(ctx context.Context, userUUID *uuid.UUID, username, password string) (*entity.PasswordUser, error) {
+	if utf8.RuneCountInString(password) < MinPasswordLength {
+		return nil, fmt.Errorf("%w: %w", ErrSettingPassword, ErrPasswordTooShort)
+	}
+
+	hash, err := i.passwordHasher().GeneratePasswordHash(password)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSettingPassword, err)
+	}
+
+	passwordUser, err := i.UserRepository.SetPassword(ctx, userUUID, username, hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSettingPassword, err)
+	}
+
+	return passwordUser, nil
+}
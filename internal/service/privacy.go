@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+var ErrExportingUserData = errors.New("could not export user data")
+
+// ExportUserData returns everything stored about the user as json.
+func (i *UserService) ExportUserData(ctx context.Context, currentUser, userUUID *uuid.UUID) ([]byte, error) {
+	if err := i.authorizeSelfOrManageUsers(ctx, currentUser, userUUID); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrExportingUserData, err)
+	}
+
+	userData, err := i.UserRepository.GetUserData(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrExportingUserData, err)
+	}
+
+	userData.ExportedAt = time.Now().UTC()
+
+	data, err := json.MarshalIndent(userData, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrExportingUserData, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,83 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+)
+
+// Ensure, that RoleRepositoryMock does implement RoleRepository.
+// If this is not the case, regenerate this file with moq.
+var _ RoleRepository = &RoleRepositoryMock{}
+
+// RoleRepositoryMock is a mock implementation of RoleRepository.
+//
+//	func TestSomethingThatUsesRoleRepository(t *testing.T) {
+//
+//		// make and configure a mocked RoleRepository
+//		mockedRoleRepository := &RoleRepositoryMock{
+//			GetRolesFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+//				panic("mock out the GetRoles method")
+//			},
+//		}
+//
+//		// use mockedRoleRepository in code that requires RoleRepository
+//		// and then make assertions.
+//
+//	}
+type RoleRepositoryMock struct {
+	// GetRolesFunc mocks the GetRoles method.
+	GetRolesFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetRoles holds details about calls to the GetRoles method.
+		GetRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+	}
+	lockGetRoles sync.RWMutex
+}
+
+// GetRoles calls GetRolesFunc.
+func (mock *RoleRepositoryMock) GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+	if mock.GetRolesFunc == nil {
+		panic("RoleRepositoryMock.GetRolesFunc: method is nil but RoleRepository.GetRoles was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetRoles.Lock()
+	mock.calls.GetRoles = append(mock.calls.GetRoles, callInfo)
+	mock.lockGetRoles.Unlock()
+	return mock.GetRolesFunc(ctx, userUUID)
+}
+
+// GetRolesCalls gets all the calls that were made to GetRoles.
+// Check the length with:
+//
+//	len(mockedRoleRepository.GetRolesCalls())
+func (mock *RoleRepositoryMock) GetRolesCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetRoles.RLock()
+	calls = mock.calls.GetRoles
+	mock.lockGetRoles.RUnlock()
+	return calls
+}
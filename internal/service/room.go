@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var (
+	ErrGettingRoomSettings  = errors.New("could not get room settings")
+	ErrUpdatingRoomSettings = errors.New("could not update room settings")
+	ErrUnknownRoomSetting   = errors.New("unknown room setting")
+	ErrInvalidRoomSetting   = errors.New("invalid room setting")
+)
+
+const (
+	RoomSettingPrefix       = "prefix"
+	RoomSettingLanguage     = "language"
+	RoomSettingDefaultMenu  = "default-menu"
+	RoomSettingAutoRegister = "auto-register"
+)
+
+// RoomSettingNames lists the settings in the order they are shown.
+var RoomSettingNames = []string{RoomSettingPrefix, RoomSettingLanguage, RoomSettingDefaultMenu, RoomSettingAutoRegister}
+
+// maxPrefixLength is the length of the prefix column.
+const maxPrefixLength = 32
+
+type RoomRepository interface {
+	GetRoomSettings(ctx context.Context, roomID string) (*entity.RoomSettings, error)
+	SaveRoomSettings(ctx context.Context, settings *entity.RoomSettings) (*entity.RoomSettings, error)
+}
+
+// RoomService manages the settings of matrix rooms. Who may change them is
+// decided by the room, so it is up to the caller to check it.
+type RoomService struct {
+	RoomRepository RoomRepository
+	MenuRepository MenuRepository
+}
+
+// GetRoomSettings returns the settings of the room, which are all empty if
+// none were stored yet.
+func (s *RoomService) GetRoomSettings(ctx context.Context, roomID string) (*entity.RoomSettings, error) {
+	settings, err := s.RoomRepository.GetRoomSettings(ctx, roomID)
+	if errors.Is(err, repository.ErrRoomSettingsNotFound) {
+		return &entity.RoomSettings{RoomID: roomID}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGettingRoomSettings, err)
+	}
+
+	return settings, nil
+}
+
+// UpdateRoomSetting changes one of the RoomSettingNames, an empty value resets
+// it to the default.
+func (s *RoomService) UpdateRoomSetting(ctx context.Context, roomID, setting, value string) (*entity.RoomSettings, error) {
+	settings, err := s.GetRoomSettings(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingRoomSettings, err)
+	}
+
+	if err = s.applyRoomSetting(ctx, settings, setting, value); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingRoomSettings, err)
+	}
+
+	if _, err = s.RoomRepository.SaveRoomSettings(ctx, settings); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUpdatingRoomSettings, err)
+	}
+
+	return settings, nil
+}
+
+func (s *RoomService) applyRoomSetting(ctx context.Context, settings *entity.RoomSettings, setting, value string) error {
+	switch setting {
+	case RoomSettingPrefix:
+		if strings.ContainsFunc(value, unicode.IsSpace) || utf8.RuneCountInString(value) > maxPrefixLength {
+			return fmt.Errorf("%w: prefix must be a single word of at most %d characters", ErrInvalidRoomSetting, maxPrefixLength)
+		}
+
+		settings.Prefix = value
+	case RoomSettingLanguage:
+		if err := checkLanguage(value); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidRoomSetting, err)
+		}
+
+		settings.Language = value
+	case RoomSettingDefaultMenu:
+		settings.DefaultMenuUUID, settings.DefaultMenu = nil, nil
+
+		if value != "" {
+			menu, err := s.MenuRepository.GetMenuByName(ctx, value)
+			if err != nil {
+				return err
+			}
+
+			settings.DefaultMenuUUID, settings.DefaultMenu = menu.UUID, menu
+		}
+	case RoomSettingAutoRegister:
+		settings.AutoRegister = nil
+
+		if value != "" {
+			if value != "on" && value != "off" {
+				return fmt.Errorf("%w: auto-register must be on or off", ErrInvalidRoomSetting)
+			}
+
+			autoRegister := value == "on"
+			settings.AutoRegister = &autoRegister
+		}
+	default:
+		return fmt.Errorf("%w: %s, the settings are %s", ErrUnknownRoomSetting, setting, strings.Join(RoomSettingNames, ", "))
+	}
+
+	return nil
+}
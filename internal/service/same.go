@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var (
+	ErrCopyingOrderItems = errors.New("could not copy order items")
+	ErrNothingToCopy     = errors.New("the user has no items in an open order")
+	ErrSeveralOpenOrders = errors.New("the user has items in several open orders, name the menu")
+	ErrCopyingOwnItems   = errors.New("you cannot copy your own items")
+)
+
+// CopiedOrderItems is the outcome of CopyOrderItems.
+type CopiedOrderItems struct {
+	MenuName string
+	Copied   []entity.MenuItem
+	// Subtotal is the sum of all items of the current user in the order,
+	// including the ones there before.
+	Subtotal int
+}
+
+// CopyOrderItems adds the items another user has in the open order of the
+// menu in the room to the current user's items. Without a menu name the only
+// open order in the room the other user takes part in is used.
+func (i *OrderService) CopyOrderItems(
+	ctx context.Context,
+	currentUser, userUUID *uuid.UUID,
+	roomID, menuName string,
+) (*CopiedOrderItems, error) {
+	if currentUser != nil && userUUID != nil && *currentUser == *userUUID {
+		return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, ErrCopyingOwnItems)
+	}
+
+	order, err := i.openOrderOf(ctx, userUUID, roomID, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, err)
+	}
+
+	orderItems, err := i.OrderRepository.GetAllOrderItemsForOrderAndUser(ctx, order.UUID, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, err)
+	}
+
+	if len(orderItems) == 0 {
+		return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, ErrNothingToCopy)
+	}
+
+	copied := &CopiedOrderItems{MenuName: order.MenuName}
+
+	newItems := make([]entity.OrderItem, 0, len(orderItems))
+
+	for _, orderItem := range orderItems {
+		var menuItem *entity.MenuItem
+
+		menuItem, err = i.MenuRepository.GetMenuItem(ctx, orderItem.MenuItemUUID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, err)
+		}
+
+		newItems = append(newItems, entity.OrderItem{
+			User:         currentUser,
+			MenuItemUUID: orderItem.MenuItemUUID,
+			OrderUUID:    order.UUID,
+		})
+
+		copied.Copied = append(copied.Copied, *menuItem)
+	}
+
+	// all items are copied in one go, so a failure does not leave half of
+	// them in the order
+	if _, err = i.OrderRepository.CreateOrderItems(ctx, order.UUID, newItems); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, err)
+	}
+
+	ownItems, err := i.OrderRepository.GetAllOrderItemsForOrderAndUser(ctx, order.UUID, currentUser)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCopyingOrderItems, err)
+	}
+
+	for _, orderItem := range ownItems {
+		copied.Subtotal += orderItem.Price
+	}
+
+	return copied, nil
+}
+
+func (i *OrderService) openOrderOf(ctx context.Context, userUUID *uuid.UUID, roomID, menuName string) (*entity.OrderSummary, error) {
+	filter := repository.OrderFilter{
+		RoomID:          &roomID,
+		ParticipantUUID: userUUID,
+		States:          []entity.OrderState{entity.Open},
+	}
+
+	if menuName != "" {
+		menu, err := i.MenuRepository.GetMenuByName(ctx, menuName)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.MenuUUID = menu.UUID
+	}
+
+	orders, err := i.OrderRepository.FindOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(orders) {
+	case 0:
+		return nil, ErrNothingToCopy
+	case 1:
+		return &orders[0], nil
+	default:
+		return nil, ErrSeveralOpenOrders
+	}
+}
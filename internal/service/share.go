@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var (
+	ErrSharingOrder     = errors.New("could not share order")
+	ErrOrderOfOtherRoom = errors.New("the order was started in another room")
+)
+
+// ShareOrder makes the active order of the menu in the room visible in all
+// rooms, or only in the room again if shared is false. A shared order must be
+// the only active order of its menu, as it would hide the orders of other
+// rooms otherwise. Like state transitions, only the initiator may change it
+// unless forced.
+func (i *OrderService) ShareOrder(
+	ctx context.Context,
+	currentUser *uuid.UUID,
+	roomID, menuName string,
+	shared bool,
+) (*entity.Order, error) {
+	order, err := i.OrderRepository.GetActiveOrderByMenuName(ctx, roomID, menuName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSharingOrder, err)
+	}
+
+	if order.RoomID != roomID {
+		return nil, fmt.Errorf("%w: %w", ErrSharingOrder, ErrOrderOfOtherRoom)
+	}
+
+	if currentUser == nil || order.Initiator == nil || *currentUser != *order.Initiator {
+		if err = i.Authorizer.Authorize(ctx, currentUser, PermissionForceStateTransition); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSharingOrder, err)
+		}
+	}
+
+	if shared && !order.Shared {
+		var activeOrders []entity.OrderSummary
+
+		activeOrders, err = i.OrderRepository.FindOrders(ctx, repository.OrderFilter{
+			MenuUUID: order.MenuUUID,
+			States:   []entity.OrderState{entity.Open, entity.Finalized, entity.Ordered},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSharingOrder, err)
+		}
+
+		if len(activeOrders) > 1 {
+			return nil, fmt.Errorf("%w: %w", ErrSharingOrder, ErrActiveOrderForMenuAlreadyExists)
+		}
+	}
+
+	order.Shared = shared
+
+	if _, err = i.OrderRepository.UpdateOrder(ctx, order.UUID, currentUser, order); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSharingOrder, err)
+	}
+
+	return order, nil
+}
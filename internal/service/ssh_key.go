@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+var (
+	ErrAddingPublicKey   = errors.New("could not add public key")
+	ErrRemovingPublicKey = errors.New("could not remove public key")
+)
+
+// AddPublicKey validates a key in authorized_keys format and adds it to the
+// user, so it can be used to log in via ssh.
+func (i *UserService) AddPublicKey(ctx context.Context, userUUID *uuid.UUID, authorizedKey string) (*entity.SSHUser, error) {
+	publicKey, _, err := crypto.NormalizePublicKey(authorizedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAddingPublicKey, err)
+	}
+
+	return i.UserRepository.AddPublicKey(ctx, userUUID, publicKey)
+}
+
+func (i *UserService) GetPublicKeys(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error) {
+	return i.UserRepository.GetSSHUsersForUser(ctx, userUUID)
+}
+
+// RemovePublicKey removes the key of the user with the given SHA256
+// fingerprint. The "SHA256:" prefix of the fingerprint is optional.
+func (i *UserService) RemovePublicKey(ctx context.Context, userUUID *uuid.UUID, fingerprint string) (*entity.SSHUser, error) {
+	sshUsers, err := i.UserRepository.GetSSHUsersForUser(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRemovingPublicKey, err)
+	}
+
+	fingerprint = "SHA256:" + strings.TrimPrefix(strings.TrimSpace(fingerprint), "SHA256:")
+
+	for _, sshUser := range sshUsers {
+		if crypto.PublicKeyFingerprint(sshUser.PublicKey) != fingerprint {
+			continue
+		}
+
+		if err = i.UserRepository.RemovePublicKey(ctx, userUUID, sshUser.UUID); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRemovingPublicKey, err)
+		}
+
+		return &sshUser, nil
+	}
+
+	return nil, fmt.Errorf("%w: %w", ErrRemovingPublicKey, repository.ErrPublicKeyNotFound)
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+)
+
+const (
+	// TOTPIssuer is shown as the account's origin in authenticator apps.
+	TOTPIssuer = "ordaa"
+
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrEnrollingTOTP       = errors.New("could not set up totp")
+	ErrDisablingTOTP       = errors.New("could not disable totp")
+	ErrTOTPAlreadyEnrolled = errors.New("totp is already set up")
+	ErrTOTPRequired        = errors.New("totp code required")
+	ErrInvalidTOTPCode     = errors.New("invalid totp or recovery code")
+)
+
+// EnrollTOTP creates a new totp secret for the user and returns its
+// provisioning uri. The secret is only required at login after ConfirmTOTP.
+func (i *UserService) EnrollTOTP(ctx context.Context, userUUID *uuid.UUID) (string, error) {
+	user, err := i.UserRepository.GetUser(ctx, userUUID)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	existing, err := i.UserRepository.GetTOTPCredential(ctx, userUUID)
+	if err != nil && !errors.Is(err, repository.ErrTOTPNotFound) {
+		return "", fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	if existing != nil && existing.ConfirmedAt != nil {
+		return "", fmt.Errorf("%w: %w", ErrEnrollingTOTP, ErrTOTPAlreadyEnrolled)
+	}
+
+	secret, err := crypto.GenerateTOTPSecret()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	if _, err = i.UserRepository.CreateTOTPCredential(ctx, &entity.TOTPCredential{UserUUID: userUUID, Secret: secret}); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	return crypto.TOTPProvisioningURI(TOTPIssuer, user.Name, secret), nil
+}
+
+// ConfirmTOTP activates the enrolled secret once the user entered a valid
+// code and returns the recovery codes. Only their hashes are stored.
+func (i *UserService) ConfirmTOTP(ctx context.Context, userUUID *uuid.UUID, code string) ([]string, error) {
+	totpCredential, err := i.UserRepository.GetTOTPCredential(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	if totpCredential.ConfirmedAt != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, ErrTOTPAlreadyEnrolled)
+	}
+
+	step, ok, err := crypto.ValidateTOTP(totpCredential.Secret, code, time.Now(), totpCredential.LastUsedStep)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, ErrInvalidTOTPCode)
+	}
+
+	recoveryCodes, err := crypto.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	hashes := make([]string, 0, len(recoveryCodes))
+
+	for _, recoveryCode := range recoveryCodes {
+		hash, hashErr := i.passwordHasher().GeneratePasswordHash(crypto.NormalizeRecoveryCode(recoveryCode))
+		if hashErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, hashErr)
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	if err = i.UserRepository.ConfirmTOTPCredential(ctx, userUUID, step, hashes); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrEnrollingTOTP, err)
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableTOTP removes the second factor after checking a current totp or
+// recovery code, so a stolen session alone cannot turn it off.
+func (i *UserService) DisableTOTP(ctx context.Context, userUUID *uuid.UUID, code string) error {
+	totpCredential, err := i.UserRepository.GetTOTPCredential(ctx, userUUID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDisablingTOTP, err)
+	}
+
+	if totpCredential.ConfirmedAt != nil {
+		if err = i.verifySecondFactor(ctx, totpCredential, code); err != nil {
+			return fmt.Errorf("%w: %w", ErrDisablingTOTP, err)
+		}
+	}
+
+	if err = i.UserRepository.DeleteTOTPCredential(ctx, userUUID); err != nil {
+		return fmt.Errorf("%w: %w", ErrDisablingTOTP, err)
+	}
+
+	return nil
+}
+
+// checkSecondFactor enforces the totp code for users that confirmed a totp
+// credential. Users without one pass without a code.
+func (i *UserService) checkSecondFactor(ctx context.Context, userUUID *uuid.UUID, code string) error {
+	totpCredential, err := i.UserRepository.GetTOTPCredential(ctx, userUUID)
+	if errors.Is(err, repository.ErrTOTPNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if totpCredential.ConfirmedAt == nil {
+		return nil
+	}
+
+	if code == "" {
+		return ErrTOTPRequired
+	}
+
+	return i.verifySecondFactor(ctx, totpCredential, code)
+}
+
+// verifySecondFactor accepts a totp code or, if the input looks like one, an
+// unused recovery code.
+func (i *UserService) verifySecondFactor(ctx context.Context, totpCredential *entity.TOTPCredential, code string) error {
+	normalized := crypto.NormalizeRecoveryCode(code)
+	if len(normalized) > crypto.TOTPDigits {
+		return i.useRecoveryCode(ctx, totpCredential.UserUUID, normalized)
+	}
+
+	step, ok, err := crypto.ValidateTOTP(totpCredential.Secret, code, time.Now(), totpCredential.LastUsedStep)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return ErrInvalidTOTPCode
+	}
+
+	if err = i.UserRepository.UseTOTPStep(ctx, totpCredential.UserUUID, step); errors.Is(err, repository.ErrTOTPCodeUsed) {
+		return ErrInvalidTOTPCode
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (i *UserService) useRecoveryCode(ctx context.Context, userUUID *uuid.UUID, code string) error {
+	recoveryCodes, err := i.UserRepository.GetUnusedRecoveryCodes(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	for _, recoveryCode := range recoveryCodes {
+		match, compareErr := crypto.ComparePasswordAndHash(code, recoveryCode.CodeHash)
+		if compareErr != nil {
+			return compareErr
+		}
+
+		if !match {
+			continue
+		}
+
+		if err = i.UserRepository.UseRecoveryCode(ctx, recoveryCode.UUID); errors.Is(err, repository.ErrRecoveryCodeUsed) {
+			return ErrInvalidTOTPCode
+		}
+
+		return err
+	}
+
+	return ErrInvalidTOTPCode
+}
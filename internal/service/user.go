@@ -2,18 +2,35 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/gofrs/uuid"
 
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
 	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
 )
 
+var (
+	ErrUnknownRole  = errors.New("unknown role")
+	ErrLastAdmin    = errors.New("cannot revoke the admin role from the last admin")
+	ErrGrantingRole = errors.New("could not grant role")
+	ErrRevokingRole = errors.New("could not revoke role")
+)
+
+//go:generate go tool moq -rm -out user_repository_mock.go . UserRepository
+
 type UserRepository interface {
 	GetAllUsers(ctx context.Context) ([]entity.User, error)
 	GetUser(ctx context.Context, uuid *uuid.UUID) (*entity.User, error)
 	GetUserByName(ctx context.Context, name string) (*entity.User, error)
 	CreateUser(ctx context.Context, user *entity.User) (*entity.User, error)
 	UpdateUser(ctx context.Context, uuid *uuid.UUID, user *entity.User) (*entity.User, error)
+	SetLanguage(ctx context.Context, uuid *uuid.UUID, language string) (*entity.User, error)
 	DeleteUser(ctx context.Context, uuid *uuid.UUID) error
 
 	GetAllMatrixUsers(ctx context.Context) ([]entity.MatrixUser, error)
@@ -29,6 +46,7 @@ type UserRepository interface {
 	CreatePasswordUser(ctx context.Context, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error)
 	UpdatePasswordUser(ctx context.Context, uuid *uuid.UUID, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error)
 	DeletePasswordUser(ctx context.Context, uuid *uuid.UUID) error
+	SetPassword(ctx context.Context, userUUID *uuid.UUID, username, passwordHash string) (*entity.PasswordUser, error)
 
 	GetAllSSHUsers(ctx context.Context) ([]entity.SSHUser, error)
 	GetSSHUser(ctx context.Context, uuid *uuid.UUID) (*entity.SSHUser, error)
@@ -37,12 +55,60 @@ type UserRepository interface {
 	UpdateSSHUser(ctx context.Context, uuid *uuid.UUID, sshUser *entity.SSHUser) (*entity.SSHUser, error)
 	DeleteSSHUser(ctx context.Context, uuid *uuid.UUID) error
 
-	RegisterMatrixUser(ctx context.Context, username string) (*entity.User, error)
-	SetPublicKey(ctx context.Context, userUUID *uuid.UUID, publicKey string) error
+	RegisterMatrixUser(ctx context.Context, username, name string) (*entity.User, error)
+	AddPublicKey(ctx context.Context, userUUID *uuid.UUID, publicKey string) (*entity.SSHUser, error)
+	GetSSHUsersForUser(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error)
+	RemovePublicKey(ctx context.Context, userUUID, sshUserUUID *uuid.UUID) error
+
+	GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error)
+	CountUsersWithRole(ctx context.Context, role entity.Role) (int64, error)
+	GrantRole(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error
+	RevokeRole(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error
+
+	GetUserData(ctx context.Context, userUUID *uuid.UUID) (*entity.UserData, error)
+
+	CreateLinkCode(ctx context.Context, linkCode *entity.LinkCode) (*entity.LinkCode, error)
+	GetLinkCode(ctx context.Context, code string) (*entity.LinkCode, error)
+	CreateLinkedMatrixUser(ctx context.Context, code string, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error)
+	CreateLinkedSSHUser(ctx context.Context, code string, sshUser *entity.SSHUser) (*entity.SSHUser, error)
+	MergeUsers(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error)
+
+	CreateAPIToken(ctx context.Context, apiToken *entity.APIToken) (*entity.APIToken, error)
+	GetAPITokensForUser(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error)
+	GetAPITokenByTokenID(ctx context.Context, tokenID string) (*entity.APIToken, error)
+	DeleteAPIToken(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error)
+	TouchAPIToken(ctx context.Context, apiTokenUUID *uuid.UUID, lastUsedAt time.Time) error
+
+	GetTOTPCredential(ctx context.Context, userUUID *uuid.UUID) (*entity.TOTPCredential, error)
+	CreateTOTPCredential(ctx context.Context, totpCredential *entity.TOTPCredential) (*entity.TOTPCredential, error)
+	ConfirmTOTPCredential(ctx context.Context, userUUID *uuid.UUID, step int64, recoveryCodeHashes []string) error
+	UseTOTPStep(ctx context.Context, userUUID *uuid.UUID, step int64) error
+	DeleteTOTPCredential(ctx context.Context, userUUID *uuid.UUID) error
+	GetUnusedRecoveryCodes(ctx context.Context, userUUID *uuid.UUID) ([]entity.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, recoveryCodeUUID *uuid.UUID) error
 }
 
 type UserService struct {
 	UserRepository UserRepository
+	Authorizer     Authorizer
+	// PasswordHasher creates new password hashes, the crypto defaults are
+	// used when it is nil.
+	PasswordHasher *crypto.Argon2idHash
+	// BootstrapAdmins are matrix usernames that always get the admin role,
+	// so there is someone to grant roles to everybody else.
+	BootstrapAdmins []string
+
+	// dummyHash is checked for unknown usernames at login, see Login
+	dummyHashOnce sync.Once
+	dummyHash     string
+}
+
+func (i *UserService) passwordHasher() *crypto.Argon2idHash {
+	if i.PasswordHasher == nil {
+		return crypto.NewDefaultArgon2idHash()
+	}
+
+	return i.PasswordHasher
 }
 
 func (i *UserService) GetAllUsers(ctx context.Context) ([]entity.User, error) {
@@ -57,22 +123,130 @@ func (i *UserService) CreateUser(ctx context.Context, user *entity.User) (*entit
 	return i.UserRepository.CreateUser(ctx, user)
 }
 
-func (i *UserService) UpdateUser(ctx context.Context, uuid *uuid.UUID, user *entity.User) (*entity.User, error) {
+func (i *UserService) UpdateUser(ctx context.Context, currentUser, uuid *uuid.UUID, user *entity.User) (*entity.User, error) {
+	if err := i.authorizeSelfOrManageUsers(ctx, currentUser, uuid); err != nil {
+		return nil, err
+	}
+
 	return i.UserRepository.UpdateUser(ctx, uuid, user)
 }
 
-func (i *UserService) DeleteUser(ctx context.Context, uuid *uuid.UUID) error {
+func (i *UserService) DeleteUser(ctx context.Context, currentUser, uuid *uuid.UUID) error {
+	if err := i.authorizeSelfOrManageUsers(ctx, currentUser, uuid); err != nil {
+		return err
+	}
+
+	if err := i.checkNotLastAdmin(ctx, uuid); err != nil {
+		return fmt.Errorf("%w: %w", repository.ErrDeletingUser, err)
+	}
+
 	return i.UserRepository.DeleteUser(ctx, uuid)
 }
 
-func (i *UserService) RegisterMatrixUser(ctx context.Context, username string) (*entity.User, error) {
-	return i.UserRepository.RegisterMatrixUser(ctx, username)
+// RegisterMatrixUser creates an account for the matrix user. It is named
+// after the display name, or the localpart of the matrix id without one.
+func (i *UserService) RegisterMatrixUser(ctx context.Context, username, displayName string) (*entity.User, error) {
+	user, err := i.UserRepository.RegisterMatrixUser(ctx, username, matrixUserName(username, displayName))
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(i.BootstrapAdmins, username) {
+		if err = i.UserRepository.GrantRole(ctx, user.UUID, entity.RoleAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// GrantBootstrapAdmins gives the admin role to all bootstrap admins that are
+// already registered. Admins registering later get it on registration.
+func (i *UserService) GrantBootstrapAdmins(ctx context.Context) error {
+	for _, username := range i.BootstrapAdmins {
+		matrixUser, err := i.UserRepository.GetMatrixUserByUsername(ctx, username)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("%w: %w", ErrGrantingRole, err)
+		}
+
+		if err = i.UserRepository.GrantRole(ctx, matrixUser.UserUUID, entity.RoleAdmin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *UserService) GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+	return i.UserRepository.GetRoles(ctx, userUUID)
 }
 
-func (i *UserService) SetPublicKey(ctx context.Context, userUUID *uuid.UUID, publicKey string) error {
-	return i.UserRepository.SetPublicKey(ctx, userUUID, publicKey)
+func (i *UserService) GrantRole(ctx context.Context, currentUser, userUUID *uuid.UUID, role entity.Role) error {
+	if !slices.Contains(entity.Roles, role) {
+		return fmt.Errorf("%w: %w: %s", ErrGrantingRole, ErrUnknownRole, role)
+	}
+
+	if err := i.Authorizer.Authorize(ctx, currentUser, PermissionManageUsers); err != nil {
+		return fmt.Errorf("%w: %w", ErrGrantingRole, err)
+	}
+
+	return i.UserRepository.GrantRole(ctx, userUUID, role)
+}
+
+func (i *UserService) RevokeRole(ctx context.Context, currentUser, userUUID *uuid.UUID, role entity.Role) error {
+	if !slices.Contains(entity.Roles, role) {
+		return fmt.Errorf("%w: %w: %s", ErrRevokingRole, ErrUnknownRole, role)
+	}
+
+	if err := i.Authorizer.Authorize(ctx, currentUser, PermissionManageUsers); err != nil {
+		return fmt.Errorf("%w: %w", ErrRevokingRole, err)
+	}
+
+	if role == entity.RoleAdmin {
+		if err := i.checkNotLastAdmin(ctx, userUUID); err != nil {
+			return fmt.Errorf("%w: %w", ErrRevokingRole, err)
+		}
+	}
+
+	return i.UserRepository.RevokeRole(ctx, userUUID, role)
+}
+
+func (i *UserService) checkNotLastAdmin(ctx context.Context, userUUID *uuid.UUID) error {
+	roles, err := i.UserRepository.GetRoles(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(roles, entity.RoleAdmin) {
+		return nil
+	}
+
+	admins, err := i.UserRepository.CountUsersWithRole(ctx, entity.RoleAdmin)
+	if err != nil {
+		return err
+	}
+
+	if admins <= 1 {
+		return ErrLastAdmin
+	}
+
+	return nil
+}
+
+func (i *UserService) authorizeSelfOrManageUsers(ctx context.Context, currentUser, userUUID *uuid.UUID) error {
+	if currentUser != nil && userUUID != nil && *currentUser == *userUUID {
+		return nil
+	}
+
+	return i.Authorizer.Authorize(ctx, currentUser, PermissionManageUsers)
 }
 
 func (i *UserService) GetMatrixUserByUsername(ctx context.Context, username string) (*entity.MatrixUser, error) {
 	return i.UserRepository.GetMatrixUserByUsername(ctx, username)
 }
+
+func (i *UserService) GetSSHUserByPublicKey(ctx context.Context, publicKey string) (*entity.SSHUser, error) {
+	return i.UserRepository.GetSSHUserByPublicKey(ctx, publicKey)
+}
@@ -0,0 +1,2730 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package service
+
+import (
+	"context"
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/gofrs/uuid"
+	"sync"
+	"time"
+)
+
+// Ensure, that UserRepositoryMock does implement UserRepository.
+// If this is not the case, regenerate this file with moq.
+var _ UserRepository = &UserRepositoryMock{}
+
+// UserRepositoryMock is a mock implementation of UserRepository.
+//
+//	func TestSomethingThatUsesUserRepository(t *testing.T) {
+//
+//		// make and configure a mocked UserRepository
+//		mockedUserRepository := &UserRepositoryMock{
+//			AddPublicKeyFunc: func(ctx context.Context, userUUID *uuid.UUID, publicKey string) (*entity.SSHUser, error) {
+//				panic("mock out the AddPublicKey method")
+//			},
+//			ConfirmTOTPCredentialFunc: func(ctx context.Context, userUUID *uuid.UUID, step int64, recoveryCodeHashes []string) error {
+//				panic("mock out the ConfirmTOTPCredential method")
+//			},
+//			CountUsersWithRoleFunc: func(ctx context.Context, role entity.Role) (int64, error) {
+//				panic("mock out the CountUsersWithRole method")
+//			},
+//			CreateAPITokenFunc: func(ctx context.Context, apiToken *entity.APIToken) (*entity.APIToken, error) {
+//				panic("mock out the CreateAPIToken method")
+//			},
+//			CreateLinkCodeFunc: func(ctx context.Context, linkCode *entity.LinkCode) (*entity.LinkCode, error) {
+//				panic("mock out the CreateLinkCode method")
+//			},
+//			CreateLinkedMatrixUserFunc: func(ctx context.Context, code string, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error) {
+//				panic("mock out the CreateLinkedMatrixUser method")
+//			},
+//			CreateLinkedSSHUserFunc: func(ctx context.Context, code string, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+//				panic("mock out the CreateLinkedSSHUser method")
+//			},
+//			CreateMatrixUserFunc: func(ctx context.Context, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error) {
+//				panic("mock out the CreateMatrixUser method")
+//			},
+//			CreatePasswordUserFunc: func(ctx context.Context, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error) {
+//				panic("mock out the CreatePasswordUser method")
+//			},
+//			CreateSSHUserFunc: func(ctx context.Context, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+//				panic("mock out the CreateSSHUser method")
+//			},
+//			CreateTOTPCredentialFunc: func(ctx context.Context, totpCredential *entity.TOTPCredential) (*entity.TOTPCredential, error) {
+//				panic("mock out the CreateTOTPCredential method")
+//			},
+//			CreateUserFunc: func(ctx context.Context, user *entity.User) (*entity.User, error) {
+//				panic("mock out the CreateUser method")
+//			},
+//			DeleteAPITokenFunc: func(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error) {
+//				panic("mock out the DeleteAPIToken method")
+//			},
+//			DeleteMatrixUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+//				panic("mock out the DeleteMatrixUser method")
+//			},
+//			DeletePasswordUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+//				panic("mock out the DeletePasswordUser method")
+//			},
+//			DeleteSSHUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+//				panic("mock out the DeleteSSHUser method")
+//			},
+//			DeleteTOTPCredentialFunc: func(ctx context.Context, userUUID *uuid.UUID) error {
+//				panic("mock out the DeleteTOTPCredential method")
+//			},
+//			DeleteUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+//				panic("mock out the DeleteUser method")
+//			},
+//			FindPasswordUserFunc: func(ctx context.Context, username string) (*entity.PasswordUser, error) {
+//				panic("mock out the FindPasswordUser method")
+//			},
+//			GetAPITokenByTokenIDFunc: func(ctx context.Context, tokenID string) (*entity.APIToken, error) {
+//				panic("mock out the GetAPITokenByTokenID method")
+//			},
+//			GetAPITokensForUserFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error) {
+//				panic("mock out the GetAPITokensForUser method")
+//			},
+//			GetAllMatrixUsersFunc: func(ctx context.Context) ([]entity.MatrixUser, error) {
+//				panic("mock out the GetAllMatrixUsers method")
+//			},
+//			GetAllPasswordUsersFunc: func(ctx context.Context) ([]entity.PasswordUser, error) {
+//				panic("mock out the GetAllPasswordUsers method")
+//			},
+//			GetAllSSHUsersFunc: func(ctx context.Context) ([]entity.SSHUser, error) {
+//				panic("mock out the GetAllSSHUsers method")
+//			},
+//			GetAllUsersFunc: func(ctx context.Context) ([]entity.User, error) {
+//				panic("mock out the GetAllUsers method")
+//			},
+//			GetLinkCodeFunc: func(ctx context.Context, code string) (*entity.LinkCode, error) {
+//				panic("mock out the GetLinkCode method")
+//			},
+//			GetMatrixUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.MatrixUser, error) {
+//				panic("mock out the GetMatrixUser method")
+//			},
+//			GetMatrixUserByUsernameFunc: func(ctx context.Context, username string) (*entity.MatrixUser, error) {
+//				panic("mock out the GetMatrixUserByUsername method")
+//			},
+//			GetPasswordUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.PasswordUser, error) {
+//				panic("mock out the GetPasswordUser method")
+//			},
+//			GetRolesFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+//				panic("mock out the GetRoles method")
+//			},
+//			GetSSHUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.SSHUser, error) {
+//				panic("mock out the GetSSHUser method")
+//			},
+//			GetSSHUserByPublicKeyFunc: func(ctx context.Context, publicKey string) (*entity.SSHUser, error) {
+//				panic("mock out the GetSSHUserByPublicKey method")
+//			},
+//			GetSSHUsersForUserFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error) {
+//				panic("mock out the GetSSHUsersForUser method")
+//			},
+//			GetTOTPCredentialFunc: func(ctx context.Context, userUUID *uuid.UUID) (*entity.TOTPCredential, error) {
+//				panic("mock out the GetTOTPCredential method")
+//			},
+//			GetUnusedRecoveryCodesFunc: func(ctx context.Context, userUUID *uuid.UUID) ([]entity.RecoveryCode, error) {
+//				panic("mock out the GetUnusedRecoveryCodes method")
+//			},
+//			GetUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error) {
+//				panic("mock out the GetUser method")
+//			},
+//			GetUserByNameFunc: func(ctx context.Context, name string) (*entity.User, error) {
+//				panic("mock out the GetUserByName method")
+//			},
+//			GetUserDataFunc: func(ctx context.Context, userUUID *uuid.UUID) (*entity.UserData, error) {
+//				panic("mock out the GetUserData method")
+//			},
+//			GrantRoleFunc: func(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+//				panic("mock out the GrantRole method")
+//			},
+//			MergeUsersFunc: func(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error) {
+//				panic("mock out the MergeUsers method")
+//			},
+//			RegisterMatrixUserFunc: func(ctx context.Context, username string, name string) (*entity.User, error) {
+//				panic("mock out the RegisterMatrixUser method")
+//			},
+//			RemovePublicKeyFunc: func(ctx context.Context, userUUID *uuid.UUID, sshUserUUID *uuid.UUID) error {
+//				panic("mock out the RemovePublicKey method")
+//			},
+//			RevokeRoleFunc: func(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+//				panic("mock out the RevokeRole method")
+//			},
+//			SetLanguageFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID, language string) (*entity.User, error) {
+//				panic("mock out the SetLanguage method")
+//			},
+//			SetPasswordFunc: func(ctx context.Context, userUUID *uuid.UUID, username string, passwordHash string) (*entity.PasswordUser, error) {
+//				panic("mock out the SetPassword method")
+//			},
+//			TouchAPITokenFunc: func(ctx context.Context, apiTokenUUID *uuid.UUID, lastUsedAt time.Time) error {
+//				panic("mock out the TouchAPIToken method")
+//			},
+//			UpdateMatrixUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error) {
+//				panic("mock out the UpdateMatrixUser method")
+//			},
+//			UpdatePasswordUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error) {
+//				panic("mock out the UpdatePasswordUser method")
+//			},
+//			UpdateSSHUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+//				panic("mock out the UpdateSSHUser method")
+//			},
+//			UpdateUserFunc: func(ctx context.Context, uuidMoqParam *uuid.UUID, user *entity.User) (*entity.User, error) {
+//				panic("mock out the UpdateUser method")
+//			},
+//			UseRecoveryCodeFunc: func(ctx context.Context, recoveryCodeUUID *uuid.UUID) error {
+//				panic("mock out the UseRecoveryCode method")
+//			},
+//			UseTOTPStepFunc: func(ctx context.Context, userUUID *uuid.UUID, step int64) error {
+//				panic("mock out the UseTOTPStep method")
+//			},
+//		}
+//
+//		// use mockedUserRepository in code that requires UserRepository
+//		// and then make assertions.
+//
+//	}
+type UserRepositoryMock struct {
+	// AddPublicKeyFunc mocks the AddPublicKey method.
+	AddPublicKeyFunc func(ctx context.Context, userUUID *uuid.UUID, publicKey string) (*entity.SSHUser, error)
+
+	// ConfirmTOTPCredentialFunc mocks the ConfirmTOTPCredential method.
+	ConfirmTOTPCredentialFunc func(ctx context.Context, userUUID *uuid.UUID, step int64, recoveryCodeHashes []string) error
+
+	// CountUsersWithRoleFunc mocks the CountUsersWithRole method.
+	CountUsersWithRoleFunc func(ctx context.Context, role entity.Role) (int64, error)
+
+	// CreateAPITokenFunc mocks the CreateAPIToken method.
+	CreateAPITokenFunc func(ctx context.Context, apiToken *entity.APIToken) (*entity.APIToken, error)
+
+	// CreateLinkCodeFunc mocks the CreateLinkCode method.
+	CreateLinkCodeFunc func(ctx context.Context, linkCode *entity.LinkCode) (*entity.LinkCode, error)
+
+	// CreateLinkedMatrixUserFunc mocks the CreateLinkedMatrixUser method.
+	CreateLinkedMatrixUserFunc func(ctx context.Context, code string, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error)
+
+	// CreateLinkedSSHUserFunc mocks the CreateLinkedSSHUser method.
+	CreateLinkedSSHUserFunc func(ctx context.Context, code string, sshUser *entity.SSHUser) (*entity.SSHUser, error)
+
+	// CreateMatrixUserFunc mocks the CreateMatrixUser method.
+	CreateMatrixUserFunc func(ctx context.Context, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error)
+
+	// CreatePasswordUserFunc mocks the CreatePasswordUser method.
+	CreatePasswordUserFunc func(ctx context.Context, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error)
+
+	// CreateSSHUserFunc mocks the CreateSSHUser method.
+	CreateSSHUserFunc func(ctx context.Context, sshUser *entity.SSHUser) (*entity.SSHUser, error)
+
+	// CreateTOTPCredentialFunc mocks the CreateTOTPCredential method.
+	CreateTOTPCredentialFunc func(ctx context.Context, totpCredential *entity.TOTPCredential) (*entity.TOTPCredential, error)
+
+	// CreateUserFunc mocks the CreateUser method.
+	CreateUserFunc func(ctx context.Context, user *entity.User) (*entity.User, error)
+
+	// DeleteAPITokenFunc mocks the DeleteAPIToken method.
+	DeleteAPITokenFunc func(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error)
+
+	// DeleteMatrixUserFunc mocks the DeleteMatrixUser method.
+	DeleteMatrixUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) error
+
+	// DeletePasswordUserFunc mocks the DeletePasswordUser method.
+	DeletePasswordUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) error
+
+	// DeleteSSHUserFunc mocks the DeleteSSHUser method.
+	DeleteSSHUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) error
+
+	// DeleteTOTPCredentialFunc mocks the DeleteTOTPCredential method.
+	DeleteTOTPCredentialFunc func(ctx context.Context, userUUID *uuid.UUID) error
+
+	// DeleteUserFunc mocks the DeleteUser method.
+	DeleteUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) error
+
+	// FindPasswordUserFunc mocks the FindPasswordUser method.
+	FindPasswordUserFunc func(ctx context.Context, username string) (*entity.PasswordUser, error)
+
+	// GetAPITokenByTokenIDFunc mocks the GetAPITokenByTokenID method.
+	GetAPITokenByTokenIDFunc func(ctx context.Context, tokenID string) (*entity.APIToken, error)
+
+	// GetAPITokensForUserFunc mocks the GetAPITokensForUser method.
+	GetAPITokensForUserFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error)
+
+	// GetAllMatrixUsersFunc mocks the GetAllMatrixUsers method.
+	GetAllMatrixUsersFunc func(ctx context.Context) ([]entity.MatrixUser, error)
+
+	// GetAllPasswordUsersFunc mocks the GetAllPasswordUsers method.
+	GetAllPasswordUsersFunc func(ctx context.Context) ([]entity.PasswordUser, error)
+
+	// GetAllSSHUsersFunc mocks the GetAllSSHUsers method.
+	GetAllSSHUsersFunc func(ctx context.Context) ([]entity.SSHUser, error)
+
+	// GetAllUsersFunc mocks the GetAllUsers method.
+	GetAllUsersFunc func(ctx context.Context) ([]entity.User, error)
+
+	// GetLinkCodeFunc mocks the GetLinkCode method.
+	GetLinkCodeFunc func(ctx context.Context, code string) (*entity.LinkCode, error)
+
+	// GetMatrixUserFunc mocks the GetMatrixUser method.
+	GetMatrixUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.MatrixUser, error)
+
+	// GetMatrixUserByUsernameFunc mocks the GetMatrixUserByUsername method.
+	GetMatrixUserByUsernameFunc func(ctx context.Context, username string) (*entity.MatrixUser, error)
+
+	// GetPasswordUserFunc mocks the GetPasswordUser method.
+	GetPasswordUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.PasswordUser, error)
+
+	// GetRolesFunc mocks the GetRoles method.
+	GetRolesFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error)
+
+	// GetSSHUserFunc mocks the GetSSHUser method.
+	GetSSHUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.SSHUser, error)
+
+	// GetSSHUserByPublicKeyFunc mocks the GetSSHUserByPublicKey method.
+	GetSSHUserByPublicKeyFunc func(ctx context.Context, publicKey string) (*entity.SSHUser, error)
+
+	// GetSSHUsersForUserFunc mocks the GetSSHUsersForUser method.
+	GetSSHUsersForUserFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error)
+
+	// GetTOTPCredentialFunc mocks the GetTOTPCredential method.
+	GetTOTPCredentialFunc func(ctx context.Context, userUUID *uuid.UUID) (*entity.TOTPCredential, error)
+
+	// GetUnusedRecoveryCodesFunc mocks the GetUnusedRecoveryCodes method.
+	GetUnusedRecoveryCodesFunc func(ctx context.Context, userUUID *uuid.UUID) ([]entity.RecoveryCode, error)
+
+	// GetUserFunc mocks the GetUser method.
+	GetUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error)
+
+	// GetUserByNameFunc mocks the GetUserByName method.
+	GetUserByNameFunc func(ctx context.Context, name string) (*entity.User, error)
+
+	// GetUserDataFunc mocks the GetUserData method.
+	GetUserDataFunc func(ctx context.Context, userUUID *uuid.UUID) (*entity.UserData, error)
+
+	// GrantRoleFunc mocks the GrantRole method.
+	GrantRoleFunc func(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error
+
+	// MergeUsersFunc mocks the MergeUsers method.
+	MergeUsersFunc func(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error)
+
+	// RegisterMatrixUserFunc mocks the RegisterMatrixUser method.
+	RegisterMatrixUserFunc func(ctx context.Context, username string, name string) (*entity.User, error)
+
+	// RemovePublicKeyFunc mocks the RemovePublicKey method.
+	RemovePublicKeyFunc func(ctx context.Context, userUUID *uuid.UUID, sshUserUUID *uuid.UUID) error
+
+	// RevokeRoleFunc mocks the RevokeRole method.
+	RevokeRoleFunc func(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error
+
+	// SetLanguageFunc mocks the SetLanguage method.
+	SetLanguageFunc func(ctx context.Context, uuidMoqParam *uuid.UUID, language string) (*entity.User, error)
+
+	// SetPasswordFunc mocks the SetPassword method.
+	SetPasswordFunc func(ctx context.Context, userUUID *uuid.UUID, username string, passwordHash string) (*entity.PasswordUser, error)
+
+	// TouchAPITokenFunc mocks the TouchAPIToken method.
+	TouchAPITokenFunc func(ctx context.Context, apiTokenUUID *uuid.UUID, lastUsedAt time.Time) error
+
+	// UpdateMatrixUserFunc mocks the UpdateMatrixUser method.
+	UpdateMatrixUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error)
+
+	// UpdatePasswordUserFunc mocks the UpdatePasswordUser method.
+	UpdatePasswordUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error)
+
+	// UpdateSSHUserFunc mocks the UpdateSSHUser method.
+	UpdateSSHUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID, sshUser *entity.SSHUser) (*entity.SSHUser, error)
+
+	// UpdateUserFunc mocks the UpdateUser method.
+	UpdateUserFunc func(ctx context.Context, uuidMoqParam *uuid.UUID, user *entity.User) (*entity.User, error)
+
+	// UseRecoveryCodeFunc mocks the UseRecoveryCode method.
+	UseRecoveryCodeFunc func(ctx context.Context, recoveryCodeUUID *uuid.UUID) error
+
+	// UseTOTPStepFunc mocks the UseTOTPStep method.
+	UseTOTPStepFunc func(ctx context.Context, userUUID *uuid.UUID, step int64) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddPublicKey holds details about calls to the AddPublicKey method.
+		AddPublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// PublicKey is the publicKey argument value.
+			PublicKey string
+		}
+		// ConfirmTOTPCredential holds details about calls to the ConfirmTOTPCredential method.
+		ConfirmTOTPCredential []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Step is the step argument value.
+			Step int64
+			// RecoveryCodeHashes is the recoveryCodeHashes argument value.
+			RecoveryCodeHashes []string
+		}
+		// CountUsersWithRole holds details about calls to the CountUsersWithRole method.
+		CountUsersWithRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Role is the role argument value.
+			Role entity.Role
+		}
+		// CreateAPIToken holds details about calls to the CreateAPIToken method.
+		CreateAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiToken is the apiToken argument value.
+			ApiToken *entity.APIToken
+		}
+		// CreateLinkCode holds details about calls to the CreateLinkCode method.
+		CreateLinkCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LinkCode is the linkCode argument value.
+			LinkCode *entity.LinkCode
+		}
+		// CreateLinkedMatrixUser holds details about calls to the CreateLinkedMatrixUser method.
+		CreateLinkedMatrixUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code string
+			// MatrixUser is the matrixUser argument value.
+			MatrixUser *entity.MatrixUser
+		}
+		// CreateLinkedSSHUser holds details about calls to the CreateLinkedSSHUser method.
+		CreateLinkedSSHUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code string
+			// SshUser is the sshUser argument value.
+			SshUser *entity.SSHUser
+		}
+		// CreateMatrixUser holds details about calls to the CreateMatrixUser method.
+		CreateMatrixUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// MatrixUser is the matrixUser argument value.
+			MatrixUser *entity.MatrixUser
+		}
+		// CreatePasswordUser holds details about calls to the CreatePasswordUser method.
+		CreatePasswordUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PasswordUser is the passwordUser argument value.
+			PasswordUser *entity.PasswordUser
+		}
+		// CreateSSHUser holds details about calls to the CreateSSHUser method.
+		CreateSSHUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SshUser is the sshUser argument value.
+			SshUser *entity.SSHUser
+		}
+		// CreateTOTPCredential holds details about calls to the CreateTOTPCredential method.
+		CreateTOTPCredential []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TotpCredential is the totpCredential argument value.
+			TotpCredential *entity.TOTPCredential
+		}
+		// CreateUser holds details about calls to the CreateUser method.
+		CreateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// User is the user argument value.
+			User *entity.User
+		}
+		// DeleteAPIToken holds details about calls to the DeleteAPIToken method.
+		DeleteAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteMatrixUser holds details about calls to the DeleteMatrixUser method.
+		DeleteMatrixUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// DeletePasswordUser holds details about calls to the DeletePasswordUser method.
+		DeletePasswordUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// DeleteSSHUser holds details about calls to the DeleteSSHUser method.
+		DeleteSSHUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// DeleteTOTPCredential holds details about calls to the DeleteTOTPCredential method.
+		DeleteTOTPCredential []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// DeleteUser holds details about calls to the DeleteUser method.
+		DeleteUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// FindPasswordUser holds details about calls to the FindPasswordUser method.
+		FindPasswordUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+		}
+		// GetAPITokenByTokenID holds details about calls to the GetAPITokenByTokenID method.
+		GetAPITokenByTokenID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TokenID is the tokenID argument value.
+			TokenID string
+		}
+		// GetAPITokensForUser holds details about calls to the GetAPITokensForUser method.
+		GetAPITokensForUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetAllMatrixUsers holds details about calls to the GetAllMatrixUsers method.
+		GetAllMatrixUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetAllPasswordUsers holds details about calls to the GetAllPasswordUsers method.
+		GetAllPasswordUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetAllSSHUsers holds details about calls to the GetAllSSHUsers method.
+		GetAllSSHUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetAllUsers holds details about calls to the GetAllUsers method.
+		GetAllUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetLinkCode holds details about calls to the GetLinkCode method.
+		GetLinkCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code string
+		}
+		// GetMatrixUser holds details about calls to the GetMatrixUser method.
+		GetMatrixUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GetMatrixUserByUsername holds details about calls to the GetMatrixUserByUsername method.
+		GetMatrixUserByUsername []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+		}
+		// GetPasswordUser holds details about calls to the GetPasswordUser method.
+		GetPasswordUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GetRoles holds details about calls to the GetRoles method.
+		GetRoles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetSSHUser holds details about calls to the GetSSHUser method.
+		GetSSHUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GetSSHUserByPublicKey holds details about calls to the GetSSHUserByPublicKey method.
+		GetSSHUserByPublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PublicKey is the publicKey argument value.
+			PublicKey string
+		}
+		// GetSSHUsersForUser holds details about calls to the GetSSHUsersForUser method.
+		GetSSHUsersForUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetTOTPCredential holds details about calls to the GetTOTPCredential method.
+		GetTOTPCredential []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetUnusedRecoveryCodes holds details about calls to the GetUnusedRecoveryCodes method.
+		GetUnusedRecoveryCodes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GetUser holds details about calls to the GetUser method.
+		GetUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+		}
+		// GetUserByName holds details about calls to the GetUserByName method.
+		GetUserByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// GetUserData holds details about calls to the GetUserData method.
+		GetUserData []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+		}
+		// GrantRole holds details about calls to the GrantRole method.
+		GrantRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Role is the role argument value.
+			Role entity.Role
+		}
+		// MergeUsers holds details about calls to the MergeUsers method.
+		MergeUsers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SourceUUID is the sourceUUID argument value.
+			SourceUUID *uuid.UUID
+			// Code is the code argument value.
+			Code string
+		}
+		// RegisterMatrixUser holds details about calls to the RegisterMatrixUser method.
+		RegisterMatrixUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Username is the username argument value.
+			Username string
+			// Name is the name argument value.
+			Name string
+		}
+		// RemovePublicKey holds details about calls to the RemovePublicKey method.
+		RemovePublicKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// SshUserUUID is the sshUserUUID argument value.
+			SshUserUUID *uuid.UUID
+		}
+		// RevokeRole holds details about calls to the RevokeRole method.
+		RevokeRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Role is the role argument value.
+			Role entity.Role
+		}
+		// SetLanguage holds details about calls to the SetLanguage method.
+		SetLanguage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// Language is the language argument value.
+			Language string
+		}
+		// SetPassword holds details about calls to the SetPassword method.
+		SetPassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Username is the username argument value.
+			Username string
+			// PasswordHash is the passwordHash argument value.
+			PasswordHash string
+		}
+		// TouchAPIToken holds details about calls to the TouchAPIToken method.
+		TouchAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiTokenUUID is the apiTokenUUID argument value.
+			ApiTokenUUID *uuid.UUID
+			// LastUsedAt is the lastUsedAt argument value.
+			LastUsedAt time.Time
+		}
+		// UpdateMatrixUser holds details about calls to the UpdateMatrixUser method.
+		UpdateMatrixUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// MatrixUser is the matrixUser argument value.
+			MatrixUser *entity.MatrixUser
+		}
+		// UpdatePasswordUser holds details about calls to the UpdatePasswordUser method.
+		UpdatePasswordUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// PasswordUser is the passwordUser argument value.
+			PasswordUser *entity.PasswordUser
+		}
+		// UpdateSSHUser holds details about calls to the UpdateSSHUser method.
+		UpdateSSHUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// SshUser is the sshUser argument value.
+			SshUser *entity.SSHUser
+		}
+		// UpdateUser holds details about calls to the UpdateUser method.
+		UpdateUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidMoqParam is the uuidMoqParam argument value.
+			UuidMoqParam *uuid.UUID
+			// User is the user argument value.
+			User *entity.User
+		}
+		// UseRecoveryCode holds details about calls to the UseRecoveryCode method.
+		UseRecoveryCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RecoveryCodeUUID is the recoveryCodeUUID argument value.
+			RecoveryCodeUUID *uuid.UUID
+		}
+		// UseTOTPStep holds details about calls to the UseTOTPStep method.
+		UseTOTPStep []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserUUID is the userUUID argument value.
+			UserUUID *uuid.UUID
+			// Step is the step argument value.
+			Step int64
+		}
+	}
+	lockAddPublicKey            sync.RWMutex
+	lockConfirmTOTPCredential   sync.RWMutex
+	lockCountUsersWithRole      sync.RWMutex
+	lockCreateAPIToken          sync.RWMutex
+	lockCreateLinkCode          sync.RWMutex
+	lockCreateLinkedMatrixUser  sync.RWMutex
+	lockCreateLinkedSSHUser     sync.RWMutex
+	lockCreateMatrixUser        sync.RWMutex
+	lockCreatePasswordUser      sync.RWMutex
+	lockCreateSSHUser           sync.RWMutex
+	lockCreateTOTPCredential    sync.RWMutex
+	lockCreateUser              sync.RWMutex
+	lockDeleteAPIToken          sync.RWMutex
+	lockDeleteMatrixUser        sync.RWMutex
+	lockDeletePasswordUser      sync.RWMutex
+	lockDeleteSSHUser           sync.RWMutex
+	lockDeleteTOTPCredential    sync.RWMutex
+	lockDeleteUser              sync.RWMutex
+	lockFindPasswordUser        sync.RWMutex
+	lockGetAPITokenByTokenID    sync.RWMutex
+	lockGetAPITokensForUser     sync.RWMutex
+	lockGetAllMatrixUsers       sync.RWMutex
+	lockGetAllPasswordUsers     sync.RWMutex
+	lockGetAllSSHUsers          sync.RWMutex
+	lockGetAllUsers             sync.RWMutex
+	lockGetLinkCode             sync.RWMutex
+	lockGetMatrixUser           sync.RWMutex
+	lockGetMatrixUserByUsername sync.RWMutex
+	lockGetPasswordUser         sync.RWMutex
+	lockGetRoles                sync.RWMutex
+	lockGetSSHUser              sync.RWMutex
+	lockGetSSHUserByPublicKey   sync.RWMutex
+	lockGetSSHUsersForUser      sync.RWMutex
+	lockGetTOTPCredential       sync.RWMutex
+	lockGetUnusedRecoveryCodes  sync.RWMutex
+	lockGetUser                 sync.RWMutex
+	lockGetUserByName           sync.RWMutex
+	lockGetUserData             sync.RWMutex
+	lockGrantRole               sync.RWMutex
+	lockMergeUsers              sync.RWMutex
+	lockRegisterMatrixUser      sync.RWMutex
+	lockRemovePublicKey         sync.RWMutex
+	lockRevokeRole              sync.RWMutex
+	lockSetLanguage             sync.RWMutex
+	lockSetPassword             sync.RWMutex
+	lockTouchAPIToken           sync.RWMutex
+	lockUpdateMatrixUser        sync.RWMutex
+	lockUpdatePasswordUser      sync.RWMutex
+	lockUpdateSSHUser           sync.RWMutex
+	lockUpdateUser              sync.RWMutex
+	lockUseRecoveryCode         sync.RWMutex
+	lockUseTOTPStep             sync.RWMutex
+}
+
+// AddPublicKey calls AddPublicKeyFunc.
+func (mock *UserRepositoryMock) AddPublicKey(ctx context.Context, userUUID *uuid.UUID, publicKey string) (*entity.SSHUser, error) {
+	if mock.AddPublicKeyFunc == nil {
+		panic("UserRepositoryMock.AddPublicKeyFunc: method is nil but UserRepository.AddPublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserUUID  *uuid.UUID
+		PublicKey string
+	}{
+		Ctx:       ctx,
+		UserUUID:  userUUID,
+		PublicKey: publicKey,
+	}
+	mock.lockAddPublicKey.Lock()
+	mock.calls.AddPublicKey = append(mock.calls.AddPublicKey, callInfo)
+	mock.lockAddPublicKey.Unlock()
+	return mock.AddPublicKeyFunc(ctx, userUUID, publicKey)
+}
+
+// AddPublicKeyCalls gets all the calls that were made to AddPublicKey.
+// Check the length with:
+//
+//	len(mockedUserRepository.AddPublicKeyCalls())
+func (mock *UserRepositoryMock) AddPublicKeyCalls() []struct {
+	Ctx       context.Context
+	UserUUID  *uuid.UUID
+	PublicKey string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserUUID  *uuid.UUID
+		PublicKey string
+	}
+	mock.lockAddPublicKey.RLock()
+	calls = mock.calls.AddPublicKey
+	mock.lockAddPublicKey.RUnlock()
+	return calls
+}
+
+// ConfirmTOTPCredential calls ConfirmTOTPCredentialFunc.
+func (mock *UserRepositoryMock) ConfirmTOTPCredential(ctx context.Context, userUUID *uuid.UUID, step int64, recoveryCodeHashes []string) error {
+	if mock.ConfirmTOTPCredentialFunc == nil {
+		panic("UserRepositoryMock.ConfirmTOTPCredentialFunc: method is nil but UserRepository.ConfirmTOTPCredential was just called")
+	}
+	callInfo := struct {
+		Ctx                context.Context
+		UserUUID           *uuid.UUID
+		Step               int64
+		RecoveryCodeHashes []string
+	}{
+		Ctx:                ctx,
+		UserUUID:           userUUID,
+		Step:               step,
+		RecoveryCodeHashes: recoveryCodeHashes,
+	}
+	mock.lockConfirmTOTPCredential.Lock()
+	mock.calls.ConfirmTOTPCredential = append(mock.calls.ConfirmTOTPCredential, callInfo)
+	mock.lockConfirmTOTPCredential.Unlock()
+	return mock.ConfirmTOTPCredentialFunc(ctx, userUUID, step, recoveryCodeHashes)
+}
+
+// ConfirmTOTPCredentialCalls gets all the calls that were made to ConfirmTOTPCredential.
+// Check the length with:
+//
+//	len(mockedUserRepository.ConfirmTOTPCredentialCalls())
+func (mock *UserRepositoryMock) ConfirmTOTPCredentialCalls() []struct {
+	Ctx                context.Context
+	UserUUID           *uuid.UUID
+	Step               int64
+	RecoveryCodeHashes []string
+} {
+	var calls []struct {
+		Ctx                context.Context
+		UserUUID           *uuid.UUID
+		Step               int64
+		RecoveryCodeHashes []string
+	}
+	mock.lockConfirmTOTPCredential.RLock()
+	calls = mock.calls.ConfirmTOTPCredential
+	mock.lockConfirmTOTPCredential.RUnlock()
+	return calls
+}
+
+// CountUsersWithRole calls CountUsersWithRoleFunc.
+func (mock *UserRepositoryMock) CountUsersWithRole(ctx context.Context, role entity.Role) (int64, error) {
+	if mock.CountUsersWithRoleFunc == nil {
+		panic("UserRepositoryMock.CountUsersWithRoleFunc: method is nil but UserRepository.CountUsersWithRole was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Role entity.Role
+	}{
+		Ctx:  ctx,
+		Role: role,
+	}
+	mock.lockCountUsersWithRole.Lock()
+	mock.calls.CountUsersWithRole = append(mock.calls.CountUsersWithRole, callInfo)
+	mock.lockCountUsersWithRole.Unlock()
+	return mock.CountUsersWithRoleFunc(ctx, role)
+}
+
+// CountUsersWithRoleCalls gets all the calls that were made to CountUsersWithRole.
+// Check the length with:
+//
+//	len(mockedUserRepository.CountUsersWithRoleCalls())
+func (mock *UserRepositoryMock) CountUsersWithRoleCalls() []struct {
+	Ctx  context.Context
+	Role entity.Role
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Role entity.Role
+	}
+	mock.lockCountUsersWithRole.RLock()
+	calls = mock.calls.CountUsersWithRole
+	mock.lockCountUsersWithRole.RUnlock()
+	return calls
+}
+
+// CreateAPIToken calls CreateAPITokenFunc.
+func (mock *UserRepositoryMock) CreateAPIToken(ctx context.Context, apiToken *entity.APIToken) (*entity.APIToken, error) {
+	if mock.CreateAPITokenFunc == nil {
+		panic("UserRepositoryMock.CreateAPITokenFunc: method is nil but UserRepository.CreateAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ApiToken *entity.APIToken
+	}{
+		Ctx:      ctx,
+		ApiToken: apiToken,
+	}
+	mock.lockCreateAPIToken.Lock()
+	mock.calls.CreateAPIToken = append(mock.calls.CreateAPIToken, callInfo)
+	mock.lockCreateAPIToken.Unlock()
+	return mock.CreateAPITokenFunc(ctx, apiToken)
+}
+
+// CreateAPITokenCalls gets all the calls that were made to CreateAPIToken.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateAPITokenCalls())
+func (mock *UserRepositoryMock) CreateAPITokenCalls() []struct {
+	Ctx      context.Context
+	ApiToken *entity.APIToken
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ApiToken *entity.APIToken
+	}
+	mock.lockCreateAPIToken.RLock()
+	calls = mock.calls.CreateAPIToken
+	mock.lockCreateAPIToken.RUnlock()
+	return calls
+}
+
+// CreateLinkCode calls CreateLinkCodeFunc.
+func (mock *UserRepositoryMock) CreateLinkCode(ctx context.Context, linkCode *entity.LinkCode) (*entity.LinkCode, error) {
+	if mock.CreateLinkCodeFunc == nil {
+		panic("UserRepositoryMock.CreateLinkCodeFunc: method is nil but UserRepository.CreateLinkCode was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		LinkCode *entity.LinkCode
+	}{
+		Ctx:      ctx,
+		LinkCode: linkCode,
+	}
+	mock.lockCreateLinkCode.Lock()
+	mock.calls.CreateLinkCode = append(mock.calls.CreateLinkCode, callInfo)
+	mock.lockCreateLinkCode.Unlock()
+	return mock.CreateLinkCodeFunc(ctx, linkCode)
+}
+
+// CreateLinkCodeCalls gets all the calls that were made to CreateLinkCode.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateLinkCodeCalls())
+func (mock *UserRepositoryMock) CreateLinkCodeCalls() []struct {
+	Ctx      context.Context
+	LinkCode *entity.LinkCode
+} {
+	var calls []struct {
+		Ctx      context.Context
+		LinkCode *entity.LinkCode
+	}
+	mock.lockCreateLinkCode.RLock()
+	calls = mock.calls.CreateLinkCode
+	mock.lockCreateLinkCode.RUnlock()
+	return calls
+}
+
+// CreateLinkedMatrixUser calls CreateLinkedMatrixUserFunc.
+func (mock *UserRepositoryMock) CreateLinkedMatrixUser(ctx context.Context, code string, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error) {
+	if mock.CreateLinkedMatrixUserFunc == nil {
+		panic("UserRepositoryMock.CreateLinkedMatrixUserFunc: method is nil but UserRepository.CreateLinkedMatrixUser was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Code       string
+		MatrixUser *entity.MatrixUser
+	}{
+		Ctx:        ctx,
+		Code:       code,
+		MatrixUser: matrixUser,
+	}
+	mock.lockCreateLinkedMatrixUser.Lock()
+	mock.calls.CreateLinkedMatrixUser = append(mock.calls.CreateLinkedMatrixUser, callInfo)
+	mock.lockCreateLinkedMatrixUser.Unlock()
+	return mock.CreateLinkedMatrixUserFunc(ctx, code, matrixUser)
+}
+
+// CreateLinkedMatrixUserCalls gets all the calls that were made to CreateLinkedMatrixUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateLinkedMatrixUserCalls())
+func (mock *UserRepositoryMock) CreateLinkedMatrixUserCalls() []struct {
+	Ctx        context.Context
+	Code       string
+	MatrixUser *entity.MatrixUser
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Code       string
+		MatrixUser *entity.MatrixUser
+	}
+	mock.lockCreateLinkedMatrixUser.RLock()
+	calls = mock.calls.CreateLinkedMatrixUser
+	mock.lockCreateLinkedMatrixUser.RUnlock()
+	return calls
+}
+
+// CreateLinkedSSHUser calls CreateLinkedSSHUserFunc.
+func (mock *UserRepositoryMock) CreateLinkedSSHUser(ctx context.Context, code string, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+	if mock.CreateLinkedSSHUserFunc == nil {
+		panic("UserRepositoryMock.CreateLinkedSSHUserFunc: method is nil but UserRepository.CreateLinkedSSHUser was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Code    string
+		SshUser *entity.SSHUser
+	}{
+		Ctx:     ctx,
+		Code:    code,
+		SshUser: sshUser,
+	}
+	mock.lockCreateLinkedSSHUser.Lock()
+	mock.calls.CreateLinkedSSHUser = append(mock.calls.CreateLinkedSSHUser, callInfo)
+	mock.lockCreateLinkedSSHUser.Unlock()
+	return mock.CreateLinkedSSHUserFunc(ctx, code, sshUser)
+}
+
+// CreateLinkedSSHUserCalls gets all the calls that were made to CreateLinkedSSHUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateLinkedSSHUserCalls())
+func (mock *UserRepositoryMock) CreateLinkedSSHUserCalls() []struct {
+	Ctx     context.Context
+	Code    string
+	SshUser *entity.SSHUser
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Code    string
+		SshUser *entity.SSHUser
+	}
+	mock.lockCreateLinkedSSHUser.RLock()
+	calls = mock.calls.CreateLinkedSSHUser
+	mock.lockCreateLinkedSSHUser.RUnlock()
+	return calls
+}
+
+// CreateMatrixUser calls CreateMatrixUserFunc.
+func (mock *UserRepositoryMock) CreateMatrixUser(ctx context.Context, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error) {
+	if mock.CreateMatrixUserFunc == nil {
+		panic("UserRepositoryMock.CreateMatrixUserFunc: method is nil but UserRepository.CreateMatrixUser was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		MatrixUser *entity.MatrixUser
+	}{
+		Ctx:        ctx,
+		MatrixUser: matrixUser,
+	}
+	mock.lockCreateMatrixUser.Lock()
+	mock.calls.CreateMatrixUser = append(mock.calls.CreateMatrixUser, callInfo)
+	mock.lockCreateMatrixUser.Unlock()
+	return mock.CreateMatrixUserFunc(ctx, matrixUser)
+}
+
+// CreateMatrixUserCalls gets all the calls that were made to CreateMatrixUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateMatrixUserCalls())
+func (mock *UserRepositoryMock) CreateMatrixUserCalls() []struct {
+	Ctx        context.Context
+	MatrixUser *entity.MatrixUser
+} {
+	var calls []struct {
+		Ctx        context.Context
+		MatrixUser *entity.MatrixUser
+	}
+	mock.lockCreateMatrixUser.RLock()
+	calls = mock.calls.CreateMatrixUser
+	mock.lockCreateMatrixUser.RUnlock()
+	return calls
+}
+
+// CreatePasswordUser calls CreatePasswordUserFunc.
+func (mock *UserRepositoryMock) CreatePasswordUser(ctx context.Context, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error) {
+	if mock.CreatePasswordUserFunc == nil {
+		panic("UserRepositoryMock.CreatePasswordUserFunc: method is nil but UserRepository.CreatePasswordUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		PasswordUser *entity.PasswordUser
+	}{
+		Ctx:          ctx,
+		PasswordUser: passwordUser,
+	}
+	mock.lockCreatePasswordUser.Lock()
+	mock.calls.CreatePasswordUser = append(mock.calls.CreatePasswordUser, callInfo)
+	mock.lockCreatePasswordUser.Unlock()
+	return mock.CreatePasswordUserFunc(ctx, passwordUser)
+}
+
+// CreatePasswordUserCalls gets all the calls that were made to CreatePasswordUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreatePasswordUserCalls())
+func (mock *UserRepositoryMock) CreatePasswordUserCalls() []struct {
+	Ctx          context.Context
+	PasswordUser *entity.PasswordUser
+} {
+	var calls []struct {
+		Ctx          context.Context
+		PasswordUser *entity.PasswordUser
+	}
+	mock.lockCreatePasswordUser.RLock()
+	calls = mock.calls.CreatePasswordUser
+	mock.lockCreatePasswordUser.RUnlock()
+	return calls
+}
+
+// CreateSSHUser calls CreateSSHUserFunc.
+func (mock *UserRepositoryMock) CreateSSHUser(ctx context.Context, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+	if mock.CreateSSHUserFunc == nil {
+		panic("UserRepositoryMock.CreateSSHUserFunc: method is nil but UserRepository.CreateSSHUser was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		SshUser *entity.SSHUser
+	}{
+		Ctx:     ctx,
+		SshUser: sshUser,
+	}
+	mock.lockCreateSSHUser.Lock()
+	mock.calls.CreateSSHUser = append(mock.calls.CreateSSHUser, callInfo)
+	mock.lockCreateSSHUser.Unlock()
+	return mock.CreateSSHUserFunc(ctx, sshUser)
+}
+
+// CreateSSHUserCalls gets all the calls that were made to CreateSSHUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateSSHUserCalls())
+func (mock *UserRepositoryMock) CreateSSHUserCalls() []struct {
+	Ctx     context.Context
+	SshUser *entity.SSHUser
+} {
+	var calls []struct {
+		Ctx     context.Context
+		SshUser *entity.SSHUser
+	}
+	mock.lockCreateSSHUser.RLock()
+	calls = mock.calls.CreateSSHUser
+	mock.lockCreateSSHUser.RUnlock()
+	return calls
+}
+
+// CreateTOTPCredential calls CreateTOTPCredentialFunc.
+func (mock *UserRepositoryMock) CreateTOTPCredential(ctx context.Context, totpCredential *entity.TOTPCredential) (*entity.TOTPCredential, error) {
+	if mock.CreateTOTPCredentialFunc == nil {
+		panic("UserRepositoryMock.CreateTOTPCredentialFunc: method is nil but UserRepository.CreateTOTPCredential was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		TotpCredential *entity.TOTPCredential
+	}{
+		Ctx:            ctx,
+		TotpCredential: totpCredential,
+	}
+	mock.lockCreateTOTPCredential.Lock()
+	mock.calls.CreateTOTPCredential = append(mock.calls.CreateTOTPCredential, callInfo)
+	mock.lockCreateTOTPCredential.Unlock()
+	return mock.CreateTOTPCredentialFunc(ctx, totpCredential)
+}
+
+// CreateTOTPCredentialCalls gets all the calls that were made to CreateTOTPCredential.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateTOTPCredentialCalls())
+func (mock *UserRepositoryMock) CreateTOTPCredentialCalls() []struct {
+	Ctx            context.Context
+	TotpCredential *entity.TOTPCredential
+} {
+	var calls []struct {
+		Ctx            context.Context
+		TotpCredential *entity.TOTPCredential
+	}
+	mock.lockCreateTOTPCredential.RLock()
+	calls = mock.calls.CreateTOTPCredential
+	mock.lockCreateTOTPCredential.RUnlock()
+	return calls
+}
+
+// CreateUser calls CreateUserFunc.
+func (mock *UserRepositoryMock) CreateUser(ctx context.Context, user *entity.User) (*entity.User, error) {
+	if mock.CreateUserFunc == nil {
+		panic("UserRepositoryMock.CreateUserFunc: method is nil but UserRepository.CreateUser was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		User *entity.User
+	}{
+		Ctx:  ctx,
+		User: user,
+	}
+	mock.lockCreateUser.Lock()
+	mock.calls.CreateUser = append(mock.calls.CreateUser, callInfo)
+	mock.lockCreateUser.Unlock()
+	return mock.CreateUserFunc(ctx, user)
+}
+
+// CreateUserCalls gets all the calls that were made to CreateUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.CreateUserCalls())
+func (mock *UserRepositoryMock) CreateUserCalls() []struct {
+	Ctx  context.Context
+	User *entity.User
+} {
+	var calls []struct {
+		Ctx  context.Context
+		User *entity.User
+	}
+	mock.lockCreateUser.RLock()
+	calls = mock.calls.CreateUser
+	mock.lockCreateUser.RUnlock()
+	return calls
+}
+
+// DeleteAPIToken calls DeleteAPITokenFunc.
+func (mock *UserRepositoryMock) DeleteAPIToken(ctx context.Context, userUUID *uuid.UUID, name string) (*entity.APIToken, error) {
+	if mock.DeleteAPITokenFunc == nil {
+		panic("UserRepositoryMock.DeleteAPITokenFunc: method is nil but UserRepository.DeleteAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Name     string
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Name:     name,
+	}
+	mock.lockDeleteAPIToken.Lock()
+	mock.calls.DeleteAPIToken = append(mock.calls.DeleteAPIToken, callInfo)
+	mock.lockDeleteAPIToken.Unlock()
+	return mock.DeleteAPITokenFunc(ctx, userUUID, name)
+}
+
+// DeleteAPITokenCalls gets all the calls that were made to DeleteAPIToken.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteAPITokenCalls())
+func (mock *UserRepositoryMock) DeleteAPITokenCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Name     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Name     string
+	}
+	mock.lockDeleteAPIToken.RLock()
+	calls = mock.calls.DeleteAPIToken
+	mock.lockDeleteAPIToken.RUnlock()
+	return calls
+}
+
+// DeleteMatrixUser calls DeleteMatrixUserFunc.
+func (mock *UserRepositoryMock) DeleteMatrixUser(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+	if mock.DeleteMatrixUserFunc == nil {
+		panic("UserRepositoryMock.DeleteMatrixUserFunc: method is nil but UserRepository.DeleteMatrixUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockDeleteMatrixUser.Lock()
+	mock.calls.DeleteMatrixUser = append(mock.calls.DeleteMatrixUser, callInfo)
+	mock.lockDeleteMatrixUser.Unlock()
+	return mock.DeleteMatrixUserFunc(ctx, uuidMoqParam)
+}
+
+// DeleteMatrixUserCalls gets all the calls that were made to DeleteMatrixUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteMatrixUserCalls())
+func (mock *UserRepositoryMock) DeleteMatrixUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockDeleteMatrixUser.RLock()
+	calls = mock.calls.DeleteMatrixUser
+	mock.lockDeleteMatrixUser.RUnlock()
+	return calls
+}
+
+// DeletePasswordUser calls DeletePasswordUserFunc.
+func (mock *UserRepositoryMock) DeletePasswordUser(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+	if mock.DeletePasswordUserFunc == nil {
+		panic("UserRepositoryMock.DeletePasswordUserFunc: method is nil but UserRepository.DeletePasswordUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockDeletePasswordUser.Lock()
+	mock.calls.DeletePasswordUser = append(mock.calls.DeletePasswordUser, callInfo)
+	mock.lockDeletePasswordUser.Unlock()
+	return mock.DeletePasswordUserFunc(ctx, uuidMoqParam)
+}
+
+// DeletePasswordUserCalls gets all the calls that were made to DeletePasswordUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeletePasswordUserCalls())
+func (mock *UserRepositoryMock) DeletePasswordUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockDeletePasswordUser.RLock()
+	calls = mock.calls.DeletePasswordUser
+	mock.lockDeletePasswordUser.RUnlock()
+	return calls
+}
+
+// DeleteSSHUser calls DeleteSSHUserFunc.
+func (mock *UserRepositoryMock) DeleteSSHUser(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+	if mock.DeleteSSHUserFunc == nil {
+		panic("UserRepositoryMock.DeleteSSHUserFunc: method is nil but UserRepository.DeleteSSHUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockDeleteSSHUser.Lock()
+	mock.calls.DeleteSSHUser = append(mock.calls.DeleteSSHUser, callInfo)
+	mock.lockDeleteSSHUser.Unlock()
+	return mock.DeleteSSHUserFunc(ctx, uuidMoqParam)
+}
+
+// DeleteSSHUserCalls gets all the calls that were made to DeleteSSHUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteSSHUserCalls())
+func (mock *UserRepositoryMock) DeleteSSHUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockDeleteSSHUser.RLock()
+	calls = mock.calls.DeleteSSHUser
+	mock.lockDeleteSSHUser.RUnlock()
+	return calls
+}
+
+// DeleteTOTPCredential calls DeleteTOTPCredentialFunc.
+func (mock *UserRepositoryMock) DeleteTOTPCredential(ctx context.Context, userUUID *uuid.UUID) error {
+	if mock.DeleteTOTPCredentialFunc == nil {
+		panic("UserRepositoryMock.DeleteTOTPCredentialFunc: method is nil but UserRepository.DeleteTOTPCredential was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockDeleteTOTPCredential.Lock()
+	mock.calls.DeleteTOTPCredential = append(mock.calls.DeleteTOTPCredential, callInfo)
+	mock.lockDeleteTOTPCredential.Unlock()
+	return mock.DeleteTOTPCredentialFunc(ctx, userUUID)
+}
+
+// DeleteTOTPCredentialCalls gets all the calls that were made to DeleteTOTPCredential.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteTOTPCredentialCalls())
+func (mock *UserRepositoryMock) DeleteTOTPCredentialCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockDeleteTOTPCredential.RLock()
+	calls = mock.calls.DeleteTOTPCredential
+	mock.lockDeleteTOTPCredential.RUnlock()
+	return calls
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (mock *UserRepositoryMock) DeleteUser(ctx context.Context, uuidMoqParam *uuid.UUID) error {
+	if mock.DeleteUserFunc == nil {
+		panic("UserRepositoryMock.DeleteUserFunc: method is nil but UserRepository.DeleteUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockDeleteUser.Lock()
+	mock.calls.DeleteUser = append(mock.calls.DeleteUser, callInfo)
+	mock.lockDeleteUser.Unlock()
+	return mock.DeleteUserFunc(ctx, uuidMoqParam)
+}
+
+// DeleteUserCalls gets all the calls that were made to DeleteUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.DeleteUserCalls())
+func (mock *UserRepositoryMock) DeleteUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockDeleteUser.RLock()
+	calls = mock.calls.DeleteUser
+	mock.lockDeleteUser.RUnlock()
+	return calls
+}
+
+// FindPasswordUser calls FindPasswordUserFunc.
+func (mock *UserRepositoryMock) FindPasswordUser(ctx context.Context, username string) (*entity.PasswordUser, error) {
+	if mock.FindPasswordUserFunc == nil {
+		panic("UserRepositoryMock.FindPasswordUserFunc: method is nil but UserRepository.FindPasswordUser was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+	}{
+		Ctx:      ctx,
+		Username: username,
+	}
+	mock.lockFindPasswordUser.Lock()
+	mock.calls.FindPasswordUser = append(mock.calls.FindPasswordUser, callInfo)
+	mock.lockFindPasswordUser.Unlock()
+	return mock.FindPasswordUserFunc(ctx, username)
+}
+
+// FindPasswordUserCalls gets all the calls that were made to FindPasswordUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.FindPasswordUserCalls())
+func (mock *UserRepositoryMock) FindPasswordUserCalls() []struct {
+	Ctx      context.Context
+	Username string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+	}
+	mock.lockFindPasswordUser.RLock()
+	calls = mock.calls.FindPasswordUser
+	mock.lockFindPasswordUser.RUnlock()
+	return calls
+}
+
+// GetAPITokenByTokenID calls GetAPITokenByTokenIDFunc.
+func (mock *UserRepositoryMock) GetAPITokenByTokenID(ctx context.Context, tokenID string) (*entity.APIToken, error) {
+	if mock.GetAPITokenByTokenIDFunc == nil {
+		panic("UserRepositoryMock.GetAPITokenByTokenIDFunc: method is nil but UserRepository.GetAPITokenByTokenID was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		TokenID string
+	}{
+		Ctx:     ctx,
+		TokenID: tokenID,
+	}
+	mock.lockGetAPITokenByTokenID.Lock()
+	mock.calls.GetAPITokenByTokenID = append(mock.calls.GetAPITokenByTokenID, callInfo)
+	mock.lockGetAPITokenByTokenID.Unlock()
+	return mock.GetAPITokenByTokenIDFunc(ctx, tokenID)
+}
+
+// GetAPITokenByTokenIDCalls gets all the calls that were made to GetAPITokenByTokenID.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetAPITokenByTokenIDCalls())
+func (mock *UserRepositoryMock) GetAPITokenByTokenIDCalls() []struct {
+	Ctx     context.Context
+	TokenID string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		TokenID string
+	}
+	mock.lockGetAPITokenByTokenID.RLock()
+	calls = mock.calls.GetAPITokenByTokenID
+	mock.lockGetAPITokenByTokenID.RUnlock()
+	return calls
+}
+
+// GetAPITokensForUser calls GetAPITokensForUserFunc.
+func (mock *UserRepositoryMock) GetAPITokensForUser(ctx context.Context, userUUID *uuid.UUID) ([]entity.APIToken, error) {
+	if mock.GetAPITokensForUserFunc == nil {
+		panic("UserRepositoryMock.GetAPITokensForUserFunc: method is nil but UserRepository.GetAPITokensForUser was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetAPITokensForUser.Lock()
+	mock.calls.GetAPITokensForUser = append(mock.calls.GetAPITokensForUser, callInfo)
+	mock.lockGetAPITokensForUser.Unlock()
+	return mock.GetAPITokensForUserFunc(ctx, userUUID)
+}
+
+// GetAPITokensForUserCalls gets all the calls that were made to GetAPITokensForUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetAPITokensForUserCalls())
+func (mock *UserRepositoryMock) GetAPITokensForUserCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetAPITokensForUser.RLock()
+	calls = mock.calls.GetAPITokensForUser
+	mock.lockGetAPITokensForUser.RUnlock()
+	return calls
+}
+
+// GetAllMatrixUsers calls GetAllMatrixUsersFunc.
+func (mock *UserRepositoryMock) GetAllMatrixUsers(ctx context.Context) ([]entity.MatrixUser, error) {
+	if mock.GetAllMatrixUsersFunc == nil {
+		panic("UserRepositoryMock.GetAllMatrixUsersFunc: method is nil but UserRepository.GetAllMatrixUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllMatrixUsers.Lock()
+	mock.calls.GetAllMatrixUsers = append(mock.calls.GetAllMatrixUsers, callInfo)
+	mock.lockGetAllMatrixUsers.Unlock()
+	return mock.GetAllMatrixUsersFunc(ctx)
+}
+
+// GetAllMatrixUsersCalls gets all the calls that were made to GetAllMatrixUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetAllMatrixUsersCalls())
+func (mock *UserRepositoryMock) GetAllMatrixUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllMatrixUsers.RLock()
+	calls = mock.calls.GetAllMatrixUsers
+	mock.lockGetAllMatrixUsers.RUnlock()
+	return calls
+}
+
+// GetAllPasswordUsers calls GetAllPasswordUsersFunc.
+func (mock *UserRepositoryMock) GetAllPasswordUsers(ctx context.Context) ([]entity.PasswordUser, error) {
+	if mock.GetAllPasswordUsersFunc == nil {
+		panic("UserRepositoryMock.GetAllPasswordUsersFunc: method is nil but UserRepository.GetAllPasswordUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllPasswordUsers.Lock()
+	mock.calls.GetAllPasswordUsers = append(mock.calls.GetAllPasswordUsers, callInfo)
+	mock.lockGetAllPasswordUsers.Unlock()
+	return mock.GetAllPasswordUsersFunc(ctx)
+}
+
+// GetAllPasswordUsersCalls gets all the calls that were made to GetAllPasswordUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetAllPasswordUsersCalls())
+func (mock *UserRepositoryMock) GetAllPasswordUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllPasswordUsers.RLock()
+	calls = mock.calls.GetAllPasswordUsers
+	mock.lockGetAllPasswordUsers.RUnlock()
+	return calls
+}
+
+// GetAllSSHUsers calls GetAllSSHUsersFunc.
+func (mock *UserRepositoryMock) GetAllSSHUsers(ctx context.Context) ([]entity.SSHUser, error) {
+	if mock.GetAllSSHUsersFunc == nil {
+		panic("UserRepositoryMock.GetAllSSHUsersFunc: method is nil but UserRepository.GetAllSSHUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllSSHUsers.Lock()
+	mock.calls.GetAllSSHUsers = append(mock.calls.GetAllSSHUsers, callInfo)
+	mock.lockGetAllSSHUsers.Unlock()
+	return mock.GetAllSSHUsersFunc(ctx)
+}
+
+// GetAllSSHUsersCalls gets all the calls that were made to GetAllSSHUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetAllSSHUsersCalls())
+func (mock *UserRepositoryMock) GetAllSSHUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllSSHUsers.RLock()
+	calls = mock.calls.GetAllSSHUsers
+	mock.lockGetAllSSHUsers.RUnlock()
+	return calls
+}
+
+// GetAllUsers calls GetAllUsersFunc.
+func (mock *UserRepositoryMock) GetAllUsers(ctx context.Context) ([]entity.User, error) {
+	if mock.GetAllUsersFunc == nil {
+		panic("UserRepositoryMock.GetAllUsersFunc: method is nil but UserRepository.GetAllUsers was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAllUsers.Lock()
+	mock.calls.GetAllUsers = append(mock.calls.GetAllUsers, callInfo)
+	mock.lockGetAllUsers.Unlock()
+	return mock.GetAllUsersFunc(ctx)
+}
+
+// GetAllUsersCalls gets all the calls that were made to GetAllUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetAllUsersCalls())
+func (mock *UserRepositoryMock) GetAllUsersCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAllUsers.RLock()
+	calls = mock.calls.GetAllUsers
+	mock.lockGetAllUsers.RUnlock()
+	return calls
+}
+
+// GetLinkCode calls GetLinkCodeFunc.
+func (mock *UserRepositoryMock) GetLinkCode(ctx context.Context, code string) (*entity.LinkCode, error) {
+	if mock.GetLinkCodeFunc == nil {
+		panic("UserRepositoryMock.GetLinkCodeFunc: method is nil but UserRepository.GetLinkCode was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Code string
+	}{
+		Ctx:  ctx,
+		Code: code,
+	}
+	mock.lockGetLinkCode.Lock()
+	mock.calls.GetLinkCode = append(mock.calls.GetLinkCode, callInfo)
+	mock.lockGetLinkCode.Unlock()
+	return mock.GetLinkCodeFunc(ctx, code)
+}
+
+// GetLinkCodeCalls gets all the calls that were made to GetLinkCode.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetLinkCodeCalls())
+func (mock *UserRepositoryMock) GetLinkCodeCalls() []struct {
+	Ctx  context.Context
+	Code string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Code string
+	}
+	mock.lockGetLinkCode.RLock()
+	calls = mock.calls.GetLinkCode
+	mock.lockGetLinkCode.RUnlock()
+	return calls
+}
+
+// GetMatrixUser calls GetMatrixUserFunc.
+func (mock *UserRepositoryMock) GetMatrixUser(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.MatrixUser, error) {
+	if mock.GetMatrixUserFunc == nil {
+		panic("UserRepositoryMock.GetMatrixUserFunc: method is nil but UserRepository.GetMatrixUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetMatrixUser.Lock()
+	mock.calls.GetMatrixUser = append(mock.calls.GetMatrixUser, callInfo)
+	mock.lockGetMatrixUser.Unlock()
+	return mock.GetMatrixUserFunc(ctx, uuidMoqParam)
+}
+
+// GetMatrixUserCalls gets all the calls that were made to GetMatrixUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetMatrixUserCalls())
+func (mock *UserRepositoryMock) GetMatrixUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetMatrixUser.RLock()
+	calls = mock.calls.GetMatrixUser
+	mock.lockGetMatrixUser.RUnlock()
+	return calls
+}
+
+// GetMatrixUserByUsername calls GetMatrixUserByUsernameFunc.
+func (mock *UserRepositoryMock) GetMatrixUserByUsername(ctx context.Context, username string) (*entity.MatrixUser, error) {
+	if mock.GetMatrixUserByUsernameFunc == nil {
+		panic("UserRepositoryMock.GetMatrixUserByUsernameFunc: method is nil but UserRepository.GetMatrixUserByUsername was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+	}{
+		Ctx:      ctx,
+		Username: username,
+	}
+	mock.lockGetMatrixUserByUsername.Lock()
+	mock.calls.GetMatrixUserByUsername = append(mock.calls.GetMatrixUserByUsername, callInfo)
+	mock.lockGetMatrixUserByUsername.Unlock()
+	return mock.GetMatrixUserByUsernameFunc(ctx, username)
+}
+
+// GetMatrixUserByUsernameCalls gets all the calls that were made to GetMatrixUserByUsername.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetMatrixUserByUsernameCalls())
+func (mock *UserRepositoryMock) GetMatrixUserByUsernameCalls() []struct {
+	Ctx      context.Context
+	Username string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+	}
+	mock.lockGetMatrixUserByUsername.RLock()
+	calls = mock.calls.GetMatrixUserByUsername
+	mock.lockGetMatrixUserByUsername.RUnlock()
+	return calls
+}
+
+// GetPasswordUser calls GetPasswordUserFunc.
+func (mock *UserRepositoryMock) GetPasswordUser(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.PasswordUser, error) {
+	if mock.GetPasswordUserFunc == nil {
+		panic("UserRepositoryMock.GetPasswordUserFunc: method is nil but UserRepository.GetPasswordUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetPasswordUser.Lock()
+	mock.calls.GetPasswordUser = append(mock.calls.GetPasswordUser, callInfo)
+	mock.lockGetPasswordUser.Unlock()
+	return mock.GetPasswordUserFunc(ctx, uuidMoqParam)
+}
+
+// GetPasswordUserCalls gets all the calls that were made to GetPasswordUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetPasswordUserCalls())
+func (mock *UserRepositoryMock) GetPasswordUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetPasswordUser.RLock()
+	calls = mock.calls.GetPasswordUser
+	mock.lockGetPasswordUser.RUnlock()
+	return calls
+}
+
+// GetRoles calls GetRolesFunc.
+func (mock *UserRepositoryMock) GetRoles(ctx context.Context, userUUID *uuid.UUID) ([]entity.Role, error) {
+	if mock.GetRolesFunc == nil {
+		panic("UserRepositoryMock.GetRolesFunc: method is nil but UserRepository.GetRoles was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetRoles.Lock()
+	mock.calls.GetRoles = append(mock.calls.GetRoles, callInfo)
+	mock.lockGetRoles.Unlock()
+	return mock.GetRolesFunc(ctx, userUUID)
+}
+
+// GetRolesCalls gets all the calls that were made to GetRoles.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetRolesCalls())
+func (mock *UserRepositoryMock) GetRolesCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetRoles.RLock()
+	calls = mock.calls.GetRoles
+	mock.lockGetRoles.RUnlock()
+	return calls
+}
+
+// GetSSHUser calls GetSSHUserFunc.
+func (mock *UserRepositoryMock) GetSSHUser(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.SSHUser, error) {
+	if mock.GetSSHUserFunc == nil {
+		panic("UserRepositoryMock.GetSSHUserFunc: method is nil but UserRepository.GetSSHUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetSSHUser.Lock()
+	mock.calls.GetSSHUser = append(mock.calls.GetSSHUser, callInfo)
+	mock.lockGetSSHUser.Unlock()
+	return mock.GetSSHUserFunc(ctx, uuidMoqParam)
+}
+
+// GetSSHUserCalls gets all the calls that were made to GetSSHUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetSSHUserCalls())
+func (mock *UserRepositoryMock) GetSSHUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetSSHUser.RLock()
+	calls = mock.calls.GetSSHUser
+	mock.lockGetSSHUser.RUnlock()
+	return calls
+}
+
+// GetSSHUserByPublicKey calls GetSSHUserByPublicKeyFunc.
+func (mock *UserRepositoryMock) GetSSHUserByPublicKey(ctx context.Context, publicKey string) (*entity.SSHUser, error) {
+	if mock.GetSSHUserByPublicKeyFunc == nil {
+		panic("UserRepositoryMock.GetSSHUserByPublicKeyFunc: method is nil but UserRepository.GetSSHUserByPublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		PublicKey string
+	}{
+		Ctx:       ctx,
+		PublicKey: publicKey,
+	}
+	mock.lockGetSSHUserByPublicKey.Lock()
+	mock.calls.GetSSHUserByPublicKey = append(mock.calls.GetSSHUserByPublicKey, callInfo)
+	mock.lockGetSSHUserByPublicKey.Unlock()
+	return mock.GetSSHUserByPublicKeyFunc(ctx, publicKey)
+}
+
+// GetSSHUserByPublicKeyCalls gets all the calls that were made to GetSSHUserByPublicKey.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetSSHUserByPublicKeyCalls())
+func (mock *UserRepositoryMock) GetSSHUserByPublicKeyCalls() []struct {
+	Ctx       context.Context
+	PublicKey string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		PublicKey string
+	}
+	mock.lockGetSSHUserByPublicKey.RLock()
+	calls = mock.calls.GetSSHUserByPublicKey
+	mock.lockGetSSHUserByPublicKey.RUnlock()
+	return calls
+}
+
+// GetSSHUsersForUser calls GetSSHUsersForUserFunc.
+func (mock *UserRepositoryMock) GetSSHUsersForUser(ctx context.Context, userUUID *uuid.UUID) ([]entity.SSHUser, error) {
+	if mock.GetSSHUsersForUserFunc == nil {
+		panic("UserRepositoryMock.GetSSHUsersForUserFunc: method is nil but UserRepository.GetSSHUsersForUser was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetSSHUsersForUser.Lock()
+	mock.calls.GetSSHUsersForUser = append(mock.calls.GetSSHUsersForUser, callInfo)
+	mock.lockGetSSHUsersForUser.Unlock()
+	return mock.GetSSHUsersForUserFunc(ctx, userUUID)
+}
+
+// GetSSHUsersForUserCalls gets all the calls that were made to GetSSHUsersForUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetSSHUsersForUserCalls())
+func (mock *UserRepositoryMock) GetSSHUsersForUserCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetSSHUsersForUser.RLock()
+	calls = mock.calls.GetSSHUsersForUser
+	mock.lockGetSSHUsersForUser.RUnlock()
+	return calls
+}
+
+// GetTOTPCredential calls GetTOTPCredentialFunc.
+func (mock *UserRepositoryMock) GetTOTPCredential(ctx context.Context, userUUID *uuid.UUID) (*entity.TOTPCredential, error) {
+	if mock.GetTOTPCredentialFunc == nil {
+		panic("UserRepositoryMock.GetTOTPCredentialFunc: method is nil but UserRepository.GetTOTPCredential was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetTOTPCredential.Lock()
+	mock.calls.GetTOTPCredential = append(mock.calls.GetTOTPCredential, callInfo)
+	mock.lockGetTOTPCredential.Unlock()
+	return mock.GetTOTPCredentialFunc(ctx, userUUID)
+}
+
+// GetTOTPCredentialCalls gets all the calls that were made to GetTOTPCredential.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetTOTPCredentialCalls())
+func (mock *UserRepositoryMock) GetTOTPCredentialCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetTOTPCredential.RLock()
+	calls = mock.calls.GetTOTPCredential
+	mock.lockGetTOTPCredential.RUnlock()
+	return calls
+}
+
+// GetUnusedRecoveryCodes calls GetUnusedRecoveryCodesFunc.
+func (mock *UserRepositoryMock) GetUnusedRecoveryCodes(ctx context.Context, userUUID *uuid.UUID) ([]entity.RecoveryCode, error) {
+	if mock.GetUnusedRecoveryCodesFunc == nil {
+		panic("UserRepositoryMock.GetUnusedRecoveryCodesFunc: method is nil but UserRepository.GetUnusedRecoveryCodes was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetUnusedRecoveryCodes.Lock()
+	mock.calls.GetUnusedRecoveryCodes = append(mock.calls.GetUnusedRecoveryCodes, callInfo)
+	mock.lockGetUnusedRecoveryCodes.Unlock()
+	return mock.GetUnusedRecoveryCodesFunc(ctx, userUUID)
+}
+
+// GetUnusedRecoveryCodesCalls gets all the calls that were made to GetUnusedRecoveryCodes.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUnusedRecoveryCodesCalls())
+func (mock *UserRepositoryMock) GetUnusedRecoveryCodesCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetUnusedRecoveryCodes.RLock()
+	calls = mock.calls.GetUnusedRecoveryCodes
+	mock.lockGetUnusedRecoveryCodes.RUnlock()
+	return calls
+}
+
+// GetUser calls GetUserFunc.
+func (mock *UserRepositoryMock) GetUser(ctx context.Context, uuidMoqParam *uuid.UUID) (*entity.User, error) {
+	if mock.GetUserFunc == nil {
+		panic("UserRepositoryMock.GetUserFunc: method is nil but UserRepository.GetUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+	}
+	mock.lockGetUser.Lock()
+	mock.calls.GetUser = append(mock.calls.GetUser, callInfo)
+	mock.lockGetUser.Unlock()
+	return mock.GetUserFunc(ctx, uuidMoqParam)
+}
+
+// GetUserCalls gets all the calls that were made to GetUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUserCalls())
+func (mock *UserRepositoryMock) GetUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+	}
+	mock.lockGetUser.RLock()
+	calls = mock.calls.GetUser
+	mock.lockGetUser.RUnlock()
+	return calls
+}
+
+// GetUserByName calls GetUserByNameFunc.
+func (mock *UserRepositoryMock) GetUserByName(ctx context.Context, name string) (*entity.User, error) {
+	if mock.GetUserByNameFunc == nil {
+		panic("UserRepositoryMock.GetUserByNameFunc: method is nil but UserRepository.GetUserByName was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetUserByName.Lock()
+	mock.calls.GetUserByName = append(mock.calls.GetUserByName, callInfo)
+	mock.lockGetUserByName.Unlock()
+	return mock.GetUserByNameFunc(ctx, name)
+}
+
+// GetUserByNameCalls gets all the calls that were made to GetUserByName.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUserByNameCalls())
+func (mock *UserRepositoryMock) GetUserByNameCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetUserByName.RLock()
+	calls = mock.calls.GetUserByName
+	mock.lockGetUserByName.RUnlock()
+	return calls
+}
+
+// GetUserData calls GetUserDataFunc.
+func (mock *UserRepositoryMock) GetUserData(ctx context.Context, userUUID *uuid.UUID) (*entity.UserData, error) {
+	if mock.GetUserDataFunc == nil {
+		panic("UserRepositoryMock.GetUserDataFunc: method is nil but UserRepository.GetUserData was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+	}
+	mock.lockGetUserData.Lock()
+	mock.calls.GetUserData = append(mock.calls.GetUserData, callInfo)
+	mock.lockGetUserData.Unlock()
+	return mock.GetUserDataFunc(ctx, userUUID)
+}
+
+// GetUserDataCalls gets all the calls that were made to GetUserData.
+// Check the length with:
+//
+//	len(mockedUserRepository.GetUserDataCalls())
+func (mock *UserRepositoryMock) GetUserDataCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+	}
+	mock.lockGetUserData.RLock()
+	calls = mock.calls.GetUserData
+	mock.lockGetUserData.RUnlock()
+	return calls
+}
+
+// GrantRole calls GrantRoleFunc.
+func (mock *UserRepositoryMock) GrantRole(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+	if mock.GrantRoleFunc == nil {
+		panic("UserRepositoryMock.GrantRoleFunc: method is nil but UserRepository.GrantRole was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Role     entity.Role
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Role:     role,
+	}
+	mock.lockGrantRole.Lock()
+	mock.calls.GrantRole = append(mock.calls.GrantRole, callInfo)
+	mock.lockGrantRole.Unlock()
+	return mock.GrantRoleFunc(ctx, userUUID, role)
+}
+
+// GrantRoleCalls gets all the calls that were made to GrantRole.
+// Check the length with:
+//
+//	len(mockedUserRepository.GrantRoleCalls())
+func (mock *UserRepositoryMock) GrantRoleCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Role     entity.Role
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Role     entity.Role
+	}
+	mock.lockGrantRole.RLock()
+	calls = mock.calls.GrantRole
+	mock.lockGrantRole.RUnlock()
+	return calls
+}
+
+// MergeUsers calls MergeUsersFunc.
+func (mock *UserRepositoryMock) MergeUsers(ctx context.Context, sourceUUID *uuid.UUID, code string) (*entity.User, error) {
+	if mock.MergeUsersFunc == nil {
+		panic("UserRepositoryMock.MergeUsersFunc: method is nil but UserRepository.MergeUsers was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SourceUUID *uuid.UUID
+		Code       string
+	}{
+		Ctx:        ctx,
+		SourceUUID: sourceUUID,
+		Code:       code,
+	}
+	mock.lockMergeUsers.Lock()
+	mock.calls.MergeUsers = append(mock.calls.MergeUsers, callInfo)
+	mock.lockMergeUsers.Unlock()
+	return mock.MergeUsersFunc(ctx, sourceUUID, code)
+}
+
+// MergeUsersCalls gets all the calls that were made to MergeUsers.
+// Check the length with:
+//
+//	len(mockedUserRepository.MergeUsersCalls())
+func (mock *UserRepositoryMock) MergeUsersCalls() []struct {
+	Ctx        context.Context
+	SourceUUID *uuid.UUID
+	Code       string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SourceUUID *uuid.UUID
+		Code       string
+	}
+	mock.lockMergeUsers.RLock()
+	calls = mock.calls.MergeUsers
+	mock.lockMergeUsers.RUnlock()
+	return calls
+}
+
+// RegisterMatrixUser calls RegisterMatrixUserFunc.
+func (mock *UserRepositoryMock) RegisterMatrixUser(ctx context.Context, username string, name string) (*entity.User, error) {
+	if mock.RegisterMatrixUserFunc == nil {
+		panic("UserRepositoryMock.RegisterMatrixUserFunc: method is nil but UserRepository.RegisterMatrixUser was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Username string
+		Name     string
+	}{
+		Ctx:      ctx,
+		Username: username,
+		Name:     name,
+	}
+	mock.lockRegisterMatrixUser.Lock()
+	mock.calls.RegisterMatrixUser = append(mock.calls.RegisterMatrixUser, callInfo)
+	mock.lockRegisterMatrixUser.Unlock()
+	return mock.RegisterMatrixUserFunc(ctx, username, name)
+}
+
+// RegisterMatrixUserCalls gets all the calls that were made to RegisterMatrixUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.RegisterMatrixUserCalls())
+func (mock *UserRepositoryMock) RegisterMatrixUserCalls() []struct {
+	Ctx      context.Context
+	Username string
+	Name     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Username string
+		Name     string
+	}
+	mock.lockRegisterMatrixUser.RLock()
+	calls = mock.calls.RegisterMatrixUser
+	mock.lockRegisterMatrixUser.RUnlock()
+	return calls
+}
+
+// RemovePublicKey calls RemovePublicKeyFunc.
+func (mock *UserRepositoryMock) RemovePublicKey(ctx context.Context, userUUID *uuid.UUID, sshUserUUID *uuid.UUID) error {
+	if mock.RemovePublicKeyFunc == nil {
+		panic("UserRepositoryMock.RemovePublicKeyFunc: method is nil but UserRepository.RemovePublicKey was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		UserUUID    *uuid.UUID
+		SshUserUUID *uuid.UUID
+	}{
+		Ctx:         ctx,
+		UserUUID:    userUUID,
+		SshUserUUID: sshUserUUID,
+	}
+	mock.lockRemovePublicKey.Lock()
+	mock.calls.RemovePublicKey = append(mock.calls.RemovePublicKey, callInfo)
+	mock.lockRemovePublicKey.Unlock()
+	return mock.RemovePublicKeyFunc(ctx, userUUID, sshUserUUID)
+}
+
+// RemovePublicKeyCalls gets all the calls that were made to RemovePublicKey.
+// Check the length with:
+//
+//	len(mockedUserRepository.RemovePublicKeyCalls())
+func (mock *UserRepositoryMock) RemovePublicKeyCalls() []struct {
+	Ctx         context.Context
+	UserUUID    *uuid.UUID
+	SshUserUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx         context.Context
+		UserUUID    *uuid.UUID
+		SshUserUUID *uuid.UUID
+	}
+	mock.lockRemovePublicKey.RLock()
+	calls = mock.calls.RemovePublicKey
+	mock.lockRemovePublicKey.RUnlock()
+	return calls
+}
+
+// RevokeRole calls RevokeRoleFunc.
+func (mock *UserRepositoryMock) RevokeRole(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+	if mock.RevokeRoleFunc == nil {
+		panic("UserRepositoryMock.RevokeRoleFunc: method is nil but UserRepository.RevokeRole was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Role     entity.Role
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Role:     role,
+	}
+	mock.lockRevokeRole.Lock()
+	mock.calls.RevokeRole = append(mock.calls.RevokeRole, callInfo)
+	mock.lockRevokeRole.Unlock()
+	return mock.RevokeRoleFunc(ctx, userUUID, role)
+}
+
+// RevokeRoleCalls gets all the calls that were made to RevokeRole.
+// Check the length with:
+//
+//	len(mockedUserRepository.RevokeRoleCalls())
+func (mock *UserRepositoryMock) RevokeRoleCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Role     entity.Role
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Role     entity.Role
+	}
+	mock.lockRevokeRole.RLock()
+	calls = mock.calls.RevokeRole
+	mock.lockRevokeRole.RUnlock()
+	return calls
+}
+
+// SetLanguage calls SetLanguageFunc.
+func (mock *UserRepositoryMock) SetLanguage(ctx context.Context, uuidMoqParam *uuid.UUID, language string) (*entity.User, error) {
+	if mock.SetLanguageFunc == nil {
+		panic("UserRepositoryMock.SetLanguageFunc: method is nil but UserRepository.SetLanguage was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		Language     string
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+		Language:     language,
+	}
+	mock.lockSetLanguage.Lock()
+	mock.calls.SetLanguage = append(mock.calls.SetLanguage, callInfo)
+	mock.lockSetLanguage.Unlock()
+	return mock.SetLanguageFunc(ctx, uuidMoqParam, language)
+}
+
+// SetLanguageCalls gets all the calls that were made to SetLanguage.
+// Check the length with:
+//
+//	len(mockedUserRepository.SetLanguageCalls())
+func (mock *UserRepositoryMock) SetLanguageCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+	Language     string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		Language     string
+	}
+	mock.lockSetLanguage.RLock()
+	calls = mock.calls.SetLanguage
+	mock.lockSetLanguage.RUnlock()
+	return calls
+}
+
+// SetPassword calls SetPasswordFunc.
+func (mock *UserRepositoryMock) SetPassword(ctx context.Context, userUUID *uuid.UUID, username string, passwordHash string) (*entity.PasswordUser, error) {
+	if mock.SetPasswordFunc == nil {
+		panic("UserRepositoryMock.SetPasswordFunc: method is nil but UserRepository.SetPassword was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UserUUID     *uuid.UUID
+		Username     string
+		PasswordHash string
+	}{
+		Ctx:          ctx,
+		UserUUID:     userUUID,
+		Username:     username,
+		PasswordHash: passwordHash,
+	}
+	mock.lockSetPassword.Lock()
+	mock.calls.SetPassword = append(mock.calls.SetPassword, callInfo)
+	mock.lockSetPassword.Unlock()
+	return mock.SetPasswordFunc(ctx, userUUID, username, passwordHash)
+}
+
+// SetPasswordCalls gets all the calls that were made to SetPassword.
+// Check the length with:
+//
+//	len(mockedUserRepository.SetPasswordCalls())
+func (mock *UserRepositoryMock) SetPasswordCalls() []struct {
+	Ctx          context.Context
+	UserUUID     *uuid.UUID
+	Username     string
+	PasswordHash string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UserUUID     *uuid.UUID
+		Username     string
+		PasswordHash string
+	}
+	mock.lockSetPassword.RLock()
+	calls = mock.calls.SetPassword
+	mock.lockSetPassword.RUnlock()
+	return calls
+}
+
+// TouchAPIToken calls TouchAPITokenFunc.
+func (mock *UserRepositoryMock) TouchAPIToken(ctx context.Context, apiTokenUUID *uuid.UUID, lastUsedAt time.Time) error {
+	if mock.TouchAPITokenFunc == nil {
+		panic("UserRepositoryMock.TouchAPITokenFunc: method is nil but UserRepository.TouchAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		ApiTokenUUID *uuid.UUID
+		LastUsedAt   time.Time
+	}{
+		Ctx:          ctx,
+		ApiTokenUUID: apiTokenUUID,
+		LastUsedAt:   lastUsedAt,
+	}
+	mock.lockTouchAPIToken.Lock()
+	mock.calls.TouchAPIToken = append(mock.calls.TouchAPIToken, callInfo)
+	mock.lockTouchAPIToken.Unlock()
+	return mock.TouchAPITokenFunc(ctx, apiTokenUUID, lastUsedAt)
+}
+
+// TouchAPITokenCalls gets all the calls that were made to TouchAPIToken.
+// Check the length with:
+//
+//	len(mockedUserRepository.TouchAPITokenCalls())
+func (mock *UserRepositoryMock) TouchAPITokenCalls() []struct {
+	Ctx          context.Context
+	ApiTokenUUID *uuid.UUID
+	LastUsedAt   time.Time
+} {
+	var calls []struct {
+		Ctx          context.Context
+		ApiTokenUUID *uuid.UUID
+		LastUsedAt   time.Time
+	}
+	mock.lockTouchAPIToken.RLock()
+	calls = mock.calls.TouchAPIToken
+	mock.lockTouchAPIToken.RUnlock()
+	return calls
+}
+
+// UpdateMatrixUser calls UpdateMatrixUserFunc.
+func (mock *UserRepositoryMock) UpdateMatrixUser(ctx context.Context, uuidMoqParam *uuid.UUID, matrixUser *entity.MatrixUser) (*entity.MatrixUser, error) {
+	if mock.UpdateMatrixUserFunc == nil {
+		panic("UserRepositoryMock.UpdateMatrixUserFunc: method is nil but UserRepository.UpdateMatrixUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		MatrixUser   *entity.MatrixUser
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+		MatrixUser:   matrixUser,
+	}
+	mock.lockUpdateMatrixUser.Lock()
+	mock.calls.UpdateMatrixUser = append(mock.calls.UpdateMatrixUser, callInfo)
+	mock.lockUpdateMatrixUser.Unlock()
+	return mock.UpdateMatrixUserFunc(ctx, uuidMoqParam, matrixUser)
+}
+
+// UpdateMatrixUserCalls gets all the calls that were made to UpdateMatrixUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.UpdateMatrixUserCalls())
+func (mock *UserRepositoryMock) UpdateMatrixUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+	MatrixUser   *entity.MatrixUser
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		MatrixUser   *entity.MatrixUser
+	}
+	mock.lockUpdateMatrixUser.RLock()
+	calls = mock.calls.UpdateMatrixUser
+	mock.lockUpdateMatrixUser.RUnlock()
+	return calls
+}
+
+// UpdatePasswordUser calls UpdatePasswordUserFunc.
+func (mock *UserRepositoryMock) UpdatePasswordUser(ctx context.Context, uuidMoqParam *uuid.UUID, passwordUser *entity.PasswordUser) (*entity.PasswordUser, error) {
+	if mock.UpdatePasswordUserFunc == nil {
+		panic("UserRepositoryMock.UpdatePasswordUserFunc: method is nil but UserRepository.UpdatePasswordUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		PasswordUser *entity.PasswordUser
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+		PasswordUser: passwordUser,
+	}
+	mock.lockUpdatePasswordUser.Lock()
+	mock.calls.UpdatePasswordUser = append(mock.calls.UpdatePasswordUser, callInfo)
+	mock.lockUpdatePasswordUser.Unlock()
+	return mock.UpdatePasswordUserFunc(ctx, uuidMoqParam, passwordUser)
+}
+
+// UpdatePasswordUserCalls gets all the calls that were made to UpdatePasswordUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.UpdatePasswordUserCalls())
+func (mock *UserRepositoryMock) UpdatePasswordUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+	PasswordUser *entity.PasswordUser
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		PasswordUser *entity.PasswordUser
+	}
+	mock.lockUpdatePasswordUser.RLock()
+	calls = mock.calls.UpdatePasswordUser
+	mock.lockUpdatePasswordUser.RUnlock()
+	return calls
+}
+
+// UpdateSSHUser calls UpdateSSHUserFunc.
+func (mock *UserRepositoryMock) UpdateSSHUser(ctx context.Context, uuidMoqParam *uuid.UUID, sshUser *entity.SSHUser) (*entity.SSHUser, error) {
+	if mock.UpdateSSHUserFunc == nil {
+		panic("UserRepositoryMock.UpdateSSHUserFunc: method is nil but UserRepository.UpdateSSHUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		SshUser      *entity.SSHUser
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+		SshUser:      sshUser,
+	}
+	mock.lockUpdateSSHUser.Lock()
+	mock.calls.UpdateSSHUser = append(mock.calls.UpdateSSHUser, callInfo)
+	mock.lockUpdateSSHUser.Unlock()
+	return mock.UpdateSSHUserFunc(ctx, uuidMoqParam, sshUser)
+}
+
+// UpdateSSHUserCalls gets all the calls that were made to UpdateSSHUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.UpdateSSHUserCalls())
+func (mock *UserRepositoryMock) UpdateSSHUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+	SshUser      *entity.SSHUser
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		SshUser      *entity.SSHUser
+	}
+	mock.lockUpdateSSHUser.RLock()
+	calls = mock.calls.UpdateSSHUser
+	mock.lockUpdateSSHUser.RUnlock()
+	return calls
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (mock *UserRepositoryMock) UpdateUser(ctx context.Context, uuidMoqParam *uuid.UUID, user *entity.User) (*entity.User, error) {
+	if mock.UpdateUserFunc == nil {
+		panic("UserRepositoryMock.UpdateUserFunc: method is nil but UserRepository.UpdateUser was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		User         *entity.User
+	}{
+		Ctx:          ctx,
+		UuidMoqParam: uuidMoqParam,
+		User:         user,
+	}
+	mock.lockUpdateUser.Lock()
+	mock.calls.UpdateUser = append(mock.calls.UpdateUser, callInfo)
+	mock.lockUpdateUser.Unlock()
+	return mock.UpdateUserFunc(ctx, uuidMoqParam, user)
+}
+
+// UpdateUserCalls gets all the calls that were made to UpdateUser.
+// Check the length with:
+//
+//	len(mockedUserRepository.UpdateUserCalls())
+func (mock *UserRepositoryMock) UpdateUserCalls() []struct {
+	Ctx          context.Context
+	UuidMoqParam *uuid.UUID
+	User         *entity.User
+} {
+	var calls []struct {
+		Ctx          context.Context
+		UuidMoqParam *uuid.UUID
+		User         *entity.User
+	}
+	mock.lockUpdateUser.RLock()
+	calls = mock.calls.UpdateUser
+	mock.lockUpdateUser.RUnlock()
+	return calls
+}
+
+// UseRecoveryCode calls UseRecoveryCodeFunc.
+func (mock *UserRepositoryMock) UseRecoveryCode(ctx context.Context, recoveryCodeUUID *uuid.UUID) error {
+	if mock.UseRecoveryCodeFunc == nil {
+		panic("UserRepositoryMock.UseRecoveryCodeFunc: method is nil but UserRepository.UseRecoveryCode was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		RecoveryCodeUUID *uuid.UUID
+	}{
+		Ctx:              ctx,
+		RecoveryCodeUUID: recoveryCodeUUID,
+	}
+	mock.lockUseRecoveryCode.Lock()
+	mock.calls.UseRecoveryCode = append(mock.calls.UseRecoveryCode, callInfo)
+	mock.lockUseRecoveryCode.Unlock()
+	return mock.UseRecoveryCodeFunc(ctx, recoveryCodeUUID)
+}
+
+// UseRecoveryCodeCalls gets all the calls that were made to UseRecoveryCode.
+// Check the length with:
+//
+//	len(mockedUserRepository.UseRecoveryCodeCalls())
+func (mock *UserRepositoryMock) UseRecoveryCodeCalls() []struct {
+	Ctx              context.Context
+	RecoveryCodeUUID *uuid.UUID
+} {
+	var calls []struct {
+		Ctx              context.Context
+		RecoveryCodeUUID *uuid.UUID
+	}
+	mock.lockUseRecoveryCode.RLock()
+	calls = mock.calls.UseRecoveryCode
+	mock.lockUseRecoveryCode.RUnlock()
+	return calls
+}
+
+// UseTOTPStep calls UseTOTPStepFunc.
+func (mock *UserRepositoryMock) UseTOTPStep(ctx context.Context, userUUID *uuid.UUID, step int64) error {
+	if mock.UseTOTPStepFunc == nil {
+		panic("UserRepositoryMock.UseTOTPStepFunc: method is nil but UserRepository.UseTOTPStep was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Step     int64
+	}{
+		Ctx:      ctx,
+		UserUUID: userUUID,
+		Step:     step,
+	}
+	mock.lockUseTOTPStep.Lock()
+	mock.calls.UseTOTPStep = append(mock.calls.UseTOTPStep, callInfo)
+	mock.lockUseTOTPStep.Unlock()
+	return mock.UseTOTPStepFunc(ctx, userUUID, step)
+}
+
+// UseTOTPStepCalls gets all the calls that were made to UseTOTPStep.
+// Check the length with:
+//
+//	len(mockedUserRepository.UseTOTPStepCalls())
+func (mock *UserRepositoryMock) UseTOTPStepCalls() []struct {
+	Ctx      context.Context
+	UserUUID *uuid.UUID
+	Step     int64
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserUUID *uuid.UUID
+		Step     int64
+	}
+	mock.lockUseTOTPStep.RLock()
+	calls = mock.calls.UseTOTPStep
+	mock.lockUseTOTPStep.RUnlock()
+	return calls
+}
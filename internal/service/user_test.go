@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+// userRepository uses the roles of roleRepository and counts the given number
+// of admins.
+func userRepository(admins int64) *UserRepositoryMock {
+	return &UserRepositoryMock{
+		GetRolesFunc: roleRepository.GetRolesFunc,
+		CountUsersWithRoleFunc: func(ctx context.Context, role entity.Role) (int64, error) {
+			return admins, nil
+		},
+		RevokeRoleFunc: func(ctx context.Context, userUUID *uuid.UUID, role entity.Role) error {
+			return nil
+		},
+		DeleteUserFunc: func(ctx context.Context, uuid *uuid.UUID) error {
+			return nil
+		},
+	}
+}
+
+func TestRevokeRole(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name        string
+		currentUser *uuid.UUID
+		userUUID    *uuid.UUID
+		role        entity.Role
+		admins      int64
+		revoked     bool
+		err         error
+	}
+
+	testCases := []testCase{
+		{name: "admin from another admin", currentUser: &adminUUID, userUUID: &adminUUID, role: entity.RoleAdmin, admins: 2, revoked: true},
+		{name: "admin from the last admin", currentUser: &adminUUID, userUUID: &adminUUID, role: entity.RoleAdmin, admins: 1, err: ErrLastAdmin},
+		{name: "member from the last admin", currentUser: &adminUUID, userUUID: &adminUUID, role: entity.RoleMember, admins: 1, revoked: true},
+		{name: "admin from a member", currentUser: &adminUUID, userUUID: &memberUUID, role: entity.RoleAdmin, admins: 1, revoked: true},
+		{name: "by a member", currentUser: &memberUUID, userUUID: &adminUUID, role: entity.RoleAdmin, admins: 2, err: ErrPermissionDenied},
+		{name: "unknown role", currentUser: &adminUUID, userUUID: &memberUUID, role: entity.Role("cook"), admins: 2, err: ErrUnknownRole},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			users := userRepository(tc.admins)
+			userService := &UserService{UserRepository: users, Authorizer: &AuthorizationService{RoleRepository: roleRepository}}
+
+			err := userService.RevokeRole(ctx, tc.currentUser, tc.userUUID, tc.role)
+
+			if tc.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.err)
+			}
+
+			assert.Equal(t, tc.revoked, len(users.RevokeRoleCalls()) == 1)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctx := t.Context()
+
+	type testCase struct {
+		name        string
+		currentUser *uuid.UUID
+		userUUID    *uuid.UUID
+		admins      int64
+		deleted     bool
+		err         error
+	}
+
+	testCases := []testCase{
+		{name: "member deletes themself", currentUser: &memberUUID, userUUID: &memberUUID, admins: 1, deleted: true},
+		{name: "admin deletes themself", currentUser: &adminUUID, userUUID: &adminUUID, admins: 2, deleted: true},
+		{name: "last admin deletes themself", currentUser: &adminUUID, userUUID: &adminUUID, admins: 1, err: ErrLastAdmin},
+		{name: "admin deletes a member", currentUser: &adminUUID, userUUID: &memberUUID, admins: 1, deleted: true},
+		{name: "member deletes an admin", currentUser: &memberUUID, userUUID: &adminUUID, admins: 2, err: ErrPermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			users := userRepository(tc.admins)
+			userService := &UserService{UserRepository: users, Authorizer: &AuthorizationService{RoleRepository: roleRepository}}
+
+			err := userService.DeleteUser(ctx, tc.currentUser, tc.userUUID)
+
+			if tc.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.err)
+			}
+
+			assert.Equal(t, tc.deleted, len(users.DeleteUserCalls()) == 1)
+		})
+	}
+}
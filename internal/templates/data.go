@@ -0,0 +1,77 @@
+package templates
+
+// Order is the data all templates get, an active order and its items. Prices
+// and dates are formatted and the state is named in the language of the reply.
+type Order struct {
+	// Menu is the name of the menu.
+	Menu string
+	// MenuURL links the menu of the restaurant, it is empty if there is none.
+	MenuURL string
+	State   string
+	// Shared is true if the order is visible in all rooms.
+	Shared bool
+	// Initiator is the name of the user who started the order.
+	Initiator string
+	// SugarPerson is the name of the user who pays the restaurant, it is empty
+	// until someone is set.
+	SugarPerson string
+	// Deadline and ETA are empty if they are not set.
+	Deadline string
+	ETA      string
+	// Dishes are the items of all users added up by dish and price, sorted by
+	// short name, which is what the restaurant needs to know.
+	Dishes []Item
+	// Users are the users with items in the order, sorted by name.
+	Users []User
+	// Debtors are the users who still owe the sugar person money.
+	Debtors []User
+	// Count is the number of items.
+	Count int
+	Total string
+}
+
+// User is a user with items in the order.
+type User struct {
+	Name string
+	// Items are added up by dish and price, sorted by short name.
+	Items []Item
+	Total string
+	// Due is what the user has not paid yet.
+	Due  string
+	Paid bool
+}
+
+// Item is a dish ordered Quantity times.
+type Item struct {
+	ShortName string
+	Name      string
+	Quantity  int
+	// Price is the price of one dish, Total that of all of them.
+	Price string
+	Total string
+}
+
+// example is rendered by Load to validate the templates, it fills in every
+// field so that all branches of a template are taken.
+var example = func() Order {
+	margherita := Item{ShortName: "1", Name: "Pizza Margherita", Quantity: 2, Price: "7.50", Total: "15.00"}
+	salad := Item{ShortName: "42", Name: "Salad", Quantity: 1, Price: "5.90", Total: "5.90"}
+	alice := User{Name: "Alice", Items: []Item{margherita}, Total: "15.00", Due: "0.00", Paid: true}
+	bob := User{Name: "Bob", Items: []Item{salad}, Total: "5.90", Due: "5.90"}
+
+	return Order{
+		Menu:        "pizza",
+		MenuURL:     "https://example.com/menu",
+		State:       "open",
+		Shared:      true,
+		Initiator:   "Alice",
+		SugarPerson: "Alice",
+		Deadline:    "2026-10-19 12:00:00",
+		ETA:         "2026-10-19 12:45:00",
+		Dishes:      []Item{margherita, salad},
+		Users:       []User{alice, bob},
+		Debtors:     []User{bob},
+		Count:       3,
+		Total:       "20.90",
+	}
+}()
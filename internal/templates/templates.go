@@ -0,0 +1,198 @@
+// Package templates renders the bot replies operators may want to word
+// differently, like the status summary, the call sheet for the restaurant and
+// the payment reminder.
+//
+// Every reply has a plain text template, rendered with text/template, and an
+// html template, rendered with html/template, named <name>.txt.tmpl and
+// <name>.html.tmpl. Templates for a language are named <name>.<language>.txt.tmpl
+// and <name>.<language>.html.tmpl. The templates in the defaults directory are
+// embedded, templates of the same name in the template directory replace them.
+// A template without a language in the template directory also replaces the
+// embedded templates of the other languages, unless the template directory has
+// them too. All templates get an *Order, see data.go.
+package templates
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	Status    = "status"
+	CallSheet = "callsheet"
+	Reminder  = "reminder"
+)
+
+// Names are the names of the templates.
+var Names = []string{Status, CallSheet, Reminder}
+
+var (
+	ErrUnknownTemplate   = errors.New("unknown template")
+	ErrParsingTemplate   = errors.New("could not parse template")
+	ErrInvalidTemplate   = errors.New("invalid template")
+	ErrRenderingTemplate = errors.New("could not render template")
+)
+
+const (
+	textKind = "txt"
+	htmlKind = "html"
+)
+
+//go:embed defaults/*.tmpl
+var defaults embed.FS
+
+type template interface {
+	Execute(w io.Writer, data any) error
+}
+
+// Templates are the templates by file name without the .tmpl suffix, e.g.
+// status.de.html.
+type Templates struct {
+	templates map[string]template
+}
+
+// Load loads the default templates and replaces them with those in dir, if
+// it is not empty. Every template is rendered once with example data, so
+// that mistakes like misspelled fields show up at startup.
+func Load(dir string) (*Templates, error) {
+	t := &Templates{templates: map[string]template{}}
+
+	if _, err := t.add(defaults, "defaults"); err != nil {
+		return nil, err
+	}
+
+	if dir != "" {
+		overrides, err := t.add(os.DirFS(dir), ".")
+		if err != nil {
+			return nil, err
+		}
+
+		t.dropDefaultLanguages(overrides)
+	}
+
+	for key, tmpl := range t.templates {
+		if err := tmpl.Execute(io.Discard, &example); err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrInvalidTemplate, key, err)
+		}
+	}
+
+	return t, nil
+}
+
+// add parses the templates in dir and returns their keys.
+func (t *Templates) add(fsys fs.FS, dir string) ([]string, error) {
+	files, err := fs.Glob(fsys, path.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParsingTemplate, err)
+	}
+
+	keys := make([]string, 0, len(files))
+
+	for _, file := range files {
+		name := path.Base(file)
+
+		key, kind, err := templateKey(name)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrParsingTemplate, name, err)
+		}
+
+		var tmpl template
+
+		if kind == htmlKind {
+			tmpl, err = htmltemplate.New(name).Parse(string(content))
+		} else {
+			tmpl, err = texttemplate.New(name).Parse(string(content))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrParsingTemplate, name, err)
+		}
+
+		t.templates[key] = tmpl
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// dropDefaultLanguages removes the embedded language templates of every
+// override without a language, so the override is used in all languages
+// instead of only where no language template exists. Language templates in
+// overrides are kept.
+func (t *Templates) dropDefaultLanguages(overrides []string) {
+	for _, override := range overrides {
+		parts := strings.Split(override, ".")
+		if len(parts) != 2 {
+			continue
+		}
+
+		name, kind := parts[0], parts[1]
+
+		for key := range t.templates {
+			keyParts := strings.Split(key, ".")
+			if len(keyParts) == 3 && keyParts[0] == name && keyParts[2] == kind && !slices.Contains(overrides, key) {
+				delete(t.templates, key)
+			}
+		}
+	}
+}
+
+// templateKey checks that the file name is that of a known template and
+// returns it without the .tmpl suffix along with the kind of template.
+func templateKey(fileName string) (key, kind string, err error) {
+	key = strings.TrimSuffix(fileName, ".tmpl")
+	parts := strings.Split(key, ".")
+	kind = parts[len(parts)-1]
+
+	if len(parts) < 2 || len(parts) > 3 || !slices.Contains(Names, parts[0]) || (kind != textKind && kind != htmlKind) {
+		return "", "", fmt.Errorf("%w: %s, the templates are %s", ErrUnknownTemplate, fileName, strings.Join(Names, ", "))
+	}
+
+	return key, kind, nil
+}
+
+// Render renders the text and the html template of the reply in the language,
+// falling back to the templates without a language.
+func (t *Templates) Render(name, lang string, data *Order) (text, html string, err error) {
+	if text, err = t.render(name, lang, textKind, data); err != nil {
+		return "", "", err
+	}
+
+	if html, err = t.render(name, lang, htmlKind, data); err != nil {
+		return "", "", err
+	}
+
+	return text, html, nil
+}
+
+func (t *Templates) render(name, lang, kind string, data *Order) (string, error) {
+	tmpl, ok := t.templates[name+"."+lang+"."+kind]
+	if !ok {
+		tmpl, ok = t.templates[name+"."+kind]
+	}
+
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("%w %s: %w", ErrRenderingTemplate, name, err)
+	}
+
+	return strings.TrimSpace(b.String()), nil
+}
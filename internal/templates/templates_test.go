@@ -0,0 +1,209 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	tmpl, err := Load("")
+	require.NoError(t, err)
+
+	type testCase struct {
+		name string
+		tmpl string
+		lang string
+		data Order
+		text string
+		html string
+	}
+
+	testCases := []testCase{
+		{
+			name: "should render status",
+			tmpl: Status,
+			data: example,
+			text: "pizza is open, shared with all rooms, started by Alice, paid by Alice\n" +
+				"order until 2026-10-19 12:00:00\n" +
+				"expected at 2026-10-19 12:45:00\n\n" +
+				"Alice: 2x Pizza Margherita (15.00)\n" +
+				"Bob: 1x Salad (5.90)\n\n" +
+				"total: 20.90",
+			html: "<b>pizza</b> is open, shared with all rooms, started by Alice, paid by Alice" +
+				"<br>order until 2026-10-19 12:00:00<br>expected at 2026-10-19 12:45:00\n" +
+				"<ul>\n<li>Alice: 2x Pizza Margherita (15.00)</li>\n<li>Bob: 1x Salad (5.90)</li>\n</ul>\n" +
+				"total: 20.90",
+		},
+		{
+			name: "should render status without items",
+			tmpl: Status,
+			data: Order{Menu: "pizza", State: "open", Initiator: "Alice"},
+			text: "pizza is open, started by Alice\n\nnothing ordered yet",
+			html: "<b>pizza</b> is open, started by Alice<br>nothing ordered yet",
+		},
+		{
+			name: "should render call sheet",
+			tmpl: CallSheet,
+			data: example,
+			text: "pizza (https://example.com/menu)\n\n2x 1 Pizza Margherita\n1x 42 Salad\n\ndishes: 3, total: 20.90",
+			html: "<b><a href=\"https://example.com/menu\">pizza</a></b>\n" +
+				"<ul>\n<li>2x <b>1</b> Pizza Margherita</li>\n<li>1x <b>42</b> Salad</li>\n</ul>\n" +
+				"dishes: 3, total: 20.90",
+		},
+		{
+			name: "should render reminder",
+			tmpl: Reminder,
+			data: example,
+			text: "please pay Alice for your pizza order:\nBob: 5.90",
+			html: "please pay Alice for your <b>pizza</b> order:\n<ul>\n<li>Bob: 5.90</li>\n</ul>",
+		},
+		{
+			name: "should render reminder if everyone has paid",
+			tmpl: Reminder,
+			data: Order{Menu: "pizza"},
+			text: "everyone has paid for the pizza order",
+			html: "everyone has paid for the <b>pizza</b> order",
+		},
+		{
+			name: "should render template of the language",
+			tmpl: Reminder,
+			lang: "de",
+			data: Order{Menu: "pizza"},
+			text: "alle haben für die Bestellung bei pizza bezahlt",
+			html: "alle haben für die Bestellung bei <b>pizza</b> bezahlt",
+		},
+		{
+			name: "should fall back to template without language",
+			tmpl: Reminder,
+			lang: "fr",
+			data: Order{Menu: "pizza"},
+			text: "everyone has paid for the pizza order",
+			html: "everyone has paid for the <b>pizza</b> order",
+		},
+		{
+			name: "should escape html",
+			tmpl: Reminder,
+			data: Order{Menu: "<script>"},
+			text: "everyone has paid for the <script> order",
+			html: "everyone has paid for the <b>&lt;script&gt;</b> order",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			text, html, err := tmpl.Render(tc.tmpl, tc.lang, &tc.data)
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, text)
+			assert.Equal(t, tc.html, html)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	type testCase struct {
+		name  string
+		files map[string]string
+		tmpl  string
+		lang  string
+		text  string
+		err   error
+	}
+
+	testCases := []testCase{
+		{
+			name:  "should override default template",
+			files: map[string]string{"reminder.txt.tmpl": "pay up for {{.Menu}}"},
+			text:  "pay up for pizza",
+		},
+		{
+			name:  "should override template of a language",
+			files: map[string]string{"reminder.de.txt.tmpl": "zahlt für {{.Menu}}"},
+			text:  "everyone has paid for the pizza order",
+		},
+		{
+			name:  "should use override without language in all languages",
+			files: map[string]string{"status.txt.tmpl": "status of {{.Menu}}"},
+			tmpl:  Status,
+			lang:  "de",
+			text:  "status of pizza",
+		},
+		{
+			name: "should prefer override of the language",
+			files: map[string]string{
+				"status.txt.tmpl":    "status of {{.Menu}}",
+				"status.de.txt.tmpl": "Status von {{.Menu}}",
+			},
+			tmpl: Status,
+			lang: "de",
+			text: "Status von pizza",
+		},
+		{
+			name:  "should keep default of the language for the other kind",
+			files: map[string]string{"status.html.tmpl": "status of <b>{{.Menu}}</b>"},
+			tmpl:  Status,
+			lang:  "de",
+			text:  "pizza ist , gestartet von \n\nnoch nichts bestellt",
+		},
+		{
+			name:  "should ignore other files",
+			files: map[string]string{"README.md": "{{"},
+			text:  "everyone has paid for the pizza order",
+		},
+		{
+			name:  "should fail on unknown template",
+			files: map[string]string{"remind.txt.tmpl": "pay up"},
+			err:   ErrUnknownTemplate,
+		},
+		{
+			name:  "should fail on unknown kind of template",
+			files: map[string]string{"reminder.md.tmpl": "pay up"},
+			err:   ErrUnknownTemplate,
+		},
+		{
+			name:  "should fail on syntax error",
+			files: map[string]string{"reminder.html.tmpl": "{{if .Debtors}}pay up"},
+			err:   ErrParsingTemplate,
+		},
+		{
+			name:  "should fail on unknown field",
+			files: map[string]string{"status.txt.tmpl": "{{.Restaurant}}"},
+			err:   ErrInvalidTemplate,
+		},
+		{
+			name:  "should fail on unknown field in branch",
+			files: map[string]string{"status.de.txt.tmpl": "{{range .Users}}{{.Amount}}{{end}}"},
+			err:   ErrInvalidTemplate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			for name, content := range tc.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+
+			tmpl, err := Load(dir)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if tc.tmpl == "" {
+				tc.tmpl = Reminder
+			}
+
+			text, _, err := tmpl.Render(tc.tmpl, tc.lang, &Order{Menu: "pizza"})
+			require.NoError(t, err)
+			assert.Equal(t, tc.text, text)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/Markus-Schwer/ordaa/internal/crypto"
+)
+
+const usage = `usage: argon2_params [flags]
+
+Benchmarks argon2id on this machine and prints the ARGON2_* settings for the
+strongest parameters that still hash a password within -target.
+`
+
+func main() {
+	var (
+		target    time.Duration
+		maxMemory uint
+		threads   uint
+	)
+
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.DurationVar(&target, "target", 500*time.Millisecond, "maximum time a single hash may take")
+	flag.UintVar(&maxMemory, "max-memory", 64*1024, "maximum memory per hash in KiB")
+	flag.UintVar(&threads, "threads", uint(runtime.NumCPU()), "number of threads per hash")
+	flag.Parse()
+
+	if maxMemory > math.MaxUint32 || threads == 0 || threads > math.MaxUint8 {
+		fmt.Fprintln(os.Stderr, "max-memory or threads out of range")
+		os.Exit(2)
+	}
+
+	params, duration := crypto.SuggestArgon2idHash(target, uint32(maxMemory), uint8(threads))
+
+	fmt.Fprintf(os.Stderr, "one hash takes %s\n", duration.Round(time.Millisecond))
+	fmt.Printf("ARGON2_TIME=%d\nARGON2_MEMORY=%d\nARGON2_THREADS=%d\n", params.Time(), params.Memory(), params.Threads())
+}
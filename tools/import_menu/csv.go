@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/price"
+)
+
+var errInvalidCSVHeader = errors.New("csv header must contain the columns short_name, name and price")
+
+// csvFormat reads and writes one menu item per row with the columns
+// short_name, name and price. Prices are written in euros ("7.90"), so the
+// file can be edited in a spreadsheet. The menu name and url are not part of
+// the file and have to be passed in.
+type csvFormat struct {
+	menuName string
+	menuURL  string
+}
+
+var csvHeader = []string{"short_name", "name", "price"}
+
+func (f *csvFormat) read(r io.Reader) (*entity.Menu, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+
+	for _, column := range csvHeader {
+		if _, ok := columns[column]; !ok {
+			return nil, errInvalidCSVHeader
+		}
+	}
+
+	menu := &entity.Menu{Name: f.menuName, URL: f.menuURL}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("reading csv: %w", err)
+		}
+
+		itemPrice, err := price.Parse(record[columns["price"]])
+		if err != nil {
+			line, _ := reader.FieldPos(columns["price"])
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		menu.Items = append(menu.Items, entity.MenuItem{
+			ShortName: strings.TrimSpace(record[columns["short_name"]]),
+			Name:      strings.TrimSpace(record[columns["name"]]),
+			Price:     itemPrice,
+		})
+	}
+
+	return menu, nil
+}
+
+func (f *csvFormat) write(w io.Writer, menu *entity.Menu) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, item := range sortedItems(menu.Items) {
+		if err := writer.Write([]string{item.ShortName, item.Name, price.Format(item.Price)}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+var (
+	errUnknownFormat   = errors.New("unknown menu file format")
+	errMenuNameMissing = errors.New("menu name missing")
+)
+
+type menuFormat interface {
+	read(r io.Reader) (*entity.Menu, error)
+	write(w io.Writer, menu *entity.Menu) error
+}
+
+// menuFile is the serialized form of a menu shared by the json and yaml
+// formats. It matches the shape of entity.Menu without any database ids.
+type menuFile struct {
+	Name  string         `json:"name" yaml:"name"`
+	URL   string         `json:"url,omitempty" yaml:"url,omitempty"`
+	Items []menuFileItem `json:"items" yaml:"items"`
+}
+
+type menuFileItem struct {
+	ShortName string `json:"short_name" yaml:"short_name"`
+	Name      string `json:"name" yaml:"name"`
+	Price     int    `json:"price" yaml:"price"`
+}
+
+// formatFor picks the format by name or, if name is empty, by the extension
+// of filename. Web pages are read as html. menuName and menuURL are used by
+// formats that cannot store them or where they are optional.
+func formatFor(name, filename, menuName, menuURL string) (menuFormat, error) {
+	if name == "" && isURL(filename) {
+		name = "html"
+	} else if name == "" {
+		name = strings.TrimPrefix(strings.ToLower(filepath.Ext(filename)), ".")
+	}
+
+	switch name {
+	case "json":
+		return &jsonFormat{}, nil
+	case "yaml", "yml":
+		return &yamlFormat{}, nil
+	case "csv":
+		if menuName == "" {
+			menuName = strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+		}
+
+		return &csvFormat{menuName: menuName, menuURL: menuURL}, nil
+	case "html", "htm", "jsonld":
+		if menuURL == "" && isURL(filename) {
+			menuURL = filename
+		}
+
+		return &jsonLDFormat{menuName: menuName, menuURL: menuURL}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownFormat, name)
+	}
+}
+
+func isURL(filename string) bool {
+	return strings.HasPrefix(filename, "http://") || strings.HasPrefix(filename, "https://")
+}
+
+func toMenuFile(menu *entity.Menu) *menuFile {
+	file := &menuFile{Name: menu.Name, URL: menu.URL, Items: make([]menuFileItem, 0, len(menu.Items))}
+
+	for _, item := range sortedItems(menu.Items) {
+		file.Items = append(file.Items, menuFileItem{ShortName: item.ShortName, Name: item.Name, Price: item.Price})
+	}
+
+	return file
+}
+
+func (file *menuFile) toMenu() *entity.Menu {
+	menu := &entity.Menu{Name: file.Name, URL: file.URL, Items: make([]entity.MenuItem, 0, len(file.Items))}
+
+	for _, item := range file.Items {
+		menu.Items = append(menu.Items, entity.MenuItem{ShortName: item.ShortName, Name: item.Name, Price: item.Price})
+	}
+
+	return menu
+}
+
+func sortedItems(items []entity.MenuItem) []entity.MenuItem {
+	sorted := append([]entity.MenuItem{}, items...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ShortName < sorted[j].ShortName
+	})
+
+	return sorted
+}
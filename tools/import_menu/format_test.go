@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/price"
+)
+
+func TestFormatFor(t *testing.T) {
+	format, err := formatFor("", "menus/Sangam.csv", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, &csvFormat{menuName: "Sangam"}, format)
+
+	format, err = formatFor("", "sangam.YML", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, &yamlFormat{}, format)
+
+	format, err = formatFor("json", "sangam.txt", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, &jsonFormat{}, format)
+
+	_, err = formatFor("", "sangam.xlsx", "", "")
+	assert.ErrorIs(t, err, errUnknownFormat)
+}
+
+func TestRoundTrip(t *testing.T) {
+	menu := &entity.Menu{
+		Name: "Sangam",
+		URL:  "https://sangam.example",
+		Items: []entity.MenuItem{
+			{ShortName: "M1", Name: "Dal Maharani", Price: 790},
+			{ShortName: "174", Name: "Nan, \"extra\" crispy", Price: 320},
+		},
+	}
+
+	formats := map[string]menuFormat{
+		"json": &jsonFormat{},
+		"yaml": &yamlFormat{},
+		"csv":  &csvFormat{menuName: "Sangam", menuURL: "https://sangam.example"},
+	}
+
+	for name, format := range formats {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			require.NoError(t, format.write(&buf, menu))
+
+			readMenu, err := format.read(&buf)
+			require.NoError(t, err)
+
+			assert.Equal(t, menu.Name, readMenu.Name)
+			assert.Equal(t, menu.URL, readMenu.URL)
+			assert.ElementsMatch(t, menu.Items, readMenu.Items)
+		})
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	input := "Price,Name,Short_Name\n\"7,90\",Dal Maharani,M1\n3.20,Nan,174\n"
+
+	menu, err := (&csvFormat{menuName: "Sangam"}).read(strings.NewReader(input))
+	require.NoError(t, err)
+
+	assert.Equal(t, []entity.MenuItem{
+		{ShortName: "M1", Name: "Dal Maharani", Price: 790},
+		{ShortName: "174", Name: "Nan", Price: 320},
+	}, menu.Items)
+
+	_, err = (&csvFormat{}).read(strings.NewReader("short_name,name\nM1,Dal Maharani\n"))
+	assert.ErrorIs(t, err, errInvalidCSVHeader)
+
+	_, err = (&csvFormat{}).read(strings.NewReader("short_name,name,price\nM1,Dal Maharani,cheap\n"))
+	assert.ErrorIs(t, err, price.ErrInvalidPrice)
+}
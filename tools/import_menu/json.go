@@ -0,0 +1,26 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+type jsonFormat struct{}
+
+func (f *jsonFormat) read(r io.Reader) (*entity.Menu, error) {
+	var file menuFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	return file.toMenu(), nil
+}
+
+func (f *jsonFormat) write(w io.Writer, menu *entity.Menu) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+
+	return encoder.Encode(toMenuFile(menu))
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/price"
+)
+
+var (
+	errNoJSONLDMenu      = errors.New("no schema.org menu found in page")
+	errWriteNotSupported = errors.New("writing is not supported for this format")
+)
+
+const (
+	// maxShortNameLen is the length of the menu_items.short_name column.
+	maxShortNameLen = 10
+	// maxAbbreviationLen is the number of words of an item name that are
+	// used for a generated short name.
+	maxAbbreviationLen = 4
+)
+
+// jsonLDFormat reads a menu from the schema.org JSON-LD embedded in an html
+// page. It understands Menu, MenuSection, MenuItem and Offer nodes, either on
+// their own, nested in a Restaurant via hasMenu or inside an @graph.
+type jsonLDFormat struct {
+	menuName string
+	menuURL  string
+}
+
+func (f *jsonLDFormat) read(r io.Reader) (*entity.Menu, error) {
+	documents, err := extractJSONLD(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		menuNode       map[string]any
+		restaurantMenu map[string]any
+		restaurantName string
+	)
+
+	for _, document := range documents {
+		walkJSONLD(document, func(node map[string]any) {
+			if restaurantMenu == nil && hasType(node, "Restaurant", "FoodEstablishment") {
+				if menu, ok := firstNode(node["hasMenu"]); ok {
+					restaurantMenu = menu
+					restaurantName = stringValue(node["name"])
+				}
+			}
+
+			if menuNode == nil && hasType(node, "Menu") {
+				menuNode = node
+			}
+		})
+	}
+
+	if restaurantMenu != nil {
+		menuNode = restaurantMenu
+	}
+
+	if menuNode == nil {
+		return nil, errNoJSONLDMenu
+	}
+
+	menu := &entity.Menu{Name: f.menuName, URL: f.menuURL}
+
+	if menu.Name == "" {
+		menu.Name = restaurantName
+	}
+
+	if menu.Name == "" {
+		menu.Name = stringValue(menuNode["name"])
+	}
+
+	if menu.URL == "" {
+		menu.URL = stringValue(menuNode["url"])
+	}
+
+	menu.Items = menuItems(menuNode)
+	if len(menu.Items) == 0 {
+		return nil, errNoJSONLDMenu
+	}
+
+	return menu, nil
+}
+
+func (f *jsonLDFormat) write(w io.Writer, menu *entity.Menu) error {
+	return fmt.Errorf("%w: html", errWriteNotSupported)
+}
+
+// extractJSONLD returns the decoded content of all application/ld+json script
+// tags in the html document. Scripts that are not valid json are skipped.
+func extractJSONLD(r io.Reader) ([]any, error) {
+	document, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("parsing html: %w", err)
+	}
+
+	var documents []any
+
+	for node := range document.Descendants() {
+		if node.Type != html.ElementNode || node.DataAtom != atom.Script || !isJSONLDScript(node) {
+			continue
+		}
+
+		var content strings.Builder
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			content.WriteString(child.Data)
+		}
+
+		var decoded any
+		if err := json.Unmarshal([]byte(content.String()), &decoded); err != nil {
+			continue
+		}
+
+		documents = append(documents, decoded)
+	}
+
+	return documents, nil
+}
+
+func isJSONLDScript(node *html.Node) bool {
+	for _, attr := range node.Attr {
+		if attr.Key == "type" && strings.EqualFold(strings.TrimSpace(attr.Val), "application/ld+json") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// walkJSONLD calls visit for every object in the json value, depth first. The
+// properties of an object are walked in sorted order, so the same document is
+// always walked the same way.
+func walkJSONLD(value any, visit func(node map[string]any)) {
+	switch v := value.(type) {
+	case map[string]any:
+		visit(v)
+
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			walkJSONLD(v[key], visit)
+		}
+	case []any:
+		for _, child := range v {
+			walkJSONLD(child, visit)
+		}
+	}
+}
+
+func menuItems(menuNode map[string]any) []entity.MenuItem {
+	var (
+		items      []entity.MenuItem
+		itemNodes  []map[string]any
+		collectAll func(node map[string]any)
+	)
+
+	collectAll = func(node map[string]any) {
+		itemNodes = append(itemNodes, nodes(node["hasMenuItem"])...)
+
+		for _, section := range nodes(node["hasMenuSection"]) {
+			collectAll(section)
+		}
+	}
+
+	collectAll(menuNode)
+
+	for _, node := range itemNodes {
+		name := strings.TrimSpace(stringValue(node["name"]))
+
+		itemPrice, ok := offerPrice(node["offers"])
+		if name == "" || !ok {
+			continue
+		}
+
+		items = append(items, entity.MenuItem{ShortName: itemIdentifier(node), Name: name, Price: itemPrice})
+	}
+
+	assigned := make(map[string]bool, len(items))
+	unnamed := []int{}
+
+	for i, item := range items {
+		if item.ShortName == "" || assigned[item.ShortName] {
+			unnamed = append(unnamed, i)
+			continue
+		}
+
+		assigned[item.ShortName] = true
+	}
+
+	// items without a usable identifier get a short name derived from their
+	// name. Colliding abbreviations are numbered in the order of the names, so
+	// the short names don't change when the page reorders its items.
+	slices.SortStableFunc(unnamed, func(a, b int) int {
+		return strings.Compare(items[a].Name, items[b].Name)
+	})
+
+	for _, i := range unnamed {
+		base := abbreviate(items[i].Name)
+		shortName := base
+
+		n := 2
+		if base == "" {
+			n = 1
+		}
+
+		for ; shortName == "" || assigned[shortName]; n++ {
+			shortName = base + strconv.Itoa(n)
+		}
+
+		assigned[shortName] = true
+		items[i].ShortName = shortName
+	}
+
+	return items
+}
+
+// abbreviate returns the upper case initials of the first words of the name,
+// e.g. "PM" for "Pizza Margherita".
+func abbreviate(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var abbreviation strings.Builder
+
+	for _, word := range words[:min(len(words), maxAbbreviationLen)] {
+		initial, _ := utf8.DecodeRuneInString(word)
+		abbreviation.WriteRune(unicode.ToUpper(initial))
+	}
+
+	return abbreviation.String()
+}
+
+func itemIdentifier(node map[string]any) string {
+	for _, key := range []string{"identifier", "sku", "productID"} {
+		identifier := strings.TrimSpace(stringValue(node[key]))
+		if identifier != "" && len(identifier) <= maxShortNameLen && !strings.ContainsAny(identifier, " \t") {
+			return identifier
+		}
+	}
+
+	return ""
+}
+
+// offerPrice returns the price in cents of the first offer that has one.
+func offerPrice(value any) (int, bool) {
+	for _, offer := range nodes(value) {
+		for _, candidate := range []any{offer["price"], offer["lowPrice"]} {
+			if cents, ok := priceValue(candidate); ok {
+				return cents, true
+			}
+		}
+
+		for _, specification := range nodes(offer["priceSpecification"]) {
+			if cents, ok := priceValue(specification["price"]); ok {
+				return cents, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func priceValue(value any) (int, bool) {
+	switch v := value.(type) {
+	case float64:
+		if v < 0 {
+			return 0, false
+		}
+
+		return int(v*100 + 0.5), true
+	case string:
+		cents, err := price.Parse(v)
+		return cents, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// nodes returns the objects of a json-ld property, which can either be a
+// single object or an array of objects.
+func nodes(value any) []map[string]any {
+	switch v := value.(type) {
+	case map[string]any:
+		return []map[string]any{v}
+	case []any:
+		result := make([]map[string]any, 0, len(v))
+
+		for _, element := range v {
+			if node, ok := element.(map[string]any); ok {
+				result = append(result, node)
+			}
+		}
+
+		return result
+	default:
+		return nil
+	}
+}
+
+func firstNode(value any) (map[string]any, bool) {
+	found := nodes(value)
+	if len(found) == 0 {
+		return nil, false
+	}
+
+	return found[0], true
+}
+
+// hasType checks the @type of a node. Types may be prefixed with the schema.org
+// vocabulary, e.g. "schema:Menu" or "https://schema.org/Menu".
+func hasType(node map[string]any, types ...string) bool {
+	var nodeTypes []string
+
+	switch v := node["@type"].(type) {
+	case string:
+		nodeTypes = []string{v}
+	case []any:
+		for _, element := range v {
+			if nodeType, ok := element.(string); ok {
+				nodeTypes = append(nodeTypes, nodeType)
+			}
+		}
+	}
+
+	for _, nodeType := range nodeTypes {
+		if i := strings.LastIndexAny(nodeType, "/:"); i >= 0 {
+			nodeType = nodeType[i+1:]
+		}
+
+		for _, t := range types {
+			if nodeType == t {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]any:
+		// language tagged strings like {"@value": "Nan", "@language": "de"}
+		return stringValue(v["@value"])
+	case []any:
+		if len(v) > 0 {
+			return stringValue(v[0])
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+func TestReadJSONLD(t *testing.T) {
+	type testCase struct {
+		name   string
+		file   string
+		format *jsonLDFormat
+		menu   *entity.Menu
+		err    error
+	}
+
+	testCases := []testCase{
+		{
+			name:   "should read nested sections of a restaurant menu",
+			file:   "restaurant.html",
+			format: &jsonLDFormat{},
+			menu: &entity.Menu{
+				Name: "Sangam",
+				URL:  "https://sangam.example/speisekarte",
+				Items: []entity.MenuItem{
+					{ShortName: "M1", Name: "Dal Maharani", Price: 790},
+					{ShortName: "M7", Name: "Chicken Masala", Price: 950},
+					{ShortName: "174", Name: "Nan", Price: 320},
+				},
+			},
+		},
+		{
+			name:   "should prefer the given name and url",
+			file:   "restaurant.html",
+			format: &jsonLDFormat{menuName: "Sangam Aalen", menuURL: "https://sangam.example"},
+			menu: &entity.Menu{
+				Name: "Sangam Aalen",
+				URL:  "https://sangam.example",
+				Items: []entity.MenuItem{
+					{ShortName: "M1", Name: "Dal Maharani", Price: 790},
+					{ShortName: "M7", Name: "Chicken Masala", Price: 950},
+					{ShortName: "174", Name: "Nan", Price: 320},
+				},
+			},
+		},
+		{
+			name:   "should read menu from graph and generate short names",
+			file:   "graph.html",
+			format: &jsonLDFormat{},
+			menu: &entity.Menu{
+				Name: "Pizza Mühle",
+				Items: []entity.MenuItem{
+					{ShortName: "PM", Name: "Pizza Margherita", Price: 850},
+					{ShortName: "1", Name: "Pizza Salami", Price: 950},
+					{ShortName: "PF", Name: "Pizza Funghi", Price: 900},
+					{ShortName: "T", Name: "Tiramisu", Price: 500},
+				},
+			},
+		},
+		{
+			name:   "should fail without embedded menu",
+			file:   "no_menu.html",
+			format: &jsonLDFormat{},
+			err:    errNoJSONLDMenu,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := os.Open(filepath.Join("testdata", tc.file))
+			require.NoError(t, err)
+
+			defer file.Close()
+
+			menu, err := tc.format.read(file)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.menu, menu)
+		})
+	}
+}
+
+func TestMenuItemsShortNames(t *testing.T) {
+	item := func(name, identifier string) any {
+		return map[string]any{"name": name, "identifier": identifier, "offers": map[string]any{"price": 9.5}}
+	}
+
+	items := []any{
+		item("Pizza Mozzarella", ""),
+		item("Pizza Margherita", ""),
+		item("Pizza Marinara", "PM"),
+		item("Pasta Mista", ""),
+		item("!!!", ""),
+	}
+
+	expected := map[string]string{
+		"Pizza Mozzarella": "PM4",
+		"Pizza Margherita": "PM3",
+		"Pizza Marinara":   "PM",
+		"Pasta Mista":      "PM2",
+		"!!!":              "1",
+	}
+
+	for range 2 {
+		shortNames := map[string]string{}
+
+		for _, menuItem := range menuItems(map[string]any{"hasMenuItem": items}) {
+			shortNames[menuItem.Name] = menuItem.ShortName
+		}
+
+		assert.Equal(t, expected, shortNames)
+
+		slices.Reverse(items)
+	}
+}
+
+func TestFormatForURL(t *testing.T) {
+	format, err := formatFor("", "https://sangam.example/speisekarte", "Sangam", "")
+	require.NoError(t, err)
+	assert.Equal(t, &jsonLDFormat{menuName: "Sangam", menuURL: "https://sangam.example/speisekarte"}, format)
+
+	format, err = formatFor("", "testdata/restaurant.html", "", "")
+	require.NoError(t, err)
+	assert.Equal(t, &jsonLDFormat{}, format)
+}
@@ -0,0 +1,297 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+	"github.com/Markus-Schwer/ordaa/internal/price"
+	"github.com/Markus-Schwer/ordaa/internal/repository"
+	"github.com/Markus-Schwer/ordaa/internal/service"
+)
+
+const (
+	fetchTimeout = 30 * time.Second
+	maxPageSize  = 10 << 20
+)
+
+var errFetchingPage = errors.New("fetching page")
+
+const usage = `usage: import_menu [import|export] [flags]
+
+  import  read a menu file and create or update the menu in the database (default)
+  export  write a menu from the database to a file
+
+The file format is detected from the file extension (.json, .csv, .yaml, .yml,
+.html) unless it is set explicitly with -format. Import also accepts an http(s)
+url for -f, the page is then searched for a schema.org menu in its JSON-LD.
+Items without a short identifier in the page get the initials of their name,
+e.g. PM for Pizza Margherita.
+`
+
+func main() {
+	ctx := context.Background()
+
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	args := os.Args[1:]
+	command := "import"
+
+	if len(args) > 0 && (args[0] == "import" || args[0] == "export") {
+		command = args[0]
+		args = args[1:]
+	}
+
+	var err error
+
+	switch command {
+	case "import":
+		err = runImport(ctx, args)
+	case "export":
+		err = runExport(ctx, args)
+	}
+
+	if err != nil {
+		log.Fatal().Err(err).Msgf("running %s", command)
+	}
+}
+
+func runImport(ctx context.Context, args []string) error {
+	var (
+		file     string
+		format   string
+		menuName string
+		menuURL  string
+		dryRun   bool
+	)
+
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	flags.Usage = printUsage(flags)
+	flags.StringVar(&file, "f", "sangam.json", "file or web page url to import")
+	flags.StringVar(&format, "format", "", "file format (json, csv, yaml or html), detected from the file extension if empty")
+	flags.StringVar(&menuName, "name", "", "menu name for formats without one (csv, html), defaults to the file name for csv")
+	flags.StringVar(&menuURL, "url", "", "menu url for formats without one (csv, html)")
+	flags.BoolVar(&dryRun, "dry-run", false, "only print the changes without writing them to the database")
+	_ = flags.Parse(args)
+
+	menuFormat, err := formatFor(format, file, menuName, menuURL)
+	if err != nil {
+		return err
+	}
+
+	menuService, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	menu, err := readMenu(ctx, file, menuFormat)
+	if err != nil {
+		return fmt.Errorf("reading menu: %w", err)
+	}
+
+	diff, err := menuService.ImportMenu(ctx, nil, menu, dryRun)
+	if err != nil {
+		return fmt.Errorf("importing menu: %w", err)
+	}
+
+	printDiff(os.Stdout, diff)
+
+	if dryRun {
+		log.Info().Msg("dry run, no changes were written")
+		return nil
+	}
+
+	log.Info().Msg("successfully imported menu")
+
+	return nil
+}
+
+func runExport(ctx context.Context, args []string) error {
+	var (
+		file     string
+		format   string
+		menuName string
+	)
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.Usage = printUsage(flags)
+	flags.StringVar(&file, "f", "-", "file to export to, - for stdout")
+	flags.StringVar(&format, "format", "", "file format (json, csv or yaml), detected from the file extension if empty")
+	flags.StringVar(&menuName, "menu", "", "name of the menu to export")
+	_ = flags.Parse(args)
+
+	if menuName == "" {
+		return errMenuNameMissing
+	}
+
+	menuFormat, err := formatFor(format, file, menuName, "")
+	if err != nil {
+		return err
+	}
+
+	menuService, err := connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	menu, err := menuService.GetMenuByName(ctx, menuName)
+	if err != nil {
+		return fmt.Errorf("getting menu: %w", err)
+	}
+
+	if err = writeMenu(file, menuFormat, menu); err != nil {
+		return fmt.Errorf("writing menu: %w", err)
+	}
+
+	if file != "-" {
+		log.Info().Msgf("successfully exported menu to %s", file)
+	}
+
+	return nil
+}
+
+func printUsage(flags *flag.FlagSet) func() {
+	return func() {
+		fmt.Fprint(flags.Output(), usage)
+		fmt.Fprintf(flags.Output(), "\nflags for %s:\n", flags.Name())
+		flags.PrintDefaults()
+	}
+}
+
+func connect(ctx context.Context) (*service.MenuService, error) {
+	databaseURL := os.Getenv("DATABASE_URL")
+
+	if err := entity.Migrate(ctx, databaseURL); err != nil {
+		return nil, fmt.Errorf("database migration failed: %w", err)
+	}
+
+	db, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("connecting to database: %w", err)
+	}
+
+	menuRepository := &repository.MenuRepository{DB: db}
+
+	return &service.MenuService{MenuRepository: menuRepository, Authorizer: service.TrustedAuthorizer{}}, nil
+}
+
+func readMenu(ctx context.Context, filename string, format menuFormat) (*entity.Menu, error) {
+	if isURL(filename) {
+		return fetchMenu(ctx, filename, format)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return format.read(file)
+}
+
+func fetchMenu(ctx context.Context, url string, format menuFormat) (*entity.Menu, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", "ordaa-menu-import")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", errFetchingPage, resp.Status)
+	}
+
+	return format.read(io.LimitReader(resp.Body, maxPageSize))
+}
+
+func writeMenu(filename string, format menuFormat, menu *entity.Menu) error {
+	if filename == "-" {
+		return format.write(os.Stdout, menu)
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	if err = format.write(file, menu); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func printDiff(w io.Writer, diff *entity.MenuDiff) {
+	if diff.Empty() {
+		fmt.Fprintf(w, "menu %s is up to date\n", diff.Name)
+		return
+	}
+
+	if diff.NewMenu {
+		fmt.Fprintf(w, "new menu %s\n", diff.Name)
+	} else {
+		fmt.Fprintf(w, "menu %s\n", diff.Name)
+	}
+
+	if diff.URL != diff.OldURL && !diff.NewMenu {
+		fmt.Fprintf(w, "~ url: %q -> %q\n", diff.OldURL, diff.URL)
+	}
+
+	for _, item := range diff.Added {
+		fmt.Fprintf(w, "+ %s %s %s", item.ShortName, item.Name, price.Format(item.Price))
+
+		if _, ok := diff.Revived[item.ShortName]; ok {
+			fmt.Fprint(w, " (restored)")
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	for _, change := range diff.Changed {
+		fmt.Fprintf(w, "~ %s", change.Old.ShortName)
+
+		if change.Old.Name != change.New.Name {
+			fmt.Fprintf(w, " name: %q -> %q", change.Old.Name, change.New.Name)
+		}
+
+		if change.Old.Price != change.New.Price {
+			fmt.Fprintf(w, " price: %s -> %s", price.Format(change.Old.Price), price.Format(change.New.Price))
+		}
+
+		fmt.Fprintln(w)
+	}
+
+	for _, item := range diff.Removed {
+		fmt.Fprintf(w, "- %s %s %s\n", item.ShortName, item.Name, price.Format(item.Price))
+	}
+
+	fmt.Fprintf(w, "%d added, %d changed, %d removed\n", len(diff.Added), len(diff.Changed), len(diff.Removed))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/Markus-Schwer/ordaa/internal/entity"
+)
+
+type yamlFormat struct{}
+
+func (f *yamlFormat) read(r io.Reader) (*entity.Menu, error) {
+	var file menuFile
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	return file.toMenu(), nil
+}
+
+func (f *yamlFormat) write(w io.Writer, menu *entity.Menu) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(toMenuFile(menu)); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}